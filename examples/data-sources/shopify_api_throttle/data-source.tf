@@ -0,0 +1,13 @@
+data "shopify_api_throttle" "current" {}
+
+resource "shopify_discount_redeem_code_bulk" "newsletter" {
+  discount_id    = "gid://shopify/DiscountCodeNode/1234567890"
+  generate_count = 100
+
+  lifecycle {
+    precondition {
+      condition     = data.shopify_api_throttle.current.currently_available >= data.shopify_api_throttle.current.maximum_available / 2
+      error_message = "The GraphQL API cost bucket is below half, retry later."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "shopify_bulk_query" "products" {
+  query   = "{ products { edges { node { id handle title } } } }"
+  timeout = "1h"
+}
+
+output "products_export_url" {
+  value = data.shopify_bulk_query.products.url
+}
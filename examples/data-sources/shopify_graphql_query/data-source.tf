@@ -0,0 +1,15 @@
+data "shopify_graphql_query" "example" {
+  query     = <<-EOT
+    query ($id: ID!) {
+      product(id: $id) {
+        title
+        totalInventory
+      }
+    }
+  EOT
+  variables = jsonencode({ id = "gid://shopify/Product/1234567890" })
+}
+
+output "total_inventory" {
+  value = jsondecode(data.shopify_graphql_query.example.result).product.totalInventory
+}
@@ -0,0 +1,10 @@
+# A definition created by an app or in the Shopify admin.
+data "shopify_metafield_definition" "care_guide" {
+  owner_type = "PRODUCT"
+  namespace  = "custom"
+  key        = "care_guide"
+}
+
+output "care_guide_definition_id" {
+  value = data.shopify_metafield_definition.care_guide.id
+}
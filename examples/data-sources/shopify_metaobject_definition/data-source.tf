@@ -0,0 +1,10 @@
+data "shopify_metaobject_definition" "review" {
+  type = "review"
+}
+
+# Derive a definition per market from the fields of an existing one.
+resource "shopify_metaobject_definition" "review_eu" {
+  name              = "Review (EU)"
+  type              = "review_eu"
+  field_definitions = data.shopify_metaobject_definition.review.field_definitions
+}
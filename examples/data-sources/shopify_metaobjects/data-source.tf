@@ -0,0 +1,13 @@
+data "shopify_metaobjects" "authors" {
+  type = "author"
+}
+
+# Adopt the definition and its entries of an existing store.
+import {
+  to = shopify_metaobject_definition.author
+  id = "author"
+}
+
+output "author_import_ids" {
+  value = { for metaobject in data.shopify_metaobjects.authors.metaobjects : metaobject.handle => metaobject.id }
+}
@@ -0,0 +1,7 @@
+data "shopify_pages" "about" {
+  handle = "about-us"
+}
+
+data "shopify_pages" "published" {
+  published_status = "published"
+}
@@ -0,0 +1,5 @@
+data "shopify_provider_config" "current" {}
+
+output "shop" {
+  value = data.shopify_provider_config.current.shop
+}
@@ -5,3 +5,14 @@ provider "shopify" {
   api_secret_key         = "XXXXXXXXXXXXXX"
   admin_api_access_token = "shpat_XXXXXXXXXXXXX"
 }
+
+# A public app, with an access token obtained by the OAuth flow beforehand
+provider "shopify" {
+  alias                  = "public_app"
+  shop                   = "shop-name.myshopify.com"
+  api_version            = "2024-01"
+  auth_mode              = "oauth"
+  api_key                = "XXXXXXXXXXXXXX"
+  api_secret_key         = "XXXXXXXXXXXXXX"
+  admin_api_access_token = "shpat_XXXXXXXXXXXXX"
+}
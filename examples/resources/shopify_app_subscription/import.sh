@@ -0,0 +1 @@
+terraform import shopify_app_subscription.example gid://shopify/AppSubscription/{{id}}
\ No newline at end of file
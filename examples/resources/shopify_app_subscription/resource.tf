@@ -0,0 +1,26 @@
+resource "shopify_app_subscription" "example" {
+  name       = "Pro Plan"
+  test       = true
+  return_url = "https://example.com/billing/return"
+  line_items = [
+    {
+      recurring = {
+        price         = 29.99
+        currency_code = "USD"
+        interval      = "EVERY_30_DAYS"
+      }
+    },
+    {
+      usage = {
+        terms         = "$1 per 1000 emails"
+        capped_amount = 100
+        currency_code = "USD"
+      }
+    },
+  ]
+}
+
+# The merchant approves the subscription by visiting this URL
+output "app_subscription_confirmation_url" {
+  value = shopify_app_subscription.example.confirmation_url
+}
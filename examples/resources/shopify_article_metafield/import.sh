@@ -0,0 +1 @@
+terraform import shopify_article_metafield.example {{article_id}}:{{namespace}}.{{key}}
\ No newline at end of file
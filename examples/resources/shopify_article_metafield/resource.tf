@@ -0,0 +1,7 @@
+resource "shopify_article_metafield" "hero_style" {
+  article_id = "gid://shopify/Article/1234567890"
+  namespace  = "theme"
+  key        = "hero_style"
+  type       = "single_line_text_field"
+  value      = "full_width"
+}
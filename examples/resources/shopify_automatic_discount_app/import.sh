@@ -0,0 +1 @@
+terraform import shopify_automatic_discount_app.example gid://shopify/DiscountAutomaticNode/{{discount_id}}
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "shopify_automatic_discount_app" "example" {
+  title       = "10% off with the loyalty function"
+  function_id = "01234567-89ab-cdef-0123-456789abcdef"
+  starts_at   = "2024-01-01T00:00:00Z"
+  combines_with = {
+    shipping_discounts = true
+  }
+  metafields = [
+    {
+      namespace = "$app:discount"
+      key       = "configuration"
+      type      = "json"
+      value     = jsonencode({ percentage = 10 })
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import shopify_blog_metafield.example {{blog_id}}:{{namespace}}.{{key}}
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "shopify_blog_metafield" "layout" {
+  blog_id   = "gid://shopify/Blog/1234567890"
+  namespace = "theme"
+  key       = "layout"
+  type      = "json"
+  value = jsonencode({
+    columns = 2
+    sidebar = true
+  })
+}
@@ -0,0 +1 @@
+terraform import shopify_collection_publication.example {{collection_id}}:{{publication_id}}
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "shopify_collection_publication" "example" {
+  collection_id  = "gid://shopify/Collection/1234567890"
+  publication_id = "gid://shopify/Publication/1234567890"
+}
+
+# Schedule the publication to a sales channel
+resource "shopify_collection_publication" "scheduled" {
+  collection_id  = "gid://shopify/Collection/1234567890"
+  publication_id = "gid://shopify/Publication/9876543210"
+  published_at   = "2030-01-01T00:00:00Z"
+}
@@ -0,0 +1,7 @@
+resource "shopify_customer_account_settings" "example" {
+}
+
+# Link to the new customer accounts from outside of the online store
+output "customer_account_url" {
+  value = shopify_customer_account_settings.example.url
+}
@@ -0,0 +1 @@
+terraform import shopify_customer_address.example {{customer_id}}:{{address_id}}
\ No newline at end of file
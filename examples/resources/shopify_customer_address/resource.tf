@@ -0,0 +1,11 @@
+resource "shopify_customer_address" "example" {
+  customer_id   = "gid://shopify/Customer/1234567890"
+  first_name    = "Jane"
+  last_name     = "Doe"
+  address1      = "1 Main Street"
+  city          = "Ottawa"
+  province_code = "ON"
+  country_code  = "CA"
+  zip           = "K1A 0B1"
+  default       = true
+}
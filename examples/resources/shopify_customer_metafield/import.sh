@@ -0,0 +1 @@
+terraform import shopify_customer_metafield.example {{customer_id}}:{{namespace}}.{{key}}
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "shopify_customer_metafield" "loyalty_tier" {
+  customer_id = "1234567890"
+  namespace   = "loyalty"
+  key         = "tier"
+  type        = "single_line_text_field"
+  value       = "gold"
+}
+
+resource "shopify_customer_metafield" "crm_profile" {
+  customer_id = "gid://shopify/Customer/1234567890"
+  namespace   = "crm"
+  key         = "profile"
+  type        = "json"
+  value = jsonencode({
+    segment    = "wholesale"
+    account_id = "A-1001"
+  })
+}
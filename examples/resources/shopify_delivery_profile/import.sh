@@ -0,0 +1 @@
+terraform import shopify_delivery_profile.heavy_items gid://shopify/DeliveryProfile/{{id}}
\ No newline at end of file
@@ -0,0 +1,42 @@
+resource "shopify_delivery_profile" "heavy_items" {
+  name = "Heavy items"
+  location_groups = [
+    {
+      location_ids = ["gid://shopify/Location/1234567890"]
+      zones = [
+        {
+          name = "Domestic"
+          countries = [
+            { code = "US", province_codes = ["CA", "NY"] },
+          ]
+          rates = [
+            {
+              name          = "Standard"
+              price         = 15
+              currency_code = "USD"
+            },
+            {
+              name          = "Express"
+              description   = "Delivered in 2 business days"
+              price         = 30
+              currency_code = "USD"
+            },
+          ]
+        },
+        {
+          name = "Canada"
+          countries = [
+            { code = "CA" },
+          ]
+          rates = [
+            {
+              name          = "International"
+              price         = 40
+              currency_code = "USD"
+            },
+          ]
+        },
+      ]
+    },
+  ]
+}
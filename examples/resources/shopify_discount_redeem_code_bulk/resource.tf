@@ -0,0 +1,11 @@
+# Add explicit codes
+resource "shopify_discount_redeem_code_bulk" "influencers" {
+  discount_id = "gid://shopify/DiscountCodeNode/1234567890"
+  codes       = ["ALICE10", "BOB10"]
+}
+
+# Generate random codes
+resource "shopify_discount_redeem_code_bulk" "newsletter" {
+  discount_id    = "gid://shopify/DiscountCodeNode/1234567890"
+  generate_count = 100
+}
@@ -0,0 +1 @@
+terraform import shopify_file_alt_text.example gid://shopify/MediaImage/{{id}}
\ No newline at end of file
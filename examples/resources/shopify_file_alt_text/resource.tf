@@ -0,0 +1,5 @@
+resource "shopify_file_alt_text" "example" {
+  file_id  = "gid://shopify/MediaImage/1234567890"
+  alt      = "A red t-shirt on a white background"
+  filename = "red-t-shirt.jpg"
+}
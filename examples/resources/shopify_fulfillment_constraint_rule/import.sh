@@ -0,0 +1 @@
+terraform import shopify_fulfillment_constraint_rule.example gid://shopify/FulfillmentConstraintRule/{{rule_id}}
\ No newline at end of file
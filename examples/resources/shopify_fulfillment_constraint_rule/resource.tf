@@ -0,0 +1,12 @@
+resource "shopify_fulfillment_constraint_rule" "example" {
+  function_id           = "01234567-89ab-cdef-0123-456789abcdef"
+  delivery_method_types = ["SHIPPING", "LOCAL"]
+  metafields = [
+    {
+      namespace = "$app:fulfillment-constraints"
+      key       = "configuration"
+      type      = "json"
+      value     = jsonencode({ bundles = ["gift-set"] })
+    }
+  ]
+}
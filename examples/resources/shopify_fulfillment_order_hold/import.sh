@@ -0,0 +1 @@
+terraform import shopify_fulfillment_order_hold.example gid://shopify/FulfillmentOrder/{{fulfillment_order_id}}:gid://shopify/FulfillmentHold/{{hold_id}}
\ No newline at end of file
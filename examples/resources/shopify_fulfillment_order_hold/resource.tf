@@ -0,0 +1,5 @@
+resource "shopify_fulfillment_order_hold" "example" {
+  fulfillment_order_id = "gid://shopify/FulfillmentOrder/1234567890"
+  reason               = "HIGH_RISK_OF_FRAUD"
+  reason_notes         = "Waiting for the fraud review"
+}
@@ -0,0 +1 @@
+terraform import shopify_gift_card_configuration.example gid://shopify/Shop/{{shop_id}}
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "shopify_gift_card_configuration" "example" {
+  expires       = true
+  expiry_months = 24
+}
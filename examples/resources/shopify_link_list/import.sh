@@ -0,0 +1 @@
+terraform import shopify_link_list.footer gid://shopify/Menu/{{id}}
\ No newline at end of file
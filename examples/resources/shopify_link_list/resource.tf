@@ -0,0 +1,20 @@
+resource "shopify_link_list" "footer" {
+  title  = "Footer"
+  handle = "footer"
+  links = [
+    {
+      title = "Home"
+      type  = "FRONTPAGE"
+    },
+    {
+      title       = "Summer collection"
+      type        = "COLLECTION"
+      resource_id = "gid://shopify/Collection/1234567890"
+    },
+    {
+      title = "Contact"
+      type  = "HTTP"
+      url   = "https://example.com/contact"
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import shopify_metafield.example {{owner_id}}:{{namespace}}.{{key}}
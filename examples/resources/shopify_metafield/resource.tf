@@ -0,0 +1,18 @@
+resource "shopify_metafield" "material" {
+  owner_id  = "gid://shopify/Product/1234567890"
+  namespace = "custom"
+  key       = "material"
+  type      = "single_line_text_field"
+  value     = "Cotton"
+}
+
+resource "shopify_metafield" "care" {
+  owner_id  = "gid://shopify/Product/1234567890"
+  namespace = "custom"
+  key       = "care"
+  type      = "json"
+  value = jsonencode({
+    washing = "30°C"
+    drying  = "low"
+  })
+}
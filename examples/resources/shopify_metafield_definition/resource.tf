@@ -5,3 +5,13 @@ resource "shopify_metafield_definition" "example" {
   owner_type = "CUSTOMER"
   type       = "single_line_text_field"
 }
+
+resource "shopify_metafield_definition" "related_products" {
+  key        = "related_products"
+  name       = "Related products"
+  namespace  = "custom"
+  owner_type = "PRODUCT"
+  type       = "list.product_reference"
+  list_min   = 1
+  list_max   = 4
+}
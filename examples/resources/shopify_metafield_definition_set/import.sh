@@ -0,0 +1 @@
+terraform import shopify_metafield_definition_set.example PRODUCT:specs
\ No newline at end of file
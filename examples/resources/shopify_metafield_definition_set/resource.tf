@@ -0,0 +1,22 @@
+resource "shopify_metafield_definition_set" "example" {
+  owner_type = "PRODUCT"
+  namespace  = "specs"
+  definitions = {
+    color = {
+      name = "Color"
+      type = "single_line_text_field"
+      pin  = true
+    }
+    weight_grams = {
+      name        = "Weight (g)"
+      description = "The weight of the product in grams"
+      type        = "number_integer"
+      validations = [
+        {
+          name  = "min"
+          value = "0"
+        }
+      ]
+    }
+  }
+}
@@ -0,0 +1,8 @@
+# metafields.csv:
+# owner_id,namespace,key,type,value
+# gid://shopify/Product/1234567890,custom,subtitle,single_line_text_field,Handmade in Japan
+# gid://shopify/Product/1234567890,custom,care,multi_line_text_field,"Hand wash only.
+# Dry flat."
+resource "shopify_metafields_import" "example" {
+  source_file = "${path.module}/metafields.csv"
+}
@@ -1 +1,5 @@
-terraform import shopify_metaobject_definition.example gid://shopify/MetaobjectDefinition/{{id}}
\ No newline at end of file
+# Import by ID
+terraform import shopify_metaobject_definition.example gid://shopify/MetaobjectDefinition/{{id}}
+
+# Import by type
+terraform import shopify_metaobject_definition.example {{type}}
\ No newline at end of file
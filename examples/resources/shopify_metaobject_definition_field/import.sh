@@ -0,0 +1 @@
+terraform import shopify_metaobject_definition_field.bio {{metaobject_type}}:{{key}}
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "shopify_metaobject_definition_field" "bio" {
+  metaobject_type = "author"
+  key             = "bio"
+  name            = "Biography"
+  description     = "Owned by the content team"
+  type            = "multi_line_text_field"
+  validations = [
+    {
+      name  = "max"
+      value = "1000"
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import shopify_metaobject_entry_set.example {{type}}
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "shopify_metaobject_entry_set" "authors" {
+  type = "author"
+  entries = {
+    jane-doe = {
+      fields = {
+        name = "Jane Doe"
+        bio  = "Writes about coffee."
+      }
+    }
+    john-smith = {
+      fields = {
+        name = "John Smith"
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import shopify_order_risk.example {{order_id}}:{{risk_id}}
\ No newline at end of file
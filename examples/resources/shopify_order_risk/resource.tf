@@ -0,0 +1,7 @@
+resource "shopify_order_risk" "example" {
+  order_id       = "gid://shopify/Order/1234567890"
+  message        = "The billing address doesn't match the card"
+  recommendation = "investigate"
+  score          = 0.5
+  source         = "Fraud Tool"
+}
@@ -0,0 +1 @@
+terraform import shopify_order_tag.example gid://shopify/Order/{{order_id}}
\ No newline at end of file
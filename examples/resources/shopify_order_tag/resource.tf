@@ -0,0 +1,5 @@
+resource "shopify_order_tag" "example" {
+  order_id = "gid://shopify/Order/1234567890"
+  tags     = ["wholesale", "priority"]
+  note     = "Ship with the next wholesale batch"
+}
@@ -1,2 +1,5 @@
 # Note: integer id instead of graphql global id
-terraform import shopify_page.test {{id}}
\ No newline at end of file
+terraform import shopify_page.test {{id}}
+
+# The handle of the page is accepted as well
+terraform import shopify_page.test {{handle}}
\ No newline at end of file
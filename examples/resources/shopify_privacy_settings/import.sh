@@ -0,0 +1 @@
+terraform import shopify_privacy_settings.example gid://shopify/Shop/{{shop_id}}
\ No newline at end of file
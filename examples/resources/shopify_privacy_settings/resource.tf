@@ -0,0 +1,4 @@
+# The store asks for the consent of the visitors with its own consent management platform
+resource "shopify_privacy_settings" "example" {
+  cookie_banner_enabled = false
+}
@@ -0,0 +1 @@
+terraform import shopify_product_option.example gid://shopify/Product/{{product_id}}:gid://shopify/ProductOption/{{option_id}}
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "shopify_product_option" "size" {
+  product_id = "gid://shopify/Product/1234567890"
+  name       = "Size"
+  values     = ["S", "M", "L"]
+}
+
+resource "shopify_product_option" "color" {
+  product_id = "gid://shopify/Product/1234567890"
+  name       = "Color"
+  values     = ["Black", "White"]
+  position   = 2
+}
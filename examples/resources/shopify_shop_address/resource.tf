@@ -0,0 +1,9 @@
+resource "shopify_shop_address" "example" {
+  address1      = "1 Main Street"
+  address2      = "Suite 100"
+  city          = "New York"
+  zip           = "10001"
+  province_code = "NY"
+  country_code  = "US"
+  phone         = "+12125550100"
+}
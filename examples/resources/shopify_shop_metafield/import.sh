@@ -0,0 +1 @@
+terraform import shopify_shop_metafield.example {{namespace}}.{{key}}
\ No newline at end of file
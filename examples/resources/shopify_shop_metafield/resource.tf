@@ -0,0 +1,16 @@
+resource "shopify_shop_metafield" "example" {
+  namespace = "custom"
+  key       = "featured_collection"
+  type      = "collection_reference"
+  value     = "gid://shopify/Collection/1234567890"
+}
+
+resource "shopify_shop_metafield" "json_example" {
+  namespace = "custom"
+  key       = "shipping_banner"
+  type      = "json"
+  value = jsonencode({
+    enabled = true
+    message = "Free shipping over $50"
+  })
+}
@@ -0,0 +1 @@
+terraform import shopify_shop_settings.example gid://shopify/Shop/{{shop_id}}
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "shopify_shop_settings" "example" {
+  money_format               = "${{amount}}"
+  money_with_currency_format = "${{amount}} USD"
+  weight_unit                = "KILOGRAMS"
+  customer_email             = "support@example.com"
+}
@@ -0,0 +1,2 @@
+# Note: integer id instead of graphql global id
+terraform import shopify_smart_collection.example {{id}}
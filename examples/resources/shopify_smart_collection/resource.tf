@@ -0,0 +1,16 @@
+resource "shopify_smart_collection" "example" {
+  title      = "Summer Sale"
+  sort_order = "price-asc"
+  rules = [
+    {
+      column    = "tag"
+      relation  = "equals"
+      condition = "summer"
+    },
+    {
+      column    = "variant_price"
+      relation  = "less_than"
+      condition = "50"
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import shopify_subscription_billing_attempt.example gid://shopify/SubscriptionBillingAttempt/{{id}}
\ No newline at end of file
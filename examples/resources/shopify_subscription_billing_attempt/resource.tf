@@ -0,0 +1,4 @@
+resource "shopify_subscription_billing_attempt" "example" {
+  subscription_contract_id = "gid://shopify/SubscriptionContract/1234567890"
+  idempotency_key          = "qa-billing-2026-10"
+}
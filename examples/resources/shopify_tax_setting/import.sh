@@ -0,0 +1 @@
+terraform import shopify_tax_setting.example gid://shopify/Shop/{{shop_id}}
\ No newline at end of file
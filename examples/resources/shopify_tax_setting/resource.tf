@@ -0,0 +1,4 @@
+resource "shopify_tax_setting" "example" {
+  taxes_included = true
+  tax_shipping   = false
+}
@@ -0,0 +1 @@
+terraform import shopify_theme_publish.example gid://shopify/OnlineStoreTheme/{{theme_id}}
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "shopify_theme_publish" "example" {
+  theme_id = "gid://shopify/OnlineStoreTheme/123456789"
+}
@@ -0,0 +1 @@
+terraform import shopify_url_redirect.example gid://shopify/UrlRedirect/{{id}}
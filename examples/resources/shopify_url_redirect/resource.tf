@@ -0,0 +1,4 @@
+resource "shopify_url_redirect" "example" {
+  path   = "/products/old-name"
+  target = "/products/new-name"
+}
@@ -0,0 +1 @@
+terraform import shopify_web_pixel.example gid://shopify/WebPixel/{{id}}
\ No newline at end of file
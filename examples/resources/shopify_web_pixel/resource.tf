@@ -0,0 +1,5 @@
+resource "shopify_web_pixel" "example" {
+  settings = jsonencode({
+    accountID = "234"
+  })
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// checkNotReservedToAnotherApp refuses to adopt a definition whose namespace or type is reserved to another app
+// than the one the provider authenticates as, since the app depends on the definition and Terraform would overwrite it.
+func checkNotReservedToAnotherApp(ctx context.Context, client *shopify.Client, kind, attribute, namespaceOrType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	appID := shopify.ReservingAppID(namespaceOrType)
+	if appID == "" {
+		return diags
+	}
+	currentAppID, err := client.GetCurrentAppID(ctx)
+	if err != nil {
+		diags.Append(diagFromClientError("Unable to read the current app", err))
+		return diags
+	}
+	if appID != currentAppID {
+		diags.AddError(
+			"Definition owned by another app",
+			fmt.Sprintf("The %s %s %q is reserved to the app %s, which depends on it. "+
+				"Managing it with Terraform would overwrite the changes of the app, so leave it to the app instead.", kind, attribute, namespaceOrType, appID),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCheckNotReservedToAnotherApp(t *testing.T) {
+	client := newTestShopifyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"currentAppInstallation":{"app":{"id":"gid://shopify/App/1234"}}}}`)
+	})
+	tests := []struct {
+		namespace string
+		wantError bool
+	}{
+		{namespace: "custom", wantError: false},
+		{namespace: "$app:specs", wantError: false},
+		{namespace: "app--1234", wantError: false},
+		{namespace: "app--1234--author", wantError: false},
+		{namespace: "app--5678", wantError: true},
+		{namespace: "app--5678--author", wantError: true},
+	}
+	for _, tt := range tests {
+		diags := checkNotReservedToAnotherApp(context.Background(), client, "metafield definition", "namespace", tt.namespace)
+		if diags.HasError() != tt.wantError {
+			t.Errorf("checkNotReservedToAnotherApp(%q) = %v, want error %v", tt.namespace, diags, tt.wantError)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Package convert maps between the Shopify models and the Terraform models shared by the resources and the data sources,
+// so that they map the same values the same way.
+package convert
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// ValidationModel describes a validation of a metafield or metaobject field definition.
+type ValidationModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+// ValidationModelsToValidations never returns nil, so that removed validations are sent
+// as an empty list and cleared, rather than omitted and left as they are.
+func ValidationModelsToValidations(validationModels []*ValidationModel) []*shopify.MetafieldDefinitionValidation {
+	validations := make([]*shopify.MetafieldDefinitionValidation, 0, len(validationModels))
+	for _, model := range validationModels {
+		validations = append(validations, &shopify.MetafieldDefinitionValidation{
+			Name:  model.Name.ValueString(),
+			Value: model.Value.ValueString(),
+		})
+	}
+	return validations
+}
+
+// ValidationsToModels returns nil without validations, and the validations in the order of the current ones otherwise.
+func ValidationsToModels(validations []*shopify.MetafieldDefinitionValidation, current []*ValidationModel) []*ValidationModel {
+	if len(validations) == 0 {
+		return nil
+	}
+	validationModels := make([]*ValidationModel, 0, len(validations))
+	for _, validation := range validations {
+		validationModels = append(validationModels, &ValidationModel{
+			Name:  types.StringValue(validation.Name),
+			Value: types.StringValue(validation.Value),
+		})
+	}
+
+	// Sort validations by order in the current data not to produce unnecessary diffs,
+	// as Shopify returns them in its own order. Unknown validations go last.
+	validationOrderMap := make(map[string]int, len(current))
+	for i, validation := range current {
+		validationOrderMap[validation.Name.ValueString()] = i
+	}
+	order := func(name string) int {
+		if i, ok := validationOrderMap[name]; ok {
+			return i
+		}
+		return len(current)
+	}
+	sort.SliceStable(validationModels, func(i, j int) bool {
+		return order(validationModels[i].Name.ValueString()) < order(validationModels[j].Name.ValueString())
+	})
+	return validationModels
+}
+
+// ManagedValidations returns the validations declared in the models, dropping the ones added outside of Terraform.
+func ManagedValidations(validations []*shopify.MetafieldDefinitionValidation, models []*ValidationModel) []*shopify.MetafieldDefinitionValidation {
+	managed := make([]*shopify.MetafieldDefinitionValidation, 0, len(validations))
+	for _, validation := range validations {
+		if slices.ContainsFunc(models, func(model *ValidationModel) bool {
+			return model.Name.ValueString() == validation.Name
+		}) {
+			managed = append(managed, validation)
+		}
+	}
+	return managed
+}
+
+// MergeExternalValidations returns the planned validations along with the current ones added outside of Terraform,
+// i.e. neither planned nor in the state, so that the update doesn't remove them.
+func MergeExternalValidations(plan, state []*ValidationModel, current []*shopify.MetafieldDefinitionValidation) []*shopify.MetafieldDefinitionValidation {
+	validations := ValidationModelsToValidations(plan)
+	declared := append(slices.Clone(plan), state...)
+	for _, validation := range current {
+		if !slices.ContainsFunc(declared, func(model *ValidationModel) bool {
+			return model.Name.ValueString() == validation.Name
+		}) {
+			validations = append(validations, validation)
+		}
+	}
+	return validations
+}
+
+// AccessModel describes the access granted to a metaobject definition.
+type AccessModel struct {
+	Admin      types.String `tfsdk:"admin"`
+	Storefront types.String `tfsdk:"storefront"`
+}
+
+// AccessAttrTypes are the attribute types of the object of an AccessModel.
+var AccessAttrTypes = map[string]attr.Type{
+	"admin":      types.StringType,
+	"storefront": types.StringType,
+}
+
+// AccessToModel converts the access of a metaobject definition to its model.
+func AccessToModel(access *shopify.MetaobjectAccess) *AccessModel {
+	return &AccessModel{
+		Admin:      types.StringValue(access.Admin),
+		Storefront: types.StringValue(access.Storefront),
+	}
+}
+
+// ToObject returns the object value of the model.
+func (m *AccessModel) ToObject(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, AccessAttrTypes, m)
+}
+
+// ToShopifyModel returns the access to send to Shopify.
+func (m *AccessModel) ToShopifyModel() *shopify.MetaobjectAccess {
+	return &shopify.MetaobjectAccess{
+		Admin:      m.Admin.ValueString(),
+		Storefront: storefrontAccessInput(m.Storefront),
+	}
+}
+
+// storefrontAccessInput returns the storefront access to send to Shopify. LEGACY_LIQUID_ONLY can only be read, so it's sent as unset.
+func storefrontAccessInput(storefront types.String) string {
+	if storefront.ValueString() == "LEGACY_LIQUID_ONLY" {
+		return ""
+	}
+	return storefront.ValueString()
+}
+
+// MetafieldAccessModel describes the access granted to the metafields of a metafield definition.
+type MetafieldAccessModel struct {
+	Admin           types.String `tfsdk:"admin"`
+	Storefront      types.String `tfsdk:"storefront"`
+	CustomerAccount types.String `tfsdk:"customer_account"`
+}
+
+// MetafieldAccessAttrTypes are the attribute types of the object of a MetafieldAccessModel.
+var MetafieldAccessAttrTypes = map[string]attr.Type{
+	"admin":            types.StringType,
+	"storefront":       types.StringType,
+	"customer_account": types.StringType,
+}
+
+// MetafieldAccessToObject converts the access of a metafield definition to the object of its model,
+// which is null if the access hasn't been read.
+func MetafieldAccessToObject(access *shopify.MetafieldAccess) types.Object {
+	if access == nil {
+		return types.ObjectNull(MetafieldAccessAttrTypes)
+	}
+	return types.ObjectValueMust(MetafieldAccessAttrTypes, map[string]attr.Value{
+		"admin":            types.StringValue(access.Admin),
+		"storefront":       types.StringValue(access.Storefront),
+		"customer_account": types.StringValue(access.CustomerAccount),
+	})
+}
+
+// ToShopifyModel returns the access to send to Shopify. The unknown values, which Shopify fills, are sent as unset.
+func (m *MetafieldAccessModel) ToShopifyModel() *shopify.MetafieldAccess {
+	return &shopify.MetafieldAccess{
+		Admin:           m.Admin.ValueString(),
+		Storefront:      storefrontAccessInput(m.Storefront),
+		CustomerAccount: m.CustomerAccount.ValueString(),
+	}
+}
+
+// EmptyAsNull returns null for an empty value unless the current value is set, e.g. configured as an empty string.
+// Shopify API handles empty string and null as the same value, so either way is kept as it's configured,
+// not to produce inconsistency after apply.
+func EmptyAsNull(value string, current types.String) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// NullIfEmpty returns nil for a null or empty string, so that the update clears the value
+// instead of leaving it as is, consistently with reading an empty value back as null.
+func NullIfEmpty(value types.String) *string {
+	if value.ValueString() == "" {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
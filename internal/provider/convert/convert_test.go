@@ -0,0 +1,170 @@
+package convert
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestValidationsToModels(t *testing.T) {
+	// Shopify returns the validations in its own order
+	validations := []*shopify.MetafieldDefinitionValidation{
+		{Name: "max", Value: "10"},
+		{Name: "regex", Value: "^[0-9]+$"},
+		{Name: "min", Value: "1"},
+	}
+	current := []*ValidationModel{
+		{Name: types.StringValue("min"), Value: types.StringValue("1")},
+		{Name: types.StringValue("max"), Value: types.StringValue("10")},
+	}
+
+	got := ValidationsToModels(validations, current)
+	want := []*ValidationModel{
+		{Name: types.StringValue("min"), Value: types.StringValue("1")},
+		{Name: types.StringValue("max"), Value: types.StringValue("10")},
+		{Name: types.StringValue("regex"), Value: types.StringValue("^[0-9]+$")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := ValidationsToModels(nil, current); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
+
+func TestManagedValidations(t *testing.T) {
+	validations := []*shopify.MetafieldDefinitionValidation{
+		{Name: "min", Value: "1"},
+		{Name: "regex", Value: "^[a-z]+$"},
+	}
+	models := []*ValidationModel{
+		{Name: types.StringValue("min"), Value: types.StringValue("1")},
+	}
+
+	got := ManagedValidations(validations, models)
+	want := []*shopify.MetafieldDefinitionValidation{{Name: "min", Value: "1"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected the validation added outside of Terraform to be dropped, got %v", got)
+	}
+}
+
+func TestMergeExternalValidations(t *testing.T) {
+	plan := []*ValidationModel{
+		{Name: types.StringValue("min"), Value: types.StringValue("2")},
+	}
+	state := []*ValidationModel{
+		{Name: types.StringValue("min"), Value: types.StringValue("1")},
+		{Name: types.StringValue("max"), Value: types.StringValue("10")},
+	}
+	current := []*shopify.MetafieldDefinitionValidation{
+		{Name: "min", Value: "1"},
+		{Name: "max", Value: "10"},
+		{Name: "regex", Value: "^[a-z]+$"},
+	}
+
+	// max has been removed from the configuration, regex has been added outside of Terraform
+	got := MergeExternalValidations(plan, state, current)
+	want := []*shopify.MetafieldDefinitionValidation{
+		{Name: "min", Value: "2"},
+		{Name: "regex", Value: "^[a-z]+$"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected validations: %v", got)
+	}
+}
+
+func TestNullIfEmpty(t *testing.T) {
+	for _, value := range []types.String{types.StringNull(), types.StringValue("")} {
+		if got := NullIfEmpty(value); got != nil {
+			t.Errorf("expected nil for %s, got %q", value, *got)
+		}
+	}
+	if got := NullIfEmpty(types.StringValue("description")); got == nil || *got != "description" {
+		t.Errorf("expected description, got %v", got)
+	}
+}
+
+func TestValidationModelsToValidations_unset(t *testing.T) {
+	// Removed validations must be sent as an empty list, as a missing one leaves them as they are
+	input := &shopify.MetaobjectFieldDefinitionUpdateInput{
+		Key:         "rating",
+		Validations: ValidationModelsToValidations(nil),
+	}
+	b, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"validations":[]`) {
+		t.Errorf("expected an explicit empty validations list, got %s", b)
+	}
+}
+
+func TestAccessToModel(t *testing.T) {
+	model := AccessToModel(&shopify.MetaobjectAccess{Admin: "MERCHANT_READ_WRITE", Storefront: "LEGACY_LIQUID_ONLY"})
+	if model.Admin.ValueString() != "MERCHANT_READ_WRITE" || model.Storefront.ValueString() != "LEGACY_LIQUID_ONLY" {
+		t.Errorf("unexpected model: %+v", model)
+	}
+
+	// LEGACY_LIQUID_ONLY can only be read, so it's not sent back
+	access := model.ToShopifyModel()
+	if access.Admin != "MERCHANT_READ_WRITE" || access.Storefront != "" {
+		t.Errorf("unexpected access: %+v", access)
+	}
+
+	object, diags := model.ToObject(context.Background())
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got := object.Attributes()["storefront"]; !got.Equal(types.StringValue("LEGACY_LIQUID_ONLY")) {
+		t.Errorf("unexpected storefront attribute: %s", got)
+	}
+}
+
+func TestMetafieldAccessToObject(t *testing.T) {
+	if object := MetafieldAccessToObject(nil); !object.IsNull() {
+		t.Errorf("expected a null object, got %s", object)
+	}
+
+	object := MetafieldAccessToObject(&shopify.MetafieldAccess{Admin: "MERCHANT_READ", Storefront: "LEGACY_LIQUID_ONLY", CustomerAccount: "NONE"})
+	var model MetafieldAccessModel
+	if diags := object.As(context.Background(), &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if model.CustomerAccount.ValueString() != "NONE" {
+		t.Errorf("unexpected customer_account attribute: %s", model.CustomerAccount)
+	}
+
+	// LEGACY_LIQUID_ONLY can only be read, so it's not sent back
+	access := model.ToShopifyModel()
+	if access.Admin != "MERCHANT_READ" || access.Storefront != "" || access.CustomerAccount != "NONE" {
+		t.Errorf("unexpected access: %+v", access)
+	}
+}
+
+func TestEmptyAsNull(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		current types.String
+		want    types.String
+	}{
+		{name: "empty and unset", value: "", current: types.StringNull(), want: types.StringNull()},
+		{name: "empty and configured empty", value: "", current: types.StringValue(""), want: types.StringValue("")},
+		{name: "empty and previously set", value: "", current: types.StringValue("old"), want: types.StringValue("")},
+		{name: "set", value: "new", current: types.StringNull(), want: types.StringValue("new")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EmptyAsNull(tt.value, tt.current); !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &APIThrottleDataSource{}
+
+// APIThrottleDataSource defines the data source implementation.
+type APIThrottleDataSource struct {
+	client *shopify.Client
+}
+
+func NewAPIThrottleDataSource() datasource.DataSource {
+	return &APIThrottleDataSource{}
+}
+
+// APIThrottleDataSourceModel describes the data source data model.
+type APIThrottleDataSourceModel struct {
+	MaximumAvailable   types.Float64 `tfsdk:"maximum_available"`
+	CurrentlyAvailable types.Float64 `tfsdk:"currently_available"`
+	RestoreRate        types.Float64 `tfsdk:"restore_rate"`
+	RESTCallsUsed      types.Int64   `tfsdk:"rest_calls_used"`
+	RESTBucketSize     types.Int64   `tfsdk:"rest_bucket_size"`
+}
+
+func (d *APIThrottleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_throttle"
+}
+
+func (d *APIThrottleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current status of the GraphQL API cost bucket of the shop, e.g. to check in a `precondition` " +
+			"that enough points are available before starting a large apply. Reading it costs a query of the lowest cost.",
+		Attributes: map[string]schema.Attribute{
+			"maximum_available": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of points the bucket can hold.",
+				Computed:            true,
+			},
+			"currently_available": schema.Float64Attribute{
+				MarkdownDescription: "The number of points currently available.",
+				Computed:            true,
+			},
+			"restore_rate": schema.Float64Attribute{
+				MarkdownDescription: "The number of points restored per second.",
+				Computed:            true,
+			},
+			"rest_calls_used": schema.Int64Attribute{
+				MarkdownDescription: "The number of calls in the REST API call bucket, as of the last REST response, e.g. of a `shopify_page`. " +
+					"Null if no REST request has been sent yet.",
+				Computed: true,
+			},
+			"rest_bucket_size": schema.Int64Attribute{
+				MarkdownDescription: "The number of calls the REST API call bucket can hold, as of the last REST response. " +
+					"Null if no REST request has been sent yet.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *APIThrottleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *APIThrottleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	status, err := d.client.GetThrottleStatus(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read API throttle status", err))
+		return
+	}
+
+	data := APIThrottleDataSourceModel{
+		MaximumAvailable:   types.Float64Value(status.MaximumAvailable),
+		CurrentlyAvailable: types.Float64Value(status.CurrentlyAvailable),
+		RestoreRate:        types.Float64Value(status.RestoreRate),
+		RESTCallsUsed:      types.Int64Null(),
+		RESTBucketSize:     types.Int64Null(),
+	}
+	if limit := d.client.LastRESTCallLimit(); limit != nil {
+		data.RESTCallsUsed = types.Int64Value(int64(limit.Used))
+		data.RESTBucketSize = types.Int64Value(int64(limit.BucketSize))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
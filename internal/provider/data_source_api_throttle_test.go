@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIThrottleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "shopify_api_throttle" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.shopify_api_throttle.test", "maximum_available"),
+					resource.TestCheckResourceAttrSet("data.shopify_api_throttle.test", "currently_available"),
+					resource.TestCheckResourceAttrSet("data.shopify_api_throttle.test", "restore_rate"),
+				),
+			},
+		},
+	})
+}
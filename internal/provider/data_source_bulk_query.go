@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BulkQueryDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &BulkQueryDataSource{}
+
+// defaultBulkQueryTimeout and defaultBulkQuerySampleSize are the defaults of the timeout and the sample_size attributes.
+const (
+	defaultBulkQueryTimeout    = 30 * time.Minute
+	defaultBulkQuerySampleSize = 10
+)
+
+// BulkQueryDataSource defines the data source implementation.
+type BulkQueryDataSource struct {
+	client *shopify.Client
+}
+
+func NewBulkQueryDataSource() datasource.DataSource {
+	return &BulkQueryDataSource{}
+}
+
+// BulkQueryDataSourceModel describes the data source data model.
+type BulkQueryDataSourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Query       types.String   `tfsdk:"query"`
+	Timeout     types.String   `tfsdk:"timeout"`
+	SampleSize  types.Int64    `tfsdk:"sample_size"`
+	ObjectCount types.Int64    `tfsdk:"object_count"`
+	URL         types.String   `tfsdk:"url"`
+	Sample      []types.String `tfsdk:"sample"`
+}
+
+func (d *BulkQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_query"
+}
+
+func (d *BulkQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a GraphQL query in a bulk operation and waits for it to complete, e.g. to export all the products or all the metafields " +
+			"for a downstream tool. The results are a JSONL file at `url`, too large to be kept in the state, with a `sample` of their first lines.\n\n" +
+			"The same query is only run once per plan or apply, however many data sources run it. " +
+			"A shop only runs one bulk query of an app at a time, so the query fails while another one is running.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bulk operation.",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The GraphQL query to run, whose connections are fully paginated by the bulk operation, " +
+					"e.g. `{ products { edges { node { id title } } } }`.",
+				Required: true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the bulk operation to complete, as a Go duration, e.g. `1h`. Defaults to `30m`.",
+				Optional:            true,
+			},
+			"sample_size": schema.Int64Attribute{
+				MarkdownDescription: "The number of lines of the results in `sample`. Defaults to `10`.",
+				Optional:            true,
+			},
+			"object_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of objects in the results, including the nested ones.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the JSONL file of the results, which expires a week after the operation has completed. " +
+					"Null if there are no results.",
+				Computed: true,
+			},
+			"sample": schema.ListAttribute{
+				MarkdownDescription: "The first lines of the results, each a JSON object to be decoded by `jsondecode`. " +
+					"The nested objects are separate lines referring to their parent by `__parentId`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *BulkQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *BulkQueryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data BulkQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+		if timeout, err := time.ParseDuration(data.Timeout.ValueString()); err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid timeout",
+				fmt.Sprintf("timeout must be a positive duration, e.g. 1h, got %q", data.Timeout.ValueString()),
+			)
+		}
+	}
+	if !data.SampleSize.IsNull() && !data.SampleSize.IsUnknown() && data.SampleSize.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sample_size"),
+			"Invalid sample_size",
+			fmt.Sprintf("sample_size must not be negative, got %d", data.SampleSize.ValueInt64()),
+		)
+	}
+}
+
+func (d *BulkQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BulkQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultBulkQueryTimeout
+	if !data.Timeout.IsNull() {
+		// The timeout has been validated
+		timeout, _ = time.ParseDuration(data.Timeout.ValueString())
+	}
+	sampleSize := int64(defaultBulkQuerySampleSize)
+	if !data.SampleSize.IsNull() {
+		sampleSize = data.SampleSize.ValueInt64()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	operation, err := d.client.RunBulkQuery(ctx, data.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to run bulk query", err))
+		return
+	}
+	objectCount, _ := strconv.ParseInt(operation.ObjectCount, 10, 64)
+
+	// The results are streamed, so that only the sample is read
+	sample := []types.String{}
+	if operation.URL != nil {
+		for line, err := range d.client.FetchBulkResults(ctx, *operation.URL) {
+			if int64(len(sample)) >= sampleSize {
+				break
+			}
+			if err != nil {
+				resp.Diagnostics.Append(diagFromClientError("Unable to read bulk query results", err))
+				return
+			}
+			sample = append(sample, types.StringValue(string(line)))
+		}
+	}
+
+	data.ID = types.StringValue(operation.ID)
+	data.ObjectCount = types.Int64Value(objectCount)
+	data.URL = types.StringPointerValue(operation.URL)
+	data.Sample = sample
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
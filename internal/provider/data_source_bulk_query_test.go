@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBulkQueryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBulkQueryDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.shopify_bulk_query.test", "id", regexp.MustCompile(`^gid://shopify/BulkOperation/`)),
+					resource.TestCheckResourceAttrSet("data.shopify_bulk_query.test", "object_count"),
+				),
+			},
+			{
+				Config:      testAccBulkQueryDataSourceInvalidTimeoutConfig,
+				ExpectError: regexp.MustCompile(`timeout must be a positive duration`),
+			},
+		},
+	})
+}
+
+const testAccBulkQueryDataSourceConfig = `
+data "shopify_bulk_query" "test" {
+  query       = "{ collections { edges { node { id handle } } } }"
+  sample_size = 1
+}
+`
+
+const testAccBulkQueryDataSourceInvalidTimeoutConfig = `
+data "shopify_bulk_query" "test" {
+  query   = "{ collections { edges { node { id handle } } } }"
+  timeout = "soon"
+}
+`
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GraphQLQueryDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &GraphQLQueryDataSource{}
+
+// GraphQLQueryDataSource defines the data source implementation.
+type GraphQLQueryDataSource struct {
+	client *shopify.Client
+}
+
+func NewGraphQLQueryDataSource() datasource.DataSource {
+	return &GraphQLQueryDataSource{}
+}
+
+// GraphQLQueryDataSourceModel describes the data source data model.
+type GraphQLQueryDataSourceModel struct {
+	Query     types.String `tfsdk:"query"`
+	Variables types.String `tfsdk:"variables"`
+	Result    types.String `tfsdk:"result"`
+}
+
+func (d *GraphQLQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graphql_query"
+}
+
+func (d *GraphQLQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs an arbitrary read-only query against the Shopify Admin GraphQL API. " +
+			"It's an escape hatch for data the provider doesn't model yet: it's available but unsupported, " +
+			"so the result isn't validated and may change with the API version. Prefer a dedicated data source when one exists.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The GraphQL query. Mutations and subscriptions aren't allowed.",
+				Required:            true,
+			},
+			"variables": schema.StringAttribute{
+				MarkdownDescription: "The variables of the query as a JSON object, e.g. `jsonencode({ id = \"gid://shopify/Product/1\" })`.",
+				Optional:            true,
+			},
+			"result": schema.StringAttribute{
+				MarkdownDescription: "The `data` of the response as JSON. Use `jsondecode` to access it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GraphQLQueryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data GraphQLQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Query.IsNull() && !data.Query.IsUnknown() {
+		if operation := graphQLOperationType(data.Query.ValueString()); operation != "query" {
+			resp.Diagnostics.AddAttributeError(path.Root("query"), "Invalid query", fmt.Sprintf("Only queries are allowed, got a %s.", operation))
+		}
+	}
+	if !data.Variables.IsNull() && !data.Variables.IsUnknown() {
+		var variables map[string]any
+		if err := json.Unmarshal([]byte(data.Variables.ValueString()), &variables); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("variables"), "Invalid variables", fmt.Sprintf("variables must be a JSON object, got error: %s", err))
+		}
+	}
+}
+
+func (d *GraphQLQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *GraphQLQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GraphQLQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]any
+	if !data.Variables.IsNull() {
+		if err := json.Unmarshal([]byte(data.Variables.ValueString()), &variables); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("variables"), "Invalid variables", fmt.Sprintf("variables must be a JSON object, got error: %s", err))
+			return
+		}
+	}
+	result, err := d.client.RawGraphQL(ctx, data.Query.ValueString(), variables)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to run GraphQL query", err))
+		return
+	}
+	data.Result = types.StringValue(string(result))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// graphQLOperationType returns the type of the first operation in the GraphQL document,
+// i.e. query, mutation or subscription. The shorthand `{ ... }` is a query.
+func graphQLOperationType(document string) string {
+	for _, line := range strings.Split(document, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, operation := range []string{"mutation", "subscription"} {
+			if strings.HasPrefix(line, operation) {
+				return operation
+			}
+		}
+		return "query"
+	}
+	return "query"
+}
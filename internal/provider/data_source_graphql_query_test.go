@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphQLQueryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphQLQueryDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.shopify_graphql_query.test", "result", regexp.MustCompile(`"myshopifyDomain"`)),
+				),
+			},
+			{
+				Config:      testAccGraphQLQueryDataSourceMutationConfig,
+				ExpectError: regexp.MustCompile(`Only queries are allowed, got a mutation`),
+			},
+		},
+	})
+}
+
+const testAccGraphQLQueryDataSourceConfig = `
+data "shopify_graphql_query" "test" {
+  query = "{ shop { myshopifyDomain } }"
+}
+`
+
+const testAccGraphQLQueryDataSourceMutationConfig = `
+data "shopify_graphql_query" "test" {
+  query = "mutation { webPixelDelete(id: \"gid://shopify/WebPixel/1\") { deletedWebPixelId } }"
+}
+`
+
+func TestGraphQLOperationType(t *testing.T) {
+	tests := []struct {
+		document string
+		want     string
+	}{
+		{document: "{ shop { id } }", want: "query"},
+		{document: "query Shop { shop { id } }", want: "query"},
+		{document: "# comment\n  mutation { shopUpdate { shop { id } } }", want: "mutation"},
+		{document: "subscription { foo }", want: "subscription"},
+		{document: "", want: "query"},
+	}
+	for _, tt := range tests {
+		if got := graphQLOperationType(tt.document); got != tt.want {
+			t.Errorf("graphQLOperationType(%q) = %q, want %q", tt.document, got, tt.want)
+		}
+	}
+}
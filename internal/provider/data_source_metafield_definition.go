@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetafieldDefinitionDataSource{}
+
+// MetafieldDefinitionDataSource defines the data source implementation.
+type MetafieldDefinitionDataSource struct {
+	client *shopify.Client
+}
+
+func NewMetafieldDefinitionDataSource() datasource.DataSource {
+	return &MetafieldDefinitionDataSource{}
+}
+
+// MetafieldDefinitionDataSourceModel describes the data source data model.
+type MetafieldDefinitionDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	OwnerType types.String `tfsdk:"owner_type"`
+	Namespace types.String `tfsdk:"namespace"`
+	Key       types.String `tfsdk:"key"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+}
+
+func (d *MetafieldDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metafield_definition"
+}
+
+func (d *MetafieldDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves the ID of an existing metafield definition by owner type, namespace and key, " +
+			"e.g. to reference a definition that isn't managed by the configuration in the validations of another one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the metafield definition.",
+				Computed:            true,
+			},
+			"owner_type": schema.StringAttribute{
+				MarkdownDescription: "The resource type that the metafield definition is attached to, e.g. `PRODUCT`.",
+				Required:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The namespace of the metafield definition.",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the metafield definition.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The human-readable name of the metafield definition.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the metafield definition.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MetafieldDefinitionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *MetafieldDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetafieldDefinitionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := d.client.GetMetafieldDefinitionByKey(ctx, data.OwnerType.ValueString(), data.Namespace.ValueString(), data.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metafield definition", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Metafield definition not found",
+			fmt.Sprintf("No %s metafield definition has the namespace %q and the key %q.", data.OwnerType.ValueString(), data.Namespace.ValueString(), data.Key.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(definition.ID)
+	data.Name = types.StringValue(definition.Name)
+	data.Type = types.StringValue(definition.Type.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
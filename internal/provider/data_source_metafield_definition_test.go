@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetafieldDefinitionDataSource(t *testing.T) {
+	key := randResourceID(30)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetafieldDefinitionDataSourceConfig(key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.shopify_metafield_definition.test", "id", "shopify_metafield_definition.test", "id"),
+					resource.TestCheckResourceAttr("data.shopify_metafield_definition.test", "name", "Test"),
+					resource.TestCheckResourceAttr("data.shopify_metafield_definition.test", "type", "single_line_text_field"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetafieldDefinitionDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "shopify_metafield_definition" "test" {
+  owner_type = "PRODUCT"
+  namespace  = "custom"
+  key        = %[1]q
+}
+`, randResourceID(30)),
+				ExpectError: regexp.MustCompile(`Metafield definition not found`),
+			},
+		},
+	})
+}
+
+func testAccMetafieldDefinitionDataSourceConfig(key string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition" "test" {
+  key        = %[1]q
+  name       = "Test"
+  namespace  = "custom"
+  owner_type = "PRODUCT"
+  type       = "single_line_text_field"
+}
+
+data "shopify_metafield_definition" "test" {
+  owner_type = shopify_metafield_definition.test.owner_type
+  namespace  = shopify_metafield_definition.test.namespace
+  key        = shopify_metafield_definition.test.key
+}
+`, key)
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetaobjectDefinitionDataSource{}
+
+// MetaobjectDefinitionDataSource defines the data source implementation.
+type MetaobjectDefinitionDataSource struct {
+	client *shopify.Client
+}
+
+func NewMetaobjectDefinitionDataSource() datasource.DataSource {
+	return &MetaobjectDefinitionDataSource{}
+}
+
+// MetaobjectDefinitionDataSourceModel describes the data source data model.
+type MetaobjectDefinitionDataSourceModel struct {
+	ID               types.String                                `tfsdk:"id"`
+	Type             types.String                                `tfsdk:"type"`
+	Name             types.String                                `tfsdk:"name"`
+	Description      types.String                                `tfsdk:"description"`
+	DisplayNameKey   types.String                                `tfsdk:"display_name_key"`
+	AppOwned         types.Bool                                  `tfsdk:"app_owned"`
+	FieldDefinitions []*MetaobjectFieldDefinitionDataSourceModel `tfsdk:"field_definitions"`
+}
+
+// MetaobjectFieldDefinitionDataSourceModel has the attributes of the field definitions of the resource,
+// so that they can be copied to another definition.
+type MetaobjectFieldDefinitionDataSourceModel struct {
+	Key         types.String                          `tfsdk:"key"`
+	Name        types.String                          `tfsdk:"name"`
+	Description types.String                          `tfsdk:"description"`
+	Type        types.String                          `tfsdk:"type"`
+	Required    types.Bool                            `tfsdk:"required"`
+	Validations []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+}
+
+func (d *MetaobjectDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metaobject_definition"
+}
+
+func (d *MetaobjectDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads an existing metaobject definition by type. Its `field_definitions` can be used as the `field_definitions` " +
+			"of a `shopify_metaobject_definition` resource, e.g. to derive near-identical definitions without copying the fields.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the metaobject definition.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the metaobject definition.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The human-readable name of the metaobject definition.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the metaobject definition.",
+				Computed:            true,
+			},
+			"display_name_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the field used as the display name of the metaobjects.",
+				Computed:            true,
+			},
+			"app_owned": schema.BoolAttribute{
+				MarkdownDescription: "Whether the metaobject definition is owned by an app or is a standard definition of Shopify, " +
+					"in which case it shouldn't be adopted by a `shopify_metaobject_definition` resource.",
+				Computed: true,
+			},
+			"field_definitions": schema.ListNestedAttribute{
+				MarkdownDescription: "The fields of the metaobject definition.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the field.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The human-readable name of the field.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the field, null if empty.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The metafield type of the field.",
+							Computed:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether metaobjects require a value for the field.",
+							Computed:            true,
+						},
+						"validations": schema.ListNestedAttribute{
+							MarkdownDescription: "The validations of the values of the field, null if none.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name of the validation.",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value of the validation.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MetaobjectDefinitionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *MetaobjectDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetaobjectDefinitionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := d.client.GetMetaobjectDefinitionByType(ctx, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metaobject definition", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Metaobject definition not found", fmt.Sprintf("No metaobject definition has the type %q.", data.Type.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMetaobjectDefinitionToDataSourceModel(definition))...)
+}
+
+func convertMetaobjectDefinitionToDataSourceModel(definition *shopify.MetaobjectDefinition) *MetaobjectDefinitionDataSourceModel {
+	fieldDefinitions := make([]*MetaobjectFieldDefinitionDataSourceModel, 0, len(definition.FieldDefinitions))
+	for _, fieldDefinition := range definition.FieldDefinitions {
+		// Without a model, the field is converted as if it were configured without description
+		model := convertMetaobjectFieldDefinitionToModel(fieldDefinition, nil)
+		fieldDefinitions = append(fieldDefinitions, &MetaobjectFieldDefinitionDataSourceModel{
+			Key:         model.Key,
+			Name:        model.Name,
+			Description: model.Description,
+			Type:        model.Type,
+			Required:    model.Required,
+			Validations: model.Validations,
+		})
+	}
+	description := convert.EmptyAsNull(definition.Description, types.StringNull())
+	return &MetaobjectDefinitionDataSourceModel{
+		ID:               types.StringValue(definition.ID),
+		Type:             types.StringValue(definition.Type),
+		Name:             types.StringValue(definition.Name),
+		Description:      description,
+		DisplayNameKey:   types.StringPointerValue(definition.DisplayNameKey),
+		AppOwned:         types.BoolValue(definition.IsAppOwned()),
+		FieldDefinitions: fieldDefinitions,
+	}
+}
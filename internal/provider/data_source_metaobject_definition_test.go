@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+func TestAccMetaobjectDefinitionDataSource(t *testing.T) {
+	metaobjectType := randResourceID(60)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionDataSourceConfig(metaobjectType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.shopify_metaobject_definition.source", "id", "shopify_metaobject_definition.source", "id"),
+					resource.TestCheckResourceAttr("data.shopify_metaobject_definition.source", "field_definitions.#", "2"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.derived", "field_definitions.#", "2"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.derived", "field_definitions.1.validations.0.name", "max"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionDataSourceConfig(metaobjectType string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "source" {
+  name = "Review"
+  type = %[1]q
+  field_definitions = [
+    {
+      key  = "title"
+      name = "Title"
+      type = "single_line_text_field"
+    },
+    {
+      key  = "rating"
+      name = "Rating"
+      type = "number_integer"
+      validations = [
+        {
+          name  = "max"
+          value = "5"
+        }
+      ]
+    }
+  ]
+}
+
+data "shopify_metaobject_definition" "source" {
+  type = shopify_metaobject_definition.source.type
+}
+
+resource "shopify_metaobject_definition" "derived" {
+  name              = "Review (EU)"
+  type              = "%[1]s_eu"
+  field_definitions = data.shopify_metaobject_definition.source.field_definitions
+}
+`, metaobjectType)
+}
+
+func TestConvertMetaobjectDefinitionToDataSourceModel(t *testing.T) {
+	model := convertMetaobjectDefinitionToDataSourceModel(&shopify.MetaobjectDefinition{
+		ID:             "gid://shopify/MetaobjectDefinition/1",
+		Type:           "review",
+		Name:           "Review",
+		DisplayNameKey: utils.Ptr("title"),
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinition{
+			{Key: "title", Name: "Title", Type: &shopify.MetafieldDefinitionType{Name: "single_line_text_field"}, Required: true},
+		},
+	})
+	if !model.Description.IsNull() {
+		t.Errorf("expected the empty description to be null, got %s", model.Description)
+	}
+	if model.DisplayNameKey.ValueString() != "title" {
+		t.Errorf("unexpected display name key: %s", model.DisplayNameKey)
+	}
+	if model.AppOwned.ValueBool() {
+		t.Error("expected a custom definition not to be app-owned")
+	}
+	if len(model.FieldDefinitions) != 1 {
+		t.Fatalf("expected 1 field definition, got %d", len(model.FieldDefinitions))
+	}
+	field := model.FieldDefinitions[0]
+	if field.Key.ValueString() != "title" || !field.Description.IsNull() || !field.Required.ValueBool() || field.Validations != nil {
+		t.Errorf("unexpected field definition: %+v", field)
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetaobjectsDataSource{}
+
+// MetaobjectsDataSource defines the data source implementation.
+type MetaobjectsDataSource struct {
+	client *shopify.Client
+}
+
+func NewMetaobjectsDataSource() datasource.DataSource {
+	return &MetaobjectsDataSource{}
+}
+
+// MetaobjectsDataSourceModel describes the data source data model.
+type MetaobjectsDataSourceModel struct {
+	Type        types.String       `tfsdk:"type"`
+	Metaobjects []*MetaobjectModel `tfsdk:"metaobjects"`
+}
+
+type MetaobjectModel struct {
+	ID     types.String `tfsdk:"id"`
+	Handle types.String `tfsdk:"handle"`
+}
+
+func (d *MetaobjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metaobjects"
+}
+
+func (d *MetaobjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the metaobject entries of a type, e.g. to generate `import` blocks when adopting the custom data of an existing store.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the metaobject definition.",
+				Required:            true,
+			},
+			"metaobjects": schema.ListNestedAttribute{
+				MarkdownDescription: "The metaobject entries of the type.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the metaobject entry.",
+							Computed:            true,
+						},
+						"handle": schema.StringAttribute{
+							MarkdownDescription: "The unique handle of the metaobject entry.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MetaobjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *MetaobjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetaobjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaobjects, err := d.client.ListMetaobjects(ctx, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to list metaobjects", err))
+		return
+	}
+	data.Metaobjects = make([]*MetaobjectModel, 0, len(metaobjects))
+	for _, metaobject := range metaobjects {
+		data.Metaobjects = append(data.Metaobjects, &MetaobjectModel{
+			ID:     types.StringValue(metaobject.ID),
+			Handle: types.StringValue(metaobject.Handle),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
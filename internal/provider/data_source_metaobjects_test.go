@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetaobjectsDataSource(t *testing.T) {
+	metaobjectType := envOrSkip(t, "SHOPIFY_TEST_METAOBJECT_TYPE")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "shopify_metaobjects" "test" {
+  type = "` + metaobjectType + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.shopify_metaobjects.test", "metaobjects.0.id"),
+					resource.TestCheckResourceAttrSet("data.shopify_metaobjects.test", "metaobjects.0.handle"),
+				),
+			},
+		},
+	})
+}
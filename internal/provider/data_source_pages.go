@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PagesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &PagesDataSource{}
+
+var pagePublishedStatuses = []string{"published", "unpublished", "any"}
+
+// PagesDataSource defines the data source implementation.
+type PagesDataSource struct {
+	client *shopify.Client
+}
+
+func NewPagesDataSource() datasource.DataSource {
+	return &PagesDataSource{}
+}
+
+// PagesDataSourceModel describes the data source data model.
+type PagesDataSourceModel struct {
+	Handle          types.String `tfsdk:"handle"`
+	Title           types.String `tfsdk:"title"`
+	PublishedStatus types.String `tfsdk:"published_status"`
+	Pages           []*PageModel `tfsdk:"pages"`
+}
+
+type PageModel struct {
+	ID          types.String `tfsdk:"id"`
+	Handle      types.String `tfsdk:"handle"`
+	Title       types.String `tfsdk:"title"`
+	PublishedAt types.String `tfsdk:"published_at"`
+}
+
+func (d *PagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pages"
+}
+
+func (d *PagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the pages of the online store, e.g. to look up a page by its handle or to generate `import` blocks for the existing pages.",
+		Attributes: map[string]schema.Attribute{
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "Only list the page with the handle.",
+				Optional:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Only list the pages with the title.",
+				Optional:            true,
+			},
+			"published_status": schema.StringAttribute{
+				MarkdownDescription: "Only list the pages with the published status, one of `published`, `unpublished` and `any`. Defaults to `any`.",
+				Optional:            true,
+			},
+			"pages": schema.ListNestedAttribute{
+				MarkdownDescription: "The pages matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The numeric ID of the page.",
+							Computed:            true,
+						},
+						"handle": schema.StringAttribute{
+							MarkdownDescription: "The handle of the page.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the page.",
+							Computed:            true,
+						},
+						"published_at": schema.StringAttribute{
+							MarkdownDescription: "The date and time (RFC3339 format in UTC) when the page was published, null if it's unpublished.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *PagesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data PagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PublishedStatus.IsNull() && !data.PublishedStatus.IsUnknown() && !slices.Contains(pagePublishedStatuses, data.PublishedStatus.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("published_status"),
+			"Invalid published_status",
+			fmt.Sprintf("published_status must be one of %s, got %q", strings.Join(pagePublishedStatuses, ", "), data.PublishedStatus.ValueString()),
+		)
+	}
+}
+
+func (d *PagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pages, err := d.client.ListAllPages(ctx, shopify.PageListFilter{
+		Handle:          data.Handle.ValueString(),
+		Title:           data.Title.ValueString(),
+		PublishedStatus: data.PublishedStatus.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to list pages", err))
+		return
+	}
+	data.Pages = make([]*PageModel, 0, len(pages))
+	for _, page := range pages {
+		data.Pages = append(data.Pages, &PageModel{
+			ID:          types.StringValue(strconv.FormatUint(page.Id, 10)),
+			Handle:      types.StringValue(page.Handle),
+			Title:       types.StringValue(page.Title),
+			PublishedAt: convertTimeToModel(page.PublishedAt, types.StringNull()),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
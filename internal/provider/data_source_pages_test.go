@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPagesDataSource(t *testing.T) {
+	pageHandle := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPagesDataSourceConfig(pageHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.shopify_pages.test", "pages.#", "1"),
+					resource.TestCheckResourceAttrPair("data.shopify_pages.test", "pages.0.id", "shopify_page.test", "id"),
+					resource.TestCheckResourceAttr("data.shopify_pages.test", "pages.0.handle", pageHandle),
+					resource.TestCheckResourceAttr("data.shopify_pages.test", "pages.0.title", "Test page"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPagesDataSourceConfig(handle string) string {
+	return fmt.Sprintf(`
+resource "shopify_page" "test" {
+  handle    = %[1]q
+  author    = "Author"
+  title     = "Test page"
+  body_html = "<p>Test</p>"
+}
+
+data "shopify_pages" "test" {
+  handle = shopify_page.test.handle
+}
+`, handle)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProviderConfigDataSource{}
+
+// ProviderConfigDataSource defines the data source implementation.
+type ProviderConfigDataSource struct {
+	client *shopify.Client
+}
+
+func NewProviderConfigDataSource() datasource.DataSource {
+	return &ProviderConfigDataSource{}
+}
+
+// ProviderConfigDataSourceModel describes the data source data model.
+type ProviderConfigDataSourceModel struct {
+	Shop                   types.String `tfsdk:"shop"`
+	APIVersion             types.String `tfsdk:"api_version"`
+	AccessTokenFingerprint types.String `tfsdk:"access_token_fingerprint"`
+}
+
+func (d *ProviderConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_config"
+}
+
+func (d *ProviderConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides the configuration the provider has resolved from its attributes and environment variables. Useful to confirm which shop and API version a run targets.",
+		Attributes: map[string]schema.Attribute{
+			"shop": schema.StringAttribute{
+				MarkdownDescription: "The myshopify domain of the shop, e.g. `theshop.myshopify.com`.",
+				Computed:            true,
+			},
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: "The Shopify API version.",
+				Computed:            true,
+			},
+			"access_token_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "A fingerprint of the Admin API access token, i.e. the first 12 characters of its SHA-256 hash, to tell tokens apart without exposing them.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProviderConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (d *ProviderConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config := d.client.Config()
+	data := ProviderConfigDataSourceModel{
+		Shop:                   types.StringValue(goshopify.ShopFullName(config.Shop)),
+		APIVersion:             types.StringValue(config.APIVersion),
+		AccessTokenFingerprint: types.StringValue(accessTokenFingerprint(config.AccessToken)),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func accessTokenFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])[:12]
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProviderConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.shopify_provider_config.test", "shop", goshopify.ShopFullName(os.Getenv("SHOPIFY_SHOP"))),
+					resource.TestCheckResourceAttr("data.shopify_provider_config.test", "api_version", os.Getenv("SHOPIFY_API_VERSION")),
+					resource.TestCheckResourceAttr("data.shopify_provider_config.test", "access_token_fingerprint", accessTokenFingerprint(os.Getenv("SHOPIFY_ADMIN_API_ACCESS_TOKEN"))),
+				),
+			},
+		},
+	})
+}
+
+const testAccProviderConfigDataSourceConfig = `
+data "shopify_provider_config" "test" {}
+`
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// diagFromClientError returns the diagnostic of the error of the client, e.g. "Unable to read page" for the summary "Unable to read page".
+// The detail starts with the code of the error, e.g. [SHOPIFY_THROTTLED], so that the failures can be searched for and alerted on by their cause.
+func diagFromClientError(summary string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("[%s] %s, got error: %s", shopify.ErrorCode(err), summary, err))
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestDiagFromClientError(t *testing.T) {
+	diagnostic := diagFromClientError("Unable to create page", &shopify.ValidationError{FieldErrors: map[string][]string{"handle": {"has already been taken"}}})
+	if diagnostic.Summary() != "Client Error" {
+		t.Errorf("unexpected summary %q", diagnostic.Summary())
+	}
+	if want := "[SHOPIFY_VALIDATION] Unable to create page, got error: handle: has already been taken"; diagnostic.Detail() != want {
+		t.Errorf("got detail %q, want %q", diagnostic.Detail(), want)
+	}
+
+	diagnostic = diagFromClientError("Unable to read page", shopify.ErrReadOnly)
+	if !strings.HasPrefix(diagnostic.Detail(), "[SHOPIFY_READ_ONLY] ") {
+		t.Errorf("expected the detail to start with the code, got %q", diagnostic.Detail())
+	}
+
+	// The errors of the rows of a set are joined, one per line, and keep their code
+	diagnostic = diagFromClientError("Unable to delete 2 metafields", errors.Join(
+		fmt.Errorf("a: %w", shopify.ErrReadOnly),
+		fmt.Errorf("b: %w", shopify.ErrReadOnly),
+	))
+	if want := "[SHOPIFY_READ_ONLY] Unable to delete 2 metafields, got error: a: " + shopify.ErrReadOnly.Error() + "\nb: " + shopify.ErrReadOnly.Error(); diagnostic.Detail() != want {
+		t.Errorf("got detail %q, want %q", diagnostic.Detail(), want)
+	}
+}
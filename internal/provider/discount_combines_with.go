@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// DiscountCombinesWithModel describes the classes of discounts a discount can be combined with.
+type DiscountCombinesWithModel struct {
+	OrderDiscounts    types.Bool `tfsdk:"order_discounts"`
+	ProductDiscounts  types.Bool `tfsdk:"product_discounts"`
+	ShippingDiscounts types.Bool `tfsdk:"shipping_discounts"`
+}
+
+// discountCombinesWithAttribute returns the combines_with attribute shared by the discount resources.
+func discountCombinesWithAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The classes of discounts the discount can be combined with. The discount can't be combined with any when unset. " +
+			"A shipping discount can't be combined with other shipping discounts.",
+		Attributes: map[string]schema.Attribute{
+			"order_discounts": schema.BoolAttribute{
+				MarkdownDescription: "Whether the discount can be combined with order discounts.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"product_discounts": schema.BoolAttribute{
+				MarkdownDescription: "Whether the discount can be combined with product discounts.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"shipping_discounts": schema.BoolAttribute{
+				MarkdownDescription: "Whether the discount can be combined with shipping discounts.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Optional: true,
+	}
+}
+
+// validateDiscountCombinesWith checks that the combinations are allowed for the class of the discount,
+// e.g. SHIPPING for a shipping discount. Nothing is checked for an unknown class.
+func validateDiscountCombinesWith(combinesWith *DiscountCombinesWithModel, discountClass string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if combinesWith == nil {
+		return diags
+	}
+	if discountClass == shopify.DiscountClassShipping && combinesWith.ShippingDiscounts.ValueBool() {
+		diags.AddAttributeError(path.Root("combines_with").AtName("shipping_discounts"), "Invalid combines_with",
+			"A shipping discount can't be combined with other shipping discounts.")
+	}
+	return diags
+}
+
+// convertDiscountCombinesWithModelToInput returns the combinations to send, none when combines_with is unset.
+func convertDiscountCombinesWithModelToInput(combinesWith *DiscountCombinesWithModel) *shopify.DiscountCombinesWith {
+	if combinesWith == nil {
+		return &shopify.DiscountCombinesWith{}
+	}
+	return &shopify.DiscountCombinesWith{
+		OrderDiscounts:    combinesWith.OrderDiscounts.ValueBool(),
+		ProductDiscounts:  combinesWith.ProductDiscounts.ValueBool(),
+		ShippingDiscounts: combinesWith.ShippingDiscounts.ValueBool(),
+	}
+}
+
+// convertDiscountCombinesWithToModel keeps combines_with unset when it's unset in the configuration
+// and the discount can't be combined with any other, not to produce a diff.
+func convertDiscountCombinesWithToModel(combinesWith *shopify.DiscountCombinesWith, configured *DiscountCombinesWithModel) *DiscountCombinesWithModel {
+	c := combinesWith
+	if c == nil || (configured == nil && !c.OrderDiscounts && !c.ProductDiscounts && !c.ShippingDiscounts) {
+		return nil
+	}
+	return &DiscountCombinesWithModel{
+		OrderDiscounts:    types.BoolValue(c.OrderDiscounts),
+		ProductDiscounts:  types.BoolValue(c.ProductDiscounts),
+		ShippingDiscounts: types.BoolValue(c.ShippingDiscounts),
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestValidateDiscountCombinesWith(t *testing.T) {
+	combinesWithShipping := &DiscountCombinesWithModel{
+		OrderDiscounts:    types.BoolValue(false),
+		ProductDiscounts:  types.BoolValue(true),
+		ShippingDiscounts: types.BoolValue(true),
+	}
+	tests := []struct {
+		name          string
+		combinesWith  *DiscountCombinesWithModel
+		discountClass string
+		wantErr       bool
+	}{
+		{name: "product discount", combinesWith: combinesWithShipping, discountClass: shopify.DiscountClassProduct},
+		{name: "shipping discount", combinesWith: combinesWithShipping, discountClass: shopify.DiscountClassShipping, wantErr: true},
+		{name: "unknown class", combinesWith: combinesWithShipping},
+		{name: "unset", discountClass: shopify.DiscountClassShipping},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateDiscountCombinesWith(tt.combinesWith, tt.discountClass).HasError(); got != tt.wantErr {
+				t.Errorf("got error %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConvertDiscountCombinesWithToModel(t *testing.T) {
+	if got := convertDiscountCombinesWithToModel(&shopify.DiscountCombinesWith{}, nil); got != nil {
+		t.Errorf("expected combines_with to stay unset, got %+v", got)
+	}
+	got := convertDiscountCombinesWithToModel(&shopify.DiscountCombinesWith{OrderDiscounts: true}, nil)
+	if got == nil || !got.OrderDiscounts.ValueBool() || got.ShippingDiscounts.ValueBool() {
+		t.Errorf("expected the combinations to be read, got %+v", got)
+	}
+	input := convertDiscountCombinesWithModelToInput(nil)
+	if input == nil || input.OrderDiscounts || input.ProductDiscounts || input.ShippingDiscounts {
+		t.Errorf("expected no combination to be sent when unset, got %+v", input)
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/pkg/xslice"
+)
+
+// FunctionMetafieldModel describes a metafield of an object backed by a Shopify Function, e.g. an app discount,
+// which the function reads its configuration from.
+type FunctionMetafieldModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Key       types.String `tfsdk:"key"`
+	Type      types.String `tfsdk:"type"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// functionMetafieldsAttribute returns the attribute of the metafields of an object backed by a Shopify Function.
+func functionMetafieldsAttribute(description string, planModifiers ...planmodifier.List) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"namespace": schema.StringAttribute{
+					MarkdownDescription: "The namespace of the metafield.",
+					Required:            true,
+				},
+				"key": schema.StringAttribute{
+					MarkdownDescription: "The key of the metafield.",
+					Required:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the metafield, e.g. `json`.",
+					Required:            true,
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "The value of the metafield. JSON values are compared semantically.",
+					Required:            true,
+				},
+			},
+		},
+		Optional:      true,
+		PlanModifiers: planModifiers,
+	}
+}
+
+// validateFunctionMetafields checks that no metafield is configured more than once.
+func validateFunctionMetafields(metafields []*FunctionMetafieldModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := make(map[string]bool, len(metafields))
+	for i, metafield := range metafields {
+		if metafield.Namespace.IsUnknown() || metafield.Key.IsUnknown() {
+			continue
+		}
+		id := metafield.Namespace.ValueString() + "." + metafield.Key.ValueString()
+		if seen[id] {
+			diags.AddAttributeError(path.Root("metafields").AtListIndex(i), "Duplicate metafield",
+				fmt.Sprintf("The metafield %s is configured more than once.", id))
+		}
+		seen[id] = true
+	}
+	return diags
+}
+
+func convertFunctionMetafieldModelsToInputs(models []*FunctionMetafieldModel) []*shopify.MetafieldInput {
+	var inputs []*shopify.MetafieldInput
+	for _, metafield := range models {
+		inputs = append(inputs, &shopify.MetafieldInput{
+			Namespace: metafield.Namespace.ValueString(),
+			Key:       metafield.Key.ValueString(),
+			Type:      metafield.Type.ValueString(),
+			Value:     metafield.Value.ValueString(),
+		})
+	}
+	return inputs
+}
+
+// convertMetafieldsToFunctionMetafieldModels tracks only the configured metafields, in the configured order,
+// so that the metafields set by the app itself aren't managed. A deleted one is dropped to be set again.
+func convertMetafieldsToFunctionMetafieldModels(metafields []*shopify.Metafield, configured []*FunctionMetafieldModel) []*FunctionMetafieldModel {
+	var models []*FunctionMetafieldModel
+	if configured != nil {
+		models = make([]*FunctionMetafieldModel, 0, len(configured))
+	}
+	for _, model := range configured {
+		metafield, ok := xslice.FindBy(metafields, func(v *shopify.Metafield) bool {
+			return v.Namespace == model.Namespace.ValueString() && v.Key == model.Key.ValueString()
+		})
+		if !ok {
+			continue
+		}
+		models = append(models, &FunctionMetafieldModel{
+			Namespace: types.StringValue(metafield.Namespace),
+			Key:       types.StringValue(metafield.Key),
+			Type:      types.StringValue(metafield.Type),
+			Value:     convertMetafieldValueToModel(metafield.Value, model.Value),
+		})
+	}
+	return models
+}
+
+// validateShopifyFunction checks that the function exists and has one of the API types, e.g. a discount function.
+func validateShopifyFunction(functionID string, function *shopify.ShopifyFunction, kind string, apiTypes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if function == nil {
+		diags.AddAttributeError(path.Root("function_id"), "Shopify Function not found",
+			fmt.Sprintf("No Shopify Function has the ID %q. The app of the function must be installed on the shop.", functionID))
+		return diags
+	}
+	if !slices.Contains(apiTypes, function.APIType) {
+		diags.AddAttributeError(path.Root("function_id"), "Invalid function_id",
+			fmt.Sprintf("The Shopify Function %q has the API type %q, expected %s function of one of the API types %s.",
+				function.Title, function.APIType, kind, strings.Join(apiTypes, ", ")))
+	}
+	return diags
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// operationLogMu serializes the writes to the operation log files, which may be shared by several provider configurations.
+var operationLogMu sync.Mutex
+
+// operationLogRecord is a line of the operation log file.
+type operationLogRecord struct {
+	Resource  string    `json:"resource"`
+	Action    string    `json:"action"`
+	ID        string    `json:"id,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Success   bool      `json:"success"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// openOperationLog checks that the operation log file can be appended to, creating it if needed.
+func openOperationLog(name string) error {
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// operationLogger returns the hook appending every operation to the file as a JSON line.
+// Failing to write a record doesn't fail the operation, which has already changed the shop.
+func operationLogger(name string) func(shopify.Operation) {
+	return func(op shopify.Operation) {
+		record := operationLogRecord{
+			Resource:  op.Resource,
+			Action:    op.Action,
+			ID:        op.ID,
+			Timestamp: op.Time.UTC(),
+			Success:   op.Err == nil,
+		}
+		if op.Err != nil {
+			record.Error = op.Err.Error()
+		}
+		line, err := json.Marshal(record)
+		if err != nil {
+			return
+		}
+
+		operationLogMu.Lock()
+		defer operationLogMu.Unlock()
+		f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return
+		}
+		defer f.Close()
+		_, _ = f.Write(append(line, '\n'))
+	}
+}
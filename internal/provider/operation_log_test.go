@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestOperationLogger(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "operations.jsonl")
+	if err := openOperationLog(name); err != nil {
+		t.Fatal(err)
+	}
+	logOperation := operationLogger(name)
+
+	// Concurrent operations must not interleave their lines
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			if i%2 == 1 {
+				err = errors.New("boom")
+			}
+			logOperation(shopify.Operation{Resource: "page", Action: "Update", ID: "gid://shopify/Page/1", Time: time.Now(), Err: err})
+		}(i)
+	}
+	wg.Wait()
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var lines, failures int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record operationLogRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid line %q: %s", scanner.Text(), err)
+		}
+		if record.Resource != "page" || record.Action != "Update" || record.ID != "gid://shopify/Page/1" {
+			t.Errorf("unexpected record: %+v", record)
+		}
+		if !record.Success {
+			failures++
+			if record.Error != "boom" {
+				t.Errorf("expected the error of the failed operation, got %q", record.Error)
+			}
+		}
+		lines++
+	}
+	if lines != 50 || failures != 25 {
+		t.Errorf("expected 50 records with 25 failures, got %d records with %d failures", lines, failures)
+	}
+}
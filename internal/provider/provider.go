@@ -5,12 +5,23 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	goshopify "github.com/bold-commerce/go-shopify/v4"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,9 +30,26 @@ import (
 	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
 )
 
+const (
+	// DefaultAPIVersion is the Shopify API version used when api_version is not configured.
+	DefaultAPIVersion = "2026-07"
+	// LatestAPIVersion can be set as api_version to use the latest stable Shopify API version.
+	LatestAPIVersion = "latest"
+)
+
+const (
+	// authModeCustom is the auth mode of a custom app, whose Admin API access token is static.
+	authModeCustom = "custom"
+	// authModeOAuth is the auth mode of a public app, whose access token is obtained by the OAuth flow beforehand.
+	authModeOAuth = "oauth"
+)
+
+var authModes = []string{authModeCustom, authModeOAuth}
+
 // Ensure ShopifyProvider satisfies various provider interfaces.
 var _ provider.Provider = &ShopifyProvider{}
 var _ provider.ProviderWithFunctions = &ShopifyProvider{}
+var _ provider.ProviderWithValidateConfig = &ShopifyProvider{}
 
 // ShopifyProvider defines the provider implementation.
 type ShopifyProvider struct {
@@ -34,10 +62,17 @@ type ShopifyProvider struct {
 // ShopifyProviderModel describes the provider data model.
 type ShopifyProviderModel struct {
 	Shop                types.String `tfsdk:"shop"`
+	APIHost             types.String `tfsdk:"api_host"`
 	APIVersion          types.String `tfsdk:"api_version"`
+	AuthMode            types.String `tfsdk:"auth_mode"`
 	APIKey              types.String `tfsdk:"api_key"`
 	APISecretKey        types.String `tfsdk:"api_secret_key"`
 	AdminAPIAccessToken types.String `tfsdk:"admin_api_access_token"`
+	RefreshToken        types.String `tfsdk:"refresh_token"`
+	MaxConcurrency      types.Int64  `tfsdk:"max_concurrency"`
+	VerifyConnection    types.Bool   `tfsdk:"verify_connection"`
+	ReadOnly            types.Bool   `tfsdk:"read_only"`
+	OperationLogFile    types.String `tfsdk:"operation_log_file"`
 }
 
 func (p *ShopifyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,13 +82,42 @@ func (p *ShopifyProvider) Metadata(ctx context.Context, req provider.MetadataReq
 
 func (p *ShopifyProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the resources of a Shopify shop through the Admin API.\n\n" +
+			"The provider authenticates as an app installed on the shop, in one of two modes set by `auth_mode`:\n\n" +
+			"- `custom`: a custom app created in the Shopify admin. Its Admin API access token is shown once the app is installed " +
+			"and doesn't expire.\n" +
+			"- `oauth`: a public app. The provider doesn't run the OAuth flow, so obtain an offline access token with the authorization " +
+			"code grant beforehand and set it as `admin_api_access_token`. The API key and the API secret key of the public app are required " +
+			"as well, to identify the app the token was issued to. For an expiring offline token, set its `refresh_token` as well, " +
+			"so that the provider refreshes the access token when it expires during a run.\n\n" +
+			"In both modes the access scopes granted to the app must cover the managed resources, e.g. `write_products` for " +
+			"the collection publications and the smart collections, `write_metaobject_definitions` for the metaobject definitions, `write_content` for the pages " +
+			"and `write_orders` for the order tags and risks. The scopes of a custom app are configured in the Shopify admin, " +
+			"and the scopes of a public app are requested in the OAuth flow.\n\n" +
+			"Throttled requests are retried. A request failing without telling whether Shopify applied it, e.g. timing out, is retried " +
+			"only if applying it twice is safe: setting metafields, upserting metaobjects, publishing and unpublishing, publishing themes, " +
+			"disabling privacy features and creating subscription billing attempts, whose `idempotency_key` makes Shopify return the attempt " +
+			"already created. The other creates aren't retried and fail asking to verify in the Shopify admin whether the object has been " +
+			"created, and to import it if so, rather than risking a duplicate.\n\n" +
+			"The errors of Shopify start with a code classifying their cause, e.g. `[SHOPIFY_THROTTLED]`: `SHOPIFY_READ_ONLY`, " +
+			"`SHOPIFY_UNAUTHORIZED`, `SHOPIFY_SCOPE_MISSING`, `SHOPIFY_THROTTLED`, `SHOPIFY_AMBIGUOUS`, `SHOPIFY_NOT_FOUND`, " +
+			"`SHOPIFY_VALIDATION` or `SHOPIFY_UNKNOWN`.",
 		Attributes: map[string]schema.Attribute{
 			"shop": schema.StringAttribute{
-				MarkdownDescription: "The shopName parameter is the shop's myshopify domain, e.g. `theshop.myshopify.com`, or simply `theshop`. Defaults to the env variable `SHOPIFY_SHOP`.",
+				MarkdownDescription: "The shopName parameter is the shop's myshopify domain, e.g. `theshop.myshopify.com`, or simply `theshop`. A URL of the shop, e.g. `https://theshop.myshopify.com/admin`, is normalized to its domain with a warning. Defaults to the env variable `SHOPIFY_SHOP`.",
 				Optional:            true,
 			},
+			"api_host": schema.StringAttribute{
+				MarkdownDescription: "The host to send the Admin API requests to instead of the myshopify domain of the shop, e.g. `shopify-proxy.example.com` or `localhost:8443`, " +
+					"for a proxy of the Admin API or a sandbox environment. `shop` still identifies the shop. Defaults to the env variable `SHOPIFY_API_HOST`, then to the myshopify domain of the shop.",
+				Optional: true,
+			},
 			"api_version": schema.StringAttribute{
-				MarkdownDescription: "Shopify API version. Defaults to the env variable `SHOPIFY_API_VERSION`.",
+				MarkdownDescription: "Shopify API version, e.g. `" + DefaultAPIVersion + "`, or `" + LatestAPIVersion + "` to use the latest stable version. Defaults to the env variable `SHOPIFY_API_VERSION`, then to `" + DefaultAPIVersion + "`. Resources with their own `api_version`, e.g. `shopify_page`, use it instead.",
+				Optional:            true,
+			},
+			"auth_mode": schema.StringAttribute{
+				MarkdownDescription: "How the app authenticates, `custom` for a custom app with a static access token, or `oauth` for a public app with an access token obtained by the OAuth flow. Defaults to the env variable `SHOPIFY_AUTH_MODE`, then to `custom`.",
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
@@ -70,10 +134,65 @@ func (p *ShopifyProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token of an expiring offline access token, only with `auth_mode` set to `oauth`. When Shopify rejects the access token, e.g. once it has expired during a long apply, " +
+					"the provider exchanges the refresh token for a new access token with the API key and the API secret key of the app, and sends the rejected request again. " +
+					"The new access token is used for the rest of the run, but isn't saved anywhere. Defaults to the env variable `SHOPIFY_REFRESH_TOKEN`, and the access token isn't refreshed when unset.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of operations modifying the shop that run at once, independently of Terraform's `-parallelism`. Useful to limit the load on a shared store. Unlimited by default.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to refuse every change to the shop, e.g. to run `terraform plan` against a production store with the guarantee that an `apply` can't modify it. Every request modifying the shop fails, while data sources and refreshing still work. Defaults to `false`.",
+				Optional:            true,
+			},
+			"operation_log_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a file to append a JSON line to for every request modifying the shop, with the `resource`, the `action`, the `id` of the changed object, the `timestamp` and whether it succeeded in `success` and `error`. " +
+					"Gives an audit trail of what an apply has changed, independently of the Terraform logs. Defaults to the env variable `SHOPIFY_OPERATION_LOG_FILE`, and no file is written when unset.",
+				Optional: true,
+			},
+			"verify_connection": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check the connection to the shop and the credentials when the provider is configured, so that they fail before any resource is touched. Defaults to `true`.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
+func (p *ShopifyProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data ShopifyProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AuthMode.IsNull() && !data.AuthMode.IsUnknown() && !slices.Contains(authModes, data.AuthMode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_mode"),
+			"Invalid auth_mode",
+			fmt.Sprintf("auth_mode must be one of %s, got %q", strings.Join(authModes, ", "), data.AuthMode.ValueString()),
+		)
+	}
+	if !data.APIHost.IsNull() && !data.APIHost.IsUnknown() {
+		if err := validateAPIHost(data.APIHost.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_host"), "Invalid api_host", err.Error())
+		}
+	}
+	if !data.RefreshToken.IsNull() && !data.AuthMode.IsNull() && !data.AuthMode.IsUnknown() && data.AuthMode.ValueString() != authModeOAuth {
+		resp.Diagnostics.AddAttributeError(path.Root("refresh_token"), "Invalid refresh_token",
+			fmt.Sprintf("refresh_token only applies to auth_mode %q, the access token of a custom app doesn't expire.", authModeOAuth))
+	}
+	if missing := missingCredentials(data); len(missing) > 0 {
+		resp.Diagnostics.AddError(
+			"Incomplete credentials",
+			fmt.Sprintf("api_key, api_secret_key and admin_api_access_token must be set together, but %s is not set in the provider configuration nor in the environment.", strings.Join(missing, ", ")),
+		)
+	}
+}
+
 func (p *ShopifyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data ShopifyProviderModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -84,43 +203,98 @@ func (p *ShopifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 	shop := readOrEnvDefault(data.Shop, "SHOPIFY_SHOP")
 	if shop == "" {
 		resp.Diagnostics.AddError("Unable to find shop", "shop cannot be an empty string")
+	} else if normalizedShop, fixed, err := normalizeShop(shop); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("shop"), "Invalid shop", err.Error())
+	} else {
+		if fixed {
+			resp.Diagnostics.AddAttributeWarning(path.Root("shop"), "shop has been normalized",
+				fmt.Sprintf("shop is expected to be the myshopify domain of the shop or its name, got %q. Using %q instead.", shop, normalizedShop))
+		}
+		shop = normalizedShop
 	}
-	apiVersion := readOrEnvDefault(data.APIVersion, "SHOPIFY_API_VERSION")
+	apiHost := readOrEnvDefault(data.APIHost, "SHOPIFY_API_HOST")
+	if apiHost != "" {
+		if err := validateAPIHost(apiHost); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_host"), "Invalid api_host", err.Error())
+		}
+	}
+	apiVersion := resolveAPIVersion(readOrEnvDefault(data.APIVersion, "SHOPIFY_API_VERSION"))
 	if apiVersion == "" {
-		resp.Diagnostics.AddError("Unable to find api_version", "api_version cannot be an empty string")
+		apiVersion = DefaultAPIVersion
+		resp.Diagnostics.AddWarning(
+			"api_version is not set",
+			fmt.Sprintf("Falling back to the default Shopify API version %s, which may change in future provider releases. Set api_version or the env variable SHOPIFY_API_VERSION to pin it.", apiVersion),
+		)
+	}
+	authMode := readOrEnvDefault(data.AuthMode, "SHOPIFY_AUTH_MODE")
+	if authMode == "" {
+		authMode = authModeCustom
+	}
+	if !slices.Contains(authModes, authMode) {
+		resp.Diagnostics.AddError("Invalid auth_mode", fmt.Sprintf("auth_mode must be one of %s, got %q", strings.Join(authModes, ", "), authMode))
 	}
 	apiKey := readOrEnvDefault(data.APIKey, "SHOPIFY_API_KEY")
 	if apiKey == "" {
-		resp.Diagnostics.AddError("Unable to find api_key", "api_key cannot be an empty string")
+		resp.Diagnostics.AddError("Unable to find api_key", "api_key cannot be an empty string"+oauthCredentialHint(authMode))
 	}
 	apiSecretKey := readOrEnvDefault(data.APISecretKey, "SHOPIFY_API_SECRET_KEY")
 	if apiSecretKey == "" {
-		resp.Diagnostics.AddError("Unable to find api_secret_key", "api_secret_key cannot be an empty string")
+		resp.Diagnostics.AddError("Unable to find api_secret_key", "api_secret_key cannot be an empty string"+oauthCredentialHint(authMode))
 	}
 	adminAPIAccessToken := readOrEnvDefault(data.AdminAPIAccessToken, "SHOPIFY_ADMIN_API_ACCESS_TOKEN")
 	if adminAPIAccessToken == "" {
-		resp.Diagnostics.AddError("Unable to find admin_api_access_token", "admin_api_access_token cannot be an empty string")
+		detail := "admin_api_access_token cannot be an empty string"
+		if authMode == authModeOAuth {
+			detail += ". In oauth mode, set it to the access token obtained by the OAuth flow of the app, which the provider doesn't run itself."
+		}
+		resp.Diagnostics.AddError("Unable to find admin_api_access_token", detail)
+	}
+
+	refreshToken := readOrEnvDefault(data.RefreshToken, "SHOPIFY_REFRESH_TOKEN")
+	if refreshToken != "" && authMode != authModeOAuth {
+		resp.Diagnostics.AddAttributeError(path.Root("refresh_token"), "Invalid refresh_token",
+			fmt.Sprintf("refresh_token only applies to auth_mode %q, the access token of a custom app doesn't expire.", authModeOAuth))
+	}
+
+	if !data.MaxConcurrency.IsNull() && data.MaxConcurrency.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_concurrency"), "Invalid max_concurrency", "max_concurrency must be at least 1")
+	}
+
+	var onOperation func(shopify.Operation)
+	if operationLogFile := readOrEnvDefault(data.OperationLogFile, "SHOPIFY_OPERATION_LOG_FILE"); operationLogFile != "" {
+		if err := openOperationLog(operationLogFile); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("operation_log_file"), "Unable to open operation_log_file", err.Error())
+		}
+		onOperation = operationLogger(operationLogFile)
 	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	opts := []goshopify.Option{goshopify.WithVersion(apiVersion)}
-	httpClient := http.DefaultClient
-	httpClient.Transport = utils.NewDebugTransport(http.DefaultTransport)
-	opts = append(opts, goshopify.WithHTTPClient(httpClient))
-
+	transport := utils.NewTransport(http.DefaultTransport)
+	if apiHost != "" {
+		transport = utils.NewHostTransport(transport, goshopify.ShopFullName(shop), apiHost)
+	}
+	if refreshToken != "" {
+		refresher := shopify.NewTokenRefresher(&http.Client{Transport: transport}, goshopify.ShopFullName(shop), apiKey, apiSecretKey, refreshToken)
+		transport = utils.NewTokenRefreshTransport(transport, adminAPIAccessToken, refresher.Refresh)
+	}
+	httpClient := &http.Client{Transport: transport}
 	app := goshopify.App{
 		ApiKey:    apiKey,
 		ApiSecret: apiSecretKey,
 	}
-	shopifyRawClient, err := goshopify.NewClient(
-		app,
-		shop,
-		adminAPIAccessToken,
-		opts...,
-	)
+	newRawClient := func(apiVersion string) (*goshopify.Client, error) {
+		return goshopify.NewClient(
+			app,
+			shop,
+			adminAPIAccessToken,
+			goshopify.WithVersion(apiVersion),
+			goshopify.WithHTTPClient(httpClient),
+		)
+	}
+	shopifyRawClient, err := newRawClient(apiVersion)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Shopify client",
@@ -129,21 +303,77 @@ func (p *ShopifyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	shopifyClient := shopify.NewClient(shopifyRawClient)
+	shopifyClient := shopify.NewClient(shopifyRawClient, shopify.Config{
+		Shop:             shop,
+		APIVersion:       apiVersion,
+		AccessToken:      adminAPIAccessToken,
+		MaxConcurrency:   int(data.MaxConcurrency.ValueInt64()),
+		ReadOnly:         data.ReadOnly.ValueBool(),
+		NewVersionClient: newRawClient,
+		OnOperation:      onOperation,
+	})
+	if data.VerifyConnection.IsNull() || data.VerifyConnection.ValueBool() {
+		if err := shopifyClient.Ping(ctx); err != nil {
+			resp.Diagnostics.Append(connectionErrorDiagnostic(shop, authMode, err))
+			return
+		}
+	}
 	resp.DataSourceData = shopifyClient
 	resp.ResourceData = shopifyClient
 }
 
 func (p *ShopifyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAppSubscriptionResource,
+		NewArticleMetafieldResource,
+		NewAutomaticDiscountAppResource,
+		NewBlogMetafieldResource,
+		NewCollectionPublicationResource,
+		NewCustomerAccountSettingsResource,
+		NewCustomerAddressResource,
+		NewCustomerMetafieldResource,
+		NewDeliveryProfileResource,
+		NewDiscountRedeemCodeBulkResource,
+		NewFileAltTextResource,
+		NewFulfillmentConstraintRuleResource,
+		NewFulfillmentOrderHoldResource,
+		NewGiftCardConfigurationResource,
+		NewLinkListResource,
+		NewMetafieldResource,
 		NewMetafieldDefinitionResource,
+		NewMetafieldDefinitionSetResource,
+		NewMetafieldsImportResource,
 		NewMetaobjectDefinitionResource,
+		NewMetaobjectDefinitionFieldResource,
+		NewMetaobjectEntrySetResource,
+		NewOrderRiskResource,
+		NewOrderTagResource,
 		NewPageResource,
+		NewPrivacySettingsResource,
+		NewProductOptionResource,
+		NewShopAddressResource,
+		NewShopMetafieldResource,
+		NewShopSettingsResource,
+		NewSmartCollectionResource,
+		NewSubscriptionBillingAttemptResource,
+		NewTaxSettingResource,
+		NewThemePublishResource,
+		NewURLRedirectResource,
+		NewWebPixelResource,
 	}
 }
 
 func (p *ShopifyProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewAPIThrottleDataSource,
+		NewBulkQueryDataSource,
+		NewGraphQLQueryDataSource,
+		NewMetafieldDefinitionDataSource,
+		NewMetaobjectDefinitionDataSource,
+		NewMetaobjectsDataSource,
+		NewPagesDataSource,
+		NewProviderConfigDataSource,
+	}
 }
 
 func (p *ShopifyProvider) Functions(ctx context.Context) []func() function.Function {
@@ -158,9 +388,149 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// validateAPIHost checks that the host is a host name or an IP address, with an optional port,
+// and nothing else, e.g. no scheme or path.
+func validateAPIHost(host string) error {
+	u, err := url.Parse("https://" + host)
+	if err != nil || u.Host != host || u.Hostname() == "" || u.User != nil || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("expected a host with an optional port, e.g. shopify-proxy.example.com or localhost:8443, got %q", host)
+	}
+	if port := u.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("invalid port %q in %q", port, host)
+		}
+	}
+	if ip := net.ParseIP(strings.Trim(u.Hostname(), "[]")); ip != nil {
+		return nil
+	}
+	for _, label := range strings.Split(u.Hostname(), ".") {
+		if !hostLabelPattern.MatchString(label) {
+			return fmt.Errorf("invalid host name %q", u.Hostname())
+		}
+	}
+	return nil
+}
+
+// shopNamePattern matches the name of a shop, i.e. the subdomain of its myshopify domain.
+var shopNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// normalizeShop returns the myshopify domain of the shop, e.g. theshop.myshopify.com, from the shop name
+// or a URL of the shop pasted as is, e.g. https://theshop.myshopify.com/admin. It returns whether the value
+// had to be fixed, i.e. it wasn't the shop name or the domain. Other domains, e.g. the custom domain of the shop, are rejected,
+// as the API is only served on the myshopify domain.
+func normalizeShop(shop string) (string, bool, error) {
+	host := strings.ToLower(strings.TrimSpace(shop))
+	if _, rest, ok := strings.Cut(host, "://"); ok {
+		host = rest
+	}
+	host, _, _ = strings.Cut(host, "/")
+	host = strings.TrimSuffix(host, ".")
+	name, ok := strings.CutSuffix(host, ".myshopify.com")
+	if !ok && strings.Contains(host, ".") {
+		return "", false, fmt.Errorf("expected the myshopify domain of the shop, e.g. theshop.myshopify.com, or its name, got %q. "+
+			"The custom domain of the shop can't be used, find the myshopify domain in the domain settings of the Shopify admin", shop)
+	}
+	if !shopNamePattern.MatchString(name) {
+		return "", false, fmt.Errorf("expected the myshopify domain of the shop, e.g. theshop.myshopify.com, or its name, got %q", shop)
+	}
+	domain := name + ".myshopify.com"
+	return domain, shop != name && shop != domain, nil
+}
+
+// hostLabelPattern matches a label of a host name, e.g. shopify-proxy in shopify-proxy.example.com.
+var hostLabelPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+
 func readOrEnvDefault(str types.String, envVarKey string) string {
 	if !str.IsNull() {
 		return str.ValueString()
 	}
 	return os.Getenv(envVarKey)
 }
+
+// oauthCredentialHint returns the explanation appended to a missing app credential in oauth mode.
+func oauthCredentialHint(authMode string) string {
+	if authMode != authModeOAuth {
+		return ""
+	}
+	return ". In oauth mode, the API key and the API secret key of the public app are required to identify the app the access token was issued to."
+}
+
+// connectionErrorDiagnostic returns the diagnostic of the failed connection check,
+// telling rejected credentials from an unreachable shop.
+func connectionErrorDiagnostic(shop, authMode string, err error) diag.Diagnostic {
+	var urlErr *url.Error
+	switch {
+	case shopify.IsUnauthorized(err) && authMode == authModeOAuth:
+		return diag.NewErrorDiagnostic(
+			"Authentication failed",
+			fmt.Sprintf("Shopify rejected the OAuth access token for the shop %s. The token may have been revoked, e.g. by uninstalling the app, or be an online token that has expired. Obtain a new offline token with the OAuth flow and set it as admin_api_access_token. Got error: %s", shop, err),
+		)
+	case shopify.IsUnauthorized(err):
+		return diag.NewErrorDiagnostic(
+			"Authentication failed",
+			fmt.Sprintf("Shopify rejected the credentials for the shop %s. Check admin_api_access_token and that the app is installed on the shop. Got error: %s", shop, err),
+		)
+	case errors.As(err, &urlErr):
+		return diag.NewErrorDiagnostic(
+			"Unable to connect to Shopify",
+			fmt.Sprintf("Unable to reach the shop %s. Check the shop name and the network connection. Got error: %s", shop, err),
+		)
+	default:
+		return diag.NewErrorDiagnostic(
+			"Unable to verify the connection to Shopify",
+			fmt.Sprintf("Unable to query the shop %s, got error: %s", shop, err),
+		)
+	}
+}
+
+// missingCredentials returns the credentials that are set neither in the configuration nor in the env variables,
+// when some of them are set in the configuration. The credentials from the env variables only are checked by Configure.
+func missingCredentials(data ShopifyProviderModel) []string {
+	credentials := []struct {
+		name      string
+		value     types.String
+		envVarKey string
+	}{
+		{name: "api_key", value: data.APIKey, envVarKey: "SHOPIFY_API_KEY"},
+		{name: "api_secret_key", value: data.APISecretKey, envVarKey: "SHOPIFY_API_SECRET_KEY"},
+		{name: "admin_api_access_token", value: data.AdminAPIAccessToken, envVarKey: "SHOPIFY_ADMIN_API_ACCESS_TOKEN"},
+	}
+
+	configured := false
+	for _, credential := range credentials {
+		if credential.value.IsUnknown() {
+			// The credential may be set once it is known
+			return nil
+		}
+		if !credential.value.IsNull() {
+			configured = true
+		}
+	}
+	if !configured {
+		return nil
+	}
+
+	var missing []string
+	for _, credential := range credentials {
+		if credential.value.IsNull() && os.Getenv(credential.envVarKey) == "" {
+			missing = append(missing, fmt.Sprintf("%s (%s)", credential.name, credential.envVarKey))
+		}
+	}
+	return missing
+}
+
+// resolveAPIVersion returns the API version to use for the configured one, resolving latest to the latest stable version.
+func resolveAPIVersion(apiVersion string) string {
+	if apiVersion == LatestAPIVersion {
+		return latestStableAPIVersion(time.Now())
+	}
+	return apiVersion
+}
+
+// latestStableAPIVersion returns the latest stable Shopify API version at the given time.
+// Shopify releases a new stable version at the beginning of each quarter.
+func latestStableAPIVersion(now time.Time) string {
+	now = now.UTC()
+	month := (int(now.Month())-1)/3*3 + 1
+	return fmt.Sprintf("%04d-%02d", now.Year(), month)
+}
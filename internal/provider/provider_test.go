@@ -4,11 +4,26 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -33,3 +48,267 @@ func mustEnv(t *testing.T, name string) {
 		t.Fatalf("%s environment variable must be set for acceptance tests", name)
 	}
 }
+
+// envOrSkip returns the value of the environment variable, skipping the test if it's not set.
+// It's used for acceptance tests that depend on store data which can't be created by the provider.
+func envOrSkip(t *testing.T, name string) string {
+	t.Helper()
+	v := os.Getenv(name)
+	if v == "" {
+		t.Skipf("%s environment variable must be set to run this acceptance test", name)
+	}
+	return v
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestShopifyClient returns a client whose requests are served by the given
+// handler instead of the Shopify API, for unit tests that don't need a real store.
+func newTestShopifyClient(t *testing.T, handler http.HandlerFunc) *shopify.Client {
+	t.Helper()
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			return rec.Result(), nil
+		}),
+	}
+	rawClient, err := goshopify.NewClient(goshopify.App{}, "test", "token", goshopify.WithVersion("2024-07"), goshopify.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return shopify.NewClient(rawClient, shopify.Config{Shop: "test", APIVersion: "2024-07", AccessToken: "token"})
+}
+
+// newTestResourcePlan returns the plan of the resource set from the model, for unit tests calling the resource methods directly.
+// Its schema and raw value make the state of the resource as well.
+func newTestResourcePlan(t *testing.T, r fwresource.Resource, model interface{}) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	return plan
+}
+
+func TestLatestStableAPIVersion(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want string
+	}{
+		{now: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), want: "2026-01"},
+		{now: time.Date(2026, time.March, 31, 23, 59, 59, 0, time.UTC), want: "2026-01"},
+		{now: time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), want: "2026-04"},
+		{now: time.Date(2026, time.August, 15, 0, 0, 0, 0, time.UTC), want: "2026-07"},
+		{now: time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC), want: "2026-10"},
+	}
+	for _, tt := range tests {
+		if got := latestStableAPIVersion(tt.now); got != tt.want {
+			t.Errorf("latestStableAPIVersion(%s) = %s, want %s", tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestConfigure_resolvedAPIVersion(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	// Build the configuration through a plan, which can be set from the model
+	config := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := config.Set(ctx, &ShopifyProviderModel{
+		Shop:                types.StringValue("test"),
+		APIHost:             types.StringNull(),
+		APIVersion:          types.StringValue(LatestAPIVersion),
+		AuthMode:            types.StringNull(),
+		APIKey:              types.StringValue("key"),
+		APISecretKey:        types.StringValue("secret"),
+		AdminAPIAccessToken: types.StringValue("token"),
+		RefreshToken:        types.StringNull(),
+		MaxConcurrency:      types.Int64Null(),
+		VerifyConnection:    types.BoolValue(false),
+		ReadOnly:            types.BoolNull(),
+		OperationLogFile:    types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	client, ok := resp.ResourceData.(*shopify.Client)
+	if !ok {
+		t.Fatalf("expected a client, got %T", resp.ResourceData)
+	}
+	if got, want := client.APIVersion(), latestStableAPIVersion(time.Now()); got != want {
+		t.Errorf("APIVersion() = %s, want %s", got, want)
+	}
+}
+
+func TestMissingCredentials(t *testing.T) {
+	t.Setenv("SHOPIFY_API_KEY", "")
+	t.Setenv("SHOPIFY_API_SECRET_KEY", "secret")
+	t.Setenv("SHOPIFY_ADMIN_API_ACCESS_TOKEN", "")
+
+	tests := []struct {
+		name string
+		data ShopifyProviderModel
+		want []string
+	}{
+		{
+			name: "env only",
+			data: ShopifyProviderModel{},
+			want: nil,
+		},
+		{
+			name: "partially configured",
+			data: ShopifyProviderModel{APIKey: types.StringValue("key")},
+			want: []string{"admin_api_access_token (SHOPIFY_ADMIN_API_ACCESS_TOKEN)"},
+		},
+		{
+			name: "fully configured",
+			data: ShopifyProviderModel{
+				APIKey:              types.StringValue("key"),
+				AdminAPIAccessToken: types.StringValue("token"),
+			},
+			want: nil,
+		},
+		{
+			name: "unknown",
+			data: ShopifyProviderModel{
+				APIKey:              types.StringValue("key"),
+				AdminAPIAccessToken: types.StringUnknown(),
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missingCredentials(tt.data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("missingCredentials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectionErrorDiagnostic(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    string
+	}{
+		{
+			name: "unauthorized",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"errors":"[API] Invalid API key or access token"}`))
+			},
+			want: "Authentication failed",
+		},
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			want: "Unable to verify the connection to Shopify",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newTestShopifyClient(t, tt.handler).Ping(context.Background())
+			if got := connectionErrorDiagnostic("test", authModeCustom, err).Summary(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	err := &url.Error{Op: "Post", URL: "https://test.myshopify.com", Err: errors.New("no such host")}
+	if got := connectionErrorDiagnostic("test", authModeCustom, err).Summary(); got != "Unable to connect to Shopify" {
+		t.Errorf("unexpected summary for a network error: %q", got)
+	}
+}
+
+func TestConnectionErrorDiagnostic_oauth(t *testing.T) {
+	err := newTestShopifyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":"[API] Invalid API key or access token"}`))
+	}).Ping(context.Background())
+
+	d := connectionErrorDiagnostic("test", authModeOAuth, err)
+	if d.Summary() != "Authentication failed" {
+		t.Errorf("unexpected summary: %q", d.Summary())
+	}
+	if !strings.Contains(d.Detail(), "OAuth flow") {
+		t.Errorf("expected the detail to explain how to obtain a new token, got %q", d.Detail())
+	}
+}
+
+func TestValidateAPIHost(t *testing.T) {
+	tests := []struct {
+		host string
+		ok   bool
+	}{
+		{host: "shopify-proxy.example.com", ok: true},
+		{host: "localhost:8443", ok: true},
+		{host: "10.0.0.1", ok: true},
+		{host: "[::1]:8443", ok: true},
+		{host: "https://shopify-proxy.example.com", ok: false},
+		{host: "shopify-proxy.example.com/admin", ok: false},
+		{host: "user@shopify-proxy.example.com", ok: false},
+		{host: "shopify-proxy.example.com:0", ok: false},
+		{host: "shopify_proxy.example.com", ok: false},
+		{host: "-proxy.example.com", ok: false},
+		{host: "", ok: false},
+	}
+	for _, tt := range tests {
+		if err := validateAPIHost(tt.host); (err == nil) != tt.ok {
+			t.Errorf("validateAPIHost(%q) = %v", tt.host, err)
+		}
+	}
+}
+
+func TestNormalizeShop(t *testing.T) {
+	tests := []struct {
+		shop  string
+		want  string
+		fixed bool
+		ok    bool
+	}{
+		{shop: "theshop", want: "theshop.myshopify.com", ok: true},
+		{shop: "theshop.myshopify.com", want: "theshop.myshopify.com", ok: true},
+		{shop: "https://theshop.myshopify.com/admin", want: "theshop.myshopify.com", fixed: true, ok: true},
+		{shop: "https://theshop.myshopify.com/", want: "theshop.myshopify.com", fixed: true, ok: true},
+		{shop: "theshop.myshopify.com/", want: "theshop.myshopify.com", fixed: true, ok: true},
+		{shop: " TheShop.myshopify.com ", want: "theshop.myshopify.com", fixed: true, ok: true},
+		{shop: "http://theshop", want: "theshop.myshopify.com", fixed: true, ok: true},
+		{shop: "the-shop-2", want: "the-shop-2.myshopify.com", ok: true},
+		{shop: "theshop.com", ok: false},
+		{shop: "https://shop.example.com/collections", ok: false},
+		{shop: "theshop.myshopify.com:443", ok: false},
+		{shop: "the shop", ok: false},
+		{shop: "https://", ok: false},
+		{shop: ".myshopify.com", ok: false},
+		{shop: "-theshop", ok: false},
+	}
+	for _, tt := range tests {
+		got, fixed, err := normalizeShop(tt.shop)
+		if (err == nil) != tt.ok {
+			t.Errorf("normalizeShop(%q) error = %v", tt.shop, err)
+			continue
+		}
+		if got != tt.want || fixed != tt.fixed {
+			t.Errorf("normalizeShop(%q) = %q, %v, want %q, %v", tt.shop, got, fixed, tt.want, tt.fixed)
+		}
+	}
+}
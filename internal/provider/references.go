@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// keepValidationReferences returns the validations read with the references to metaobject definitions,
+// which Shopify returns as GIDs, written as in the current validations, e.g. by type, when they refer to the same definitions.
+func keepValidationReferences(ctx context.Context, client *shopify.Client, validations []*shopify.MetafieldDefinitionValidation, current []*MetafieldDefinitionValidationModel) []*shopify.MetafieldDefinitionValidation {
+	if len(current) == 0 {
+		return validations
+	}
+	return client.KeepWrittenValidationReferences(ctx, validations, convert.ValidationModelsToValidations(current))
+}
+
+// keepMetaobjectFieldDefinitionReferences writes the validation references of the field definitions read
+// as in the current field definitions with the same key.
+func keepMetaobjectFieldDefinitionReferences(ctx context.Context, client *shopify.Client, definition *shopify.MetaobjectDefinition, current []*MetaobjectFieldDefinitionModel) {
+	if definition == nil {
+		return
+	}
+	currentValidations := make(map[string][]*MetafieldDefinitionValidationModel, len(current))
+	for _, fieldDefinition := range current {
+		currentValidations[fieldDefinition.Key.ValueString()] = fieldDefinition.Validations
+	}
+	for _, fieldDefinition := range definition.FieldDefinitions {
+		fieldDefinition.Validations = keepValidationReferences(ctx, client, fieldDefinition.Validations, currentValidations[fieldDefinition.Key])
+	}
+}
+
+// keepMetafieldReferences returns the value of the metafield read with the references to metaobjects,
+// which Shopify returns as GIDs, written as in the current value, e.g. by handle, when they refer to the same metaobjects.
+func keepMetafieldReferences(ctx context.Context, client *shopify.Client, metafield *shopify.Metafield, current types.String) string {
+	if current.IsNull() || current.IsUnknown() {
+		return metafield.Value
+	}
+	return client.KeepWrittenMetafieldReferences(ctx, metafield.Type, metafield.Value, current.ValueString())
+}
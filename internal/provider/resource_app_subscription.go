@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppSubscriptionResource{}
+var _ resource.ResourceWithImportState = &AppSubscriptionResource{}
+var _ resource.ResourceWithValidateConfig = &AppSubscriptionResource{}
+
+// AppSubscriptionResource defines the resource implementation.
+type AppSubscriptionResource struct {
+	client *shopify.Client
+}
+
+func NewAppSubscriptionResource() resource.Resource {
+	return &AppSubscriptionResource{}
+}
+
+// AppSubscriptionResourceModel describes the resource data model.
+type AppSubscriptionResourceModel struct {
+	ID              types.String                    `tfsdk:"id"`
+	Name            types.String                    `tfsdk:"name"`
+	LineItems       []*AppSubscriptionLineItemModel `tfsdk:"line_items"`
+	Test            types.Bool                      `tfsdk:"test"`
+	ReturnURL       types.String                    `tfsdk:"return_url"`
+	Status          types.String                    `tfsdk:"status"`
+	ConfirmationURL types.String                    `tfsdk:"confirmation_url"`
+}
+
+// AppSubscriptionLineItemModel describes a line item of the subscription, priced either on a recurring or on a usage basis.
+type AppSubscriptionLineItemModel struct {
+	Recurring *AppRecurringPricingModel `tfsdk:"recurring"`
+	Usage     *AppUsagePricingModel     `tfsdk:"usage"`
+}
+
+type AppRecurringPricingModel struct {
+	Price        types.Float64 `tfsdk:"price"`
+	CurrencyCode types.String  `tfsdk:"currency_code"`
+	Interval     types.String  `tfsdk:"interval"`
+}
+
+type AppUsagePricingModel struct {
+	Terms        types.String  `tfsdk:"terms"`
+	CappedAmount types.Float64 `tfsdk:"capped_amount"`
+	CurrencyCode types.String  `tfsdk:"currency_code"`
+}
+
+func (r *AppSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_subscription"
+}
+
+func (r *AppSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a recurring charge of the app configured with `admin_api_access_token`, e.g. to test billing flows.\n\n" +
+			"The subscription is `PENDING` until the merchant approves it by visiting `confirmation_url`, which can't be done by Terraform. " +
+			"A subscription can't be changed, so changing any argument creates a new subscription, and destroying the resource cancels it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the app subscription.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the subscription, shown to the merchant.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"line_items": schema.ListNestedAttribute{
+				MarkdownDescription: "The plans of the subscription. Each line item sets exactly one of `recurring` and `usage`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"recurring": schema.SingleNestedAttribute{
+							MarkdownDescription: "Charges the merchant a fixed price every interval.",
+							Attributes: map[string]schema.Attribute{
+								"price": schema.Float64Attribute{
+									MarkdownDescription: "The amount charged every interval.",
+									Required:            true,
+								},
+								"currency_code": schema.StringAttribute{
+									MarkdownDescription: "The currency of the price, e.g. `USD`.",
+									Required:            true,
+								},
+								"interval": schema.StringAttribute{
+									MarkdownDescription: "How often the merchant is charged. Possible values are `EVERY_30_DAYS` and `ANNUAL`.",
+									Required:            true,
+								},
+							},
+							Optional: true,
+						},
+						"usage": schema.SingleNestedAttribute{
+							MarkdownDescription: "Charges the merchant based on the usage of the app, up to a capped amount every 30 days.",
+							Attributes: map[string]schema.Attribute{
+								"terms": schema.StringAttribute{
+									MarkdownDescription: "The terms of the usage charges, shown to the merchant.",
+									Required:            true,
+								},
+								"capped_amount": schema.Float64Attribute{
+									MarkdownDescription: "The maximum amount charged every 30 days.",
+									Required:            true,
+								},
+								"currency_code": schema.StringAttribute{
+									MarkdownDescription: "The currency of the capped amount, e.g. `USD`.",
+									Required:            true,
+								},
+							},
+							Optional: true,
+						},
+					},
+				},
+				Required:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+			},
+			"test": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subscription is a test one, which doesn't charge the merchant.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"return_url": schema.StringAttribute{
+				MarkdownDescription: "The URL the merchant is redirected to after approving the subscription.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the subscription. Possible values are `PENDING`, `ACTIVE`, `FROZEN`, `DECLINED`, `EXPIRED` and `CANCELLED`.",
+				Computed:            true,
+			},
+			"confirmation_url": schema.StringAttribute{
+				MarkdownDescription: "The URL where the merchant approves the subscription. Only known for the subscriptions created by Terraform.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *AppSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var lineItems []*AppSubscriptionLineItemModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("line_items"), &lineItems)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, lineItem := range lineItems {
+		if lineItem == nil || (lineItem.Recurring == nil) != (lineItem.Usage == nil) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("line_items").AtListIndex(i),
+			"Invalid line item",
+			"Exactly one of recurring and usage must be set.",
+		)
+	}
+}
+
+func (r *AppSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AppSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := shopify.AppSubscriptionCreateInput{
+		Name:      data.Name.ValueString(),
+		LineItems: convertAppSubscriptionLineItemModelsToInputs(data.LineItems),
+		Test:      data.Test.ValueBool(),
+		ReturnURL: data.ReturnURL.ValueString(),
+	}
+	subscription, confirmationURL, err := r.client.CreateAppSubscription(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create app subscription", err))
+		return
+	}
+
+	createdData := convertAppSubscriptionToResourceModel(subscription, data)
+	createdData.ConfirmationURL = types.StringValue(confirmationURL)
+	tflog.Trace(ctx, "created an app subscription", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *AppSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AppSubscriptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.GetAppSubscription(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read app subscription", err))
+		return
+	}
+	if subscription == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertAppSubscriptionToResourceModel(subscription, data))...)
+}
+
+func (r *AppSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update.
+	var data AppSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AppSubscriptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.GetAppSubscription(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read app subscription", err))
+		return
+	}
+	if subscription == nil || subscription.IsFinished() {
+		return
+	}
+
+	if err := r.client.CancelAppSubscription(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to cancel app subscription", err))
+		return
+	}
+	tflog.Trace(ctx, "cancelled an app subscription", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *AppSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertAppSubscriptionLineItemModelsToInputs(lineItems []*AppSubscriptionLineItemModel) []*shopify.AppSubscriptionLineItemInput {
+	inputs := make([]*shopify.AppSubscriptionLineItemInput, 0, len(lineItems))
+	for _, lineItem := range lineItems {
+		plan := &shopify.AppPlanInput{}
+		if lineItem.Recurring != nil {
+			plan.AppRecurringPricingDetails = &shopify.AppRecurringPricingInput{
+				Price:    convertAmountToMoney(lineItem.Recurring.Price, lineItem.Recurring.CurrencyCode),
+				Interval: lineItem.Recurring.Interval.ValueString(),
+			}
+		}
+		if lineItem.Usage != nil {
+			plan.AppUsagePricingDetails = &shopify.AppUsagePricingInput{
+				Terms:        lineItem.Usage.Terms.ValueString(),
+				CappedAmount: convertAmountToMoney(lineItem.Usage.CappedAmount, lineItem.Usage.CurrencyCode),
+			}
+		}
+		inputs = append(inputs, &shopify.AppSubscriptionLineItemInput{Plan: plan})
+	}
+	return inputs
+}
+
+func convertAmountToMoney(amount types.Float64, currencyCode types.String) *shopify.Money {
+	return &shopify.Money{
+		Amount:       strconv.FormatFloat(amount.ValueFloat64(), 'f', -1, 64),
+		CurrencyCode: currencyCode.ValueString(),
+	}
+}
+
+// convertMoneyToModel returns the amount and the currency code of the money, keeping the current values when it's not set.
+func convertMoneyToModel(money *shopify.Money, amount types.Float64, currencyCode types.String) (types.Float64, types.String) {
+	if money == nil {
+		return amount, currencyCode
+	}
+	if parsed, err := strconv.ParseFloat(money.Amount, 64); err == nil {
+		amount = types.Float64Value(parsed)
+	}
+	return amount, types.StringValue(money.CurrencyCode)
+}
+
+func convertAppSubscriptionToResourceModel(subscription *shopify.AppSubscription, data AppSubscriptionResourceModel) *AppSubscriptionResourceModel {
+	lineItems := make([]*AppSubscriptionLineItemModel, 0, len(subscription.LineItems))
+	for i, lineItem := range subscription.LineItems {
+		var current AppSubscriptionLineItemModel
+		if i < len(data.LineItems) && data.LineItems[i] != nil {
+			current = *data.LineItems[i]
+		}
+		pricing := lineItem.Plan.PricingDetails
+		if pricing == nil {
+			continue
+		}
+		model := &AppSubscriptionLineItemModel{}
+		switch pricing.Typename {
+		case "AppRecurringPricing":
+			if current.Recurring == nil {
+				current.Recurring = &AppRecurringPricingModel{}
+			}
+			price, currencyCode := convertMoneyToModel(pricing.Price, current.Recurring.Price, current.Recurring.CurrencyCode)
+			model.Recurring = &AppRecurringPricingModel{
+				Price:        price,
+				CurrencyCode: currencyCode,
+				Interval:     types.StringValue(pricing.Interval),
+			}
+		case "AppUsagePricing":
+			if current.Usage == nil {
+				current.Usage = &AppUsagePricingModel{}
+			}
+			cappedAmount, currencyCode := convertMoneyToModel(pricing.CappedAmount, current.Usage.CappedAmount, current.Usage.CurrencyCode)
+			model.Usage = &AppUsagePricingModel{
+				Terms:        types.StringValue(pricing.Terms),
+				CappedAmount: cappedAmount,
+				CurrencyCode: currencyCode,
+			}
+		}
+		lineItems = append(lineItems, model)
+	}
+
+	confirmationURL := data.ConfirmationURL
+	if confirmationURL.IsUnknown() {
+		confirmationURL = types.StringNull()
+	}
+	return &AppSubscriptionResourceModel{
+		ID:              types.StringValue(subscription.ID),
+		Name:            types.StringValue(subscription.Name),
+		LineItems:       lineItems,
+		Test:            types.BoolValue(subscription.Test),
+		ReturnURL:       types.StringValue(subscription.ReturnURL),
+		Status:          types.StringValue(subscription.Status),
+		ConfirmationURL: confirmationURL,
+	}
+}
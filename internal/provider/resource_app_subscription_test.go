@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccAppSubscriptionResource(t *testing.T) {
+	// Only the apps allowed to use the Billing API can create subscriptions
+	returnURL := envOrSkip(t, "SHOPIFY_TEST_APP_SUBSCRIPTION_RETURN_URL")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAppSubscriptionResourceConfig(returnURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("shopify_app_subscription.test", "id"),
+					resource.TestCheckResourceAttr("shopify_app_subscription.test", "status", "PENDING"),
+					resource.TestCheckResourceAttrSet("shopify_app_subscription.test", "confirmation_url"),
+					resource.TestCheckResourceAttr("shopify_app_subscription.test", "line_items.0.recurring.price", "9.99"),
+					resource.TestCheckResourceAttr("shopify_app_subscription.test", "line_items.1.usage.capped_amount", "100"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "shopify_app_subscription.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirmation_url"},
+			},
+		},
+	})
+}
+
+func testAccAppSubscriptionResourceConfig(returnURL string) string {
+	return fmt.Sprintf(`
+resource "shopify_app_subscription" "test" {
+  name       = "Terraform Test Plan"
+  test       = true
+  return_url = %[1]q
+  line_items = [
+    {
+      recurring = {
+        price         = 9.99
+        currency_code = "USD"
+        interval      = "EVERY_30_DAYS"
+      }
+    },
+    {
+      usage = {
+        terms         = "$1 per 100 emails"
+        capped_amount = 100
+        currency_code = "USD"
+      }
+    },
+  ]
+}
+`, returnURL)
+}
+
+func TestConvertAppSubscriptionToResourceModel(t *testing.T) {
+	subscription := &shopify.AppSubscription{
+		ID:        "gid://shopify/AppSubscription/1",
+		Name:      "Plan",
+		Status:    shopify.AppSubscriptionStatusActive,
+		ReturnURL: "https://example.com",
+		LineItems: []*shopify.AppSubscriptionLineItem{{}, {}},
+	}
+	subscription.LineItems[0].Plan.PricingDetails = &shopify.AppPricingDetails{
+		Typename: "AppRecurringPricing",
+		Price:    &shopify.Money{Amount: "9.99", CurrencyCode: "USD"},
+		Interval: "ANNUAL",
+	}
+	subscription.LineItems[1].Plan.PricingDetails = &shopify.AppPricingDetails{
+		Typename:     "AppUsagePricing",
+		Terms:        "Per email",
+		CappedAmount: &shopify.Money{Amount: "100.0", CurrencyCode: "USD"},
+	}
+
+	got := convertAppSubscriptionToResourceModel(subscription, AppSubscriptionResourceModel{
+		ConfirmationURL: types.StringValue("https://test.myshopify.com/confirm"),
+	})
+	if len(got.LineItems) != 2 {
+		t.Fatalf("expected 2 line items, got %d", len(got.LineItems))
+	}
+	if recurring := got.LineItems[0].Recurring; recurring == nil || recurring.Price.ValueFloat64() != 9.99 || recurring.Interval.ValueString() != "ANNUAL" {
+		t.Errorf("unexpected recurring pricing: %+v", recurring)
+	}
+	if usage := got.LineItems[1].Usage; usage == nil || usage.CappedAmount.ValueFloat64() != 100 || usage.CurrencyCode.ValueString() != "USD" {
+		t.Errorf("unexpected usage pricing: %+v", usage)
+	}
+	if got.ConfirmationURL.ValueString() != "https://test.myshopify.com/confirm" {
+		t.Errorf("expected the confirmation URL to be kept, got %s", got.ConfirmationURL)
+	}
+}
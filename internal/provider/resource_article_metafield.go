@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewArticleMetafieldResource() resource.Resource {
+	return &ownerMetafieldResource{owner: metafieldOwner{
+		name:        "article",
+		gidType:     "Article",
+		attribute:   "article_id",
+		description: "Manages a metafield of a blog article, e.g. a layout setting read by the article template.",
+	}}
+}
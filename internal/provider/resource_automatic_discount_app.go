@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/pkg/xslice"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AutomaticDiscountAppResource{}
+var _ resource.ResourceWithImportState = &AutomaticDiscountAppResource{}
+var _ resource.ResourceWithValidateConfig = &AutomaticDiscountAppResource{}
+var _ resource.ResourceWithModifyPlan = &AutomaticDiscountAppResource{}
+
+// AutomaticDiscountAppResource defines the resource implementation.
+type AutomaticDiscountAppResource struct {
+	client *shopify.Client
+}
+
+func NewAutomaticDiscountAppResource() resource.Resource {
+	return &AutomaticDiscountAppResource{}
+}
+
+// AutomaticDiscountAppResourceModel describes the resource data model.
+type AutomaticDiscountAppResourceModel struct {
+	ID           types.String               `tfsdk:"id"`
+	Title        types.String               `tfsdk:"title"`
+	FunctionID   types.String               `tfsdk:"function_id"`
+	StartsAt     types.String               `tfsdk:"starts_at"`
+	EndsAt       types.String               `tfsdk:"ends_at"`
+	CombinesWith *DiscountCombinesWithModel `tfsdk:"combines_with"`
+	Metafields   []*FunctionMetafieldModel  `tfsdk:"metafields"`
+	Status       types.String               `tfsdk:"status"`
+}
+
+func (r *AutomaticDiscountAppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automatic_discount_app"
+}
+
+func (r *AutomaticDiscountAppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an automatic discount whose logic is implemented by a discount Shopify Function of an app.\n\n" +
+			"Only the configured `metafields` are managed, the other metafields of the discount are left as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the discount, e.g. `gid://shopify/DiscountAutomaticNode/1234567890`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the discount, shown to the customers.",
+				Required:            true,
+			},
+			"function_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the discount Shopify Function implementing the discount, " +
+					"whose API type is one of `discount`, `product_discounts`, `order_discounts` or `shipping_discounts`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC3339 format) when the discount starts.",
+				Required:            true,
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC3339 format) when the discount ends. The discount doesn't end when unset.",
+				Optional:            true,
+			},
+			"combines_with": discountCombinesWithAttribute(),
+			"metafields":    functionMetafieldsAttribute("The metafields of the discount, which the function reads its configuration from."),
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the discount, one of `ACTIVE`, `EXPIRED` and `SCHEDULED`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AutomaticDiscountAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *AutomaticDiscountAppResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AutomaticDiscountAppResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, diags := parseDiscountPeriod(data.StartsAt, data.EndsAt)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(validateFunctionMetafields(data.Metafields)...)
+}
+
+func (r *AutomaticDiscountAppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or without a client to check the function with
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var functionID, stateFunctionID types.String
+	var combinesWith, stateCombinesWith *DiscountCombinesWithModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("function_id"), &functionID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("combines_with"), &combinesWith)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("function_id"), &stateFunctionID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("combines_with"), &stateCombinesWith)...)
+	}
+	// The combinations depend on the class of the function, so they're checked along with it
+	if resp.Diagnostics.HasError() || functionID.IsUnknown() || (functionID.Equal(stateFunctionID) && reflect.DeepEqual(combinesWith, stateCombinesWith)) {
+		return
+	}
+
+	function, err := r.client.GetShopifyFunction(ctx, functionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read Shopify Function", err))
+		return
+	}
+	resp.Diagnostics.Append(validateDiscountFunction(functionID.ValueString(), function)...)
+	if function != nil {
+		resp.Diagnostics.Append(validateDiscountCombinesWith(combinesWith, shopify.DiscountFunctionAPITypeClasses[function.APIType])...)
+	}
+}
+
+// validateDiscountFunction checks that the function exists and implements a discount.
+func validateDiscountFunction(functionID string, function *shopify.ShopifyFunction) diag.Diagnostics {
+	return validateShopifyFunction(functionID, function, "a discount", shopify.DiscountFunctionAPITypes)
+}
+
+func (r *AutomaticDiscountAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutomaticDiscountAppResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := convertAutomaticDiscountAppResourceModelToInput(&data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	input.FunctionID = data.FunctionID.ValueString()
+	created, err := r.client.CreateDiscountAutomaticApp(ctx, input)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create automatic app discount", err))
+		return
+	}
+
+	// The metafields are only returned by reading the discount
+	discount, err := r.client.GetDiscountAutomaticApp(ctx, created.DiscountID)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read automatic app discount", err))
+		return
+	}
+	if discount == nil {
+		discount = created
+	}
+	tflog.Trace(ctx, "created an automatic app discount", map[string]interface{}{
+		"id": discount.DiscountID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertDiscountAutomaticAppToResourceModel(discount, &data))...)
+}
+
+func (r *AutomaticDiscountAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutomaticDiscountAppResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	discount, err := r.client.GetDiscountAutomaticApp(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read automatic app discount", err))
+		return
+	}
+	if discount == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertDiscountAutomaticAppToResourceModel(discount, &data))...)
+}
+
+func (r *AutomaticDiscountAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AutomaticDiscountAppResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The metafields in the input are only created or updated, so the removed ones are deleted first
+	if removed := removedAutomaticDiscountAppMetafields(data.ID.ValueString(), data.Metafields, state.Metafields); len(removed) > 0 {
+		if err := r.client.DeleteMetafields(ctx, removed); err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to delete metafields of automatic app discount", err))
+			return
+		}
+	}
+
+	input, diags := convertAutomaticDiscountAppResourceModelToInput(&data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := r.client.UpdateDiscountAutomaticApp(ctx, data.ID.ValueString(), input); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update automatic app discount", err))
+		return
+	}
+	discount, err := r.client.GetDiscountAutomaticApp(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read automatic app discount", err))
+		return
+	}
+	if discount == nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read automatic app discount", errors.New("the discount has been deleted")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertDiscountAutomaticAppToResourceModel(discount, &data))...)
+}
+
+func (r *AutomaticDiscountAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AutomaticDiscountAppResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteDiscountAutomatic(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete automatic app discount", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted an automatic app discount", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *AutomaticDiscountAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// parseDiscountPeriod parses the configured start and end of a discount, nil if unset or unknown.
+func parseDiscountPeriod(startsAt, endsAt types.String) (*time.Time, *time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parse := func(attributePath path.Path, value types.String) *time.Time {
+		if value.IsNull() || value.IsUnknown() {
+			return nil
+		}
+		t, err := parseTime(value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attributePath, "Invalid "+attributePath.String(), err.Error())
+			return nil
+		}
+		return &t
+	}
+	start := parse(path.Root("starts_at"), startsAt)
+	end := parse(path.Root("ends_at"), endsAt)
+	if start != nil && end != nil && !end.After(*start) {
+		diags.AddAttributeError(path.Root("ends_at"), "Invalid ends_at",
+			fmt.Sprintf("ends_at must be after starts_at, got %s", endsAt.ValueString()))
+	}
+	return start, end, diags
+}
+
+func convertAutomaticDiscountAppResourceModelToInput(data *AutomaticDiscountAppResourceModel) (*shopify.DiscountAutomaticAppInput, diag.Diagnostics) {
+	start, end, diags := parseDiscountPeriod(data.StartsAt, data.EndsAt)
+	if diags.HasError() {
+		return nil, diags
+	}
+	input := &shopify.DiscountAutomaticAppInput{
+		Title:        data.Title.ValueString(),
+		StartsAt:     *start,
+		EndsAt:       end,
+		CombinesWith: convertDiscountCombinesWithModelToInput(data.CombinesWith),
+	}
+	input.Metafields = convertFunctionMetafieldModelsToInputs(data.Metafields)
+	return input, diags
+}
+
+// removedAutomaticDiscountAppMetafields returns the identifiers of the metafields in the state that aren't planned anymore.
+func removedAutomaticDiscountAppMetafields(ownerID string, plan, state []*FunctionMetafieldModel) []*shopify.MetafieldIdentifierInput {
+	var removed []*shopify.MetafieldIdentifierInput
+	for _, old := range state {
+		if _, ok := xslice.FindBy(plan, func(v *FunctionMetafieldModel) bool {
+			return v.Namespace.Equal(old.Namespace) && v.Key.Equal(old.Key)
+		}); ok {
+			continue
+		}
+		removed = append(removed, &shopify.MetafieldIdentifierInput{
+			OwnerID:   ownerID,
+			Namespace: old.Namespace.ValueString(),
+			Key:       old.Key.ValueString(),
+		})
+	}
+	return removed
+}
+
+func convertDiscountAutomaticAppToResourceModel(discount *shopify.DiscountAutomaticApp, data *AutomaticDiscountAppResourceModel) *AutomaticDiscountAppResourceModel {
+	return &AutomaticDiscountAppResourceModel{
+		ID:           types.StringValue(discount.DiscountID),
+		Title:        types.StringValue(discount.Title),
+		FunctionID:   types.StringValue(discount.AppDiscountType.FunctionID),
+		StartsAt:     convertTimeToModel(&discount.StartsAt, data.StartsAt),
+		EndsAt:       convertTimeToModel(discount.EndsAt, data.EndsAt),
+		CombinesWith: convertDiscountCombinesWithToModel(discount.CombinesWith, data.CombinesWith),
+		Metafields:   convertMetafieldsToFunctionMetafieldModels(discount.Metafields, data.Metafields),
+		Status:       types.StringValue(discount.Status),
+	}
+}
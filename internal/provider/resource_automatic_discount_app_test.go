@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccAutomaticDiscountAppResource(t *testing.T) {
+	functionID := envOrSkip(t, "SHOPIFY_TEST_DISCOUNT_FUNCTION_ID")
+	title := "Test discount " + randResourceID(8)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAutomaticDiscountAppResourceConfig(functionID, title, `{"percentage":10}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "title", title),
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "function_id", functionID),
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "combines_with.product_discounts", "true"),
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "combines_with.order_discounts", "false"),
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "metafields.#", "1"),
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet("shopify_automatic_discount_app.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "shopify_automatic_discount_app.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metafields"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAutomaticDiscountAppResourceConfig(functionID, title+" updated", `{"percentage":20}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "title", title+" updated"),
+					resource.TestCheckResourceAttr("shopify_automatic_discount_app.test", "metafields.0.value", `{"percentage":20}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomaticDiscountAppResourceConfig(functionID, title, configuration string) string {
+	return fmt.Sprintf(`
+resource "shopify_automatic_discount_app" "test" {
+  title       = %[2]q
+  function_id = %[1]q
+  starts_at   = "2024-01-01T00:00:00Z"
+  combines_with = {
+    product_discounts = true
+  }
+  metafields = [
+    {
+      namespace = "$app:discount"
+      key       = "configuration"
+      type      = "json"
+      value     = %[3]q
+    }
+  ]
+}
+`, functionID, title, configuration)
+}
+
+func TestValidateDiscountFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		function *shopify.ShopifyFunction
+		wantErr  bool
+	}{
+		{name: "product discount", function: &shopify.ShopifyFunction{APIType: "product_discounts"}},
+		{name: "discount", function: &shopify.ShopifyFunction{APIType: "discount"}},
+		{name: "not a discount", function: &shopify.ShopifyFunction{APIType: "payment_customization"}, wantErr: true},
+		{name: "not found", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateDiscountFunction("123", tt.function).HasError(); got != tt.wantErr {
+				t.Errorf("got error %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDiscountPeriod(t *testing.T) {
+	start, end, diags := parseDiscountPeriod(types.StringValue("2024-01-01T00:00:00Z"), types.StringNull())
+	if diags.HasError() || start == nil || end != nil {
+		t.Errorf("unexpected result: %v, %v, %v", start, end, diags)
+	}
+	if _, _, diags := parseDiscountPeriod(types.StringValue("2024-01-02T00:00:00Z"), types.StringValue("2024-01-01T00:00:00Z")); !diags.HasError() {
+		t.Error("expected an error for an end before the start")
+	}
+	if _, _, diags := parseDiscountPeriod(types.StringValue("tomorrow"), types.StringNull()); !diags.HasError() {
+		t.Error("expected an error for an invalid start")
+	}
+}
+
+func TestRemovedAutomaticDiscountAppMetafields(t *testing.T) {
+	metafield := func(namespace, key string) *FunctionMetafieldModel {
+		return &FunctionMetafieldModel{Namespace: types.StringValue(namespace), Key: types.StringValue(key)}
+	}
+	removed := removedAutomaticDiscountAppMetafields("gid://shopify/DiscountAutomaticNode/1",
+		[]*FunctionMetafieldModel{metafield("$app:discount", "configuration")},
+		[]*FunctionMetafieldModel{metafield("$app:discount", "configuration"), metafield("$app:discount", "legacy")},
+	)
+	if len(removed) != 1 || removed[0].Key != "legacy" || removed[0].OwnerID != "gid://shopify/DiscountAutomaticNode/1" {
+		t.Errorf("unexpected removed metafields: %+v", removed)
+	}
+}
+
+func TestConvertDiscountAutomaticAppToResourceModel(t *testing.T) {
+	discount := &shopify.DiscountAutomaticApp{
+		DiscountID:   "gid://shopify/DiscountAutomaticNode/1",
+		Title:        "Test",
+		Status:       "ACTIVE",
+		StartsAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		CombinesWith: &shopify.DiscountCombinesWith{},
+		Metafields: []*shopify.Metafield{
+			{Namespace: "$app:discount", Key: "configuration", Type: "json", Value: `{"percentage":10}`},
+			{Namespace: "other", Key: "unmanaged", Type: "single_line_text_field", Value: "x"},
+		},
+	}
+	data := &AutomaticDiscountAppResourceModel{
+		StartsAt: types.StringValue("2024-01-01T09:00:00+09:00"),
+		EndsAt:   types.StringNull(),
+		Metafields: []*FunctionMetafieldModel{
+			{Namespace: types.StringValue("$app:discount"), Key: types.StringValue("configuration"), Value: types.StringValue(`{ "percentage": 10 }`)},
+		},
+	}
+
+	got := convertDiscountAutomaticAppToResourceModel(discount, data)
+	if got.StartsAt.ValueString() != "2024-01-01T09:00:00+09:00" {
+		t.Errorf("expected the configured starts_at to be kept, got %s", got.StartsAt)
+	}
+	if got.CombinesWith != nil {
+		t.Errorf("expected combines_with to stay unset, got %+v", got.CombinesWith)
+	}
+	if len(got.Metafields) != 1 || got.Metafields[0].Value.ValueString() != `{ "percentage": 10 }` {
+		t.Errorf("expected only the configured metafield with its configured value, got %+v", got.Metafields)
+	}
+}
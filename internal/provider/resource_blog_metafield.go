@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewBlogMetafieldResource() resource.Resource {
+	return &ownerMetafieldResource{owner: metafieldOwner{
+		name:        "blog",
+		gidType:     "Blog",
+		attribute:   "blog_id",
+		description: "Manages a metafield of a blog, e.g. a layout setting read by the blog template.",
+	}}
+}
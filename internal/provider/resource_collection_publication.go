@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CollectionPublicationResource{}
+var _ resource.ResourceWithImportState = &CollectionPublicationResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionPublicationResource{}
+
+// CollectionPublicationResource defines the resource implementation.
+type CollectionPublicationResource struct {
+	client *shopify.Client
+}
+
+func NewCollectionPublicationResource() resource.Resource {
+	return &CollectionPublicationResource{}
+}
+
+// CollectionPublicationResourceModel describes the resource data model.
+type CollectionPublicationResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	CollectionID  types.String `tfsdk:"collection_id"`
+	PublicationID types.String `tfsdk:"publication_id"`
+	PublishedAt   types.String `tfsdk:"published_at"`
+}
+
+func (r *CollectionPublicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_publication"
+}
+
+func (r *CollectionPublicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Publishes a collection to a publication, e.g. the Online Store or another sales channel. " +
+			"Destroying the resource unpublishes the collection from the publication.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the collection publication, in the format `<collection_id>:<publication_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the collection, e.g. `gid://shopify/Collection/1234567890`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"publication_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the publication, e.g. `gid://shopify/Publication/1234567890`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"published_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC3339 format) when the collection is published. " +
+					"A future time schedules the publication. The collection is published immediately when unset.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CollectionPublicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *CollectionPublicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionPublicationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attr := range []struct {
+		name    string
+		value   types.String
+		gidType string
+	}{
+		{name: "collection_id", value: data.CollectionID, gidType: "Collection"},
+		{name: "publication_id", value: data.PublicationID, gidType: "Publication"},
+	} {
+		if attr.value.IsNull() || attr.value.IsUnknown() {
+			continue
+		}
+		if prefix := utils.GIDPrefix(attr.gidType); !strings.HasPrefix(attr.value.ValueString(), prefix) {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.name), "Invalid "+attr.name,
+				fmt.Sprintf("expected %s<id>, got %q", prefix, attr.value.ValueString()))
+		}
+	}
+	_, diags := parsePublishedAt(data.PublishedAt)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CollectionPublicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CollectionPublicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created a collection publication", map[string]interface{}{
+		"id": data.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPublicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CollectionPublicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourcePublication, err := r.client.GetResourcePublication(ctx, data.CollectionID.ValueString(), data.PublicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read collection publication", err))
+		return
+	}
+	if resourcePublication == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertResourcePublicationToCollectionPublicationModel(resourcePublication, data))...)
+}
+
+func (r *CollectionPublicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CollectionPublicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the publish date can change, publishing again reschedules the publication
+	r.publish(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionPublicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionPublicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := []*shopify.PublicationInput{{PublicationID: data.PublicationID.ValueString()}}
+	if err := r.client.PublishableUnpublish(ctx, data.CollectionID.ValueString(), input); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to unpublish collection", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a collection publication", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *CollectionPublicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	collectionID, publicationID, ok := splitCollectionPublicationID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: collection_id:publication_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_id"), collectionID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("publication_id"), publicationID)...)
+}
+
+// publish publishes the collection to the publication and sets the computed attributes of the data.
+func (r *CollectionPublicationResource) publish(ctx context.Context, data *CollectionPublicationResourceModel, diags *diag.Diagnostics) {
+	publishDate, d := parsePublishedAt(data.PublishedAt)
+	if diags.Append(d...); diags.HasError() {
+		return
+	}
+	input := []*shopify.PublicationInput{{PublicationID: data.PublicationID.ValueString(), PublishDate: publishDate}}
+	if err := r.client.PublishablePublish(ctx, data.CollectionID.ValueString(), input); err != nil {
+		diags.Append(diagFromClientError("Unable to publish collection", err))
+		return
+	}
+
+	// Read the publication back to know when Shopify has published the collection
+	resourcePublication, err := r.client.GetResourcePublication(ctx, data.CollectionID.ValueString(), data.PublicationID.ValueString())
+	if err != nil {
+		diags.Append(diagFromClientError("Unable to read collection publication", err))
+		return
+	}
+	if resourcePublication == nil {
+		diags.Append(diagFromClientError("Unable to read collection publication", errors.New("the collection is not published to the publication")))
+		return
+	}
+	*data = *convertResourcePublicationToCollectionPublicationModel(resourcePublication, *data)
+}
+
+// parsePublishedAt parses the configured publish date, or returns nil to publish immediately.
+func parsePublishedAt(publishedAt types.String) (*time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if publishedAt.IsNull() || publishedAt.IsUnknown() {
+		return nil, diags
+	}
+	t, err := parseTime(publishedAt.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("published_at"), "Invalid published_at", err.Error())
+		return nil, diags
+	}
+	return &t, diags
+}
+
+// splitCollectionPublicationID splits the import identifier into the collection ID and the publication ID.
+// Both IDs are GIDs, which contain colons themselves.
+func splitCollectionPublicationID(id string) (collectionID, publicationID string, ok bool) {
+	i := strings.LastIndex(id, ":gid://")
+	if i <= 0 {
+		return "", "", false
+	}
+	return id[:i], id[i+1:], true
+}
+
+func convertResourcePublicationToCollectionPublicationModel(resourcePublication *shopify.ResourcePublication, data CollectionPublicationResourceModel) *CollectionPublicationResourceModel {
+	return &CollectionPublicationResourceModel{
+		ID:            types.StringValue(data.CollectionID.ValueString() + ":" + data.PublicationID.ValueString()),
+		CollectionID:  data.CollectionID,
+		PublicationID: types.StringValue(resourcePublication.Publication.ID),
+		PublishedAt:   convertTimeToModel(resourcePublication.PublishDate, data.PublishedAt),
+	}
+}
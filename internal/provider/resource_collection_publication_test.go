@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionPublicationResource(t *testing.T) {
+	collectionID := envOrSkip(t, "SHOPIFY_TEST_COLLECTION_ID")
+	publicationID := envOrSkip(t, "SHOPIFY_TEST_PUBLICATION_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCollectionPublicationResourceConfig(collectionID, publicationID, "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_collection_publication.test", "id", collectionID+":"+publicationID),
+					resource.TestCheckResourceAttr("shopify_collection_publication.test", "published_at", "2099-01-01T00:00:00Z"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_collection_publication.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCollectionPublicationResourceConfig(collectionID, publicationID, "2098-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_collection_publication.test", "published_at", "2098-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCollectionPublicationResourceConfig(collectionID, publicationID, publishedAt string) string {
+	return fmt.Sprintf(`
+resource "shopify_collection_publication" "test" {
+  collection_id  = %[1]q
+  publication_id = %[2]q
+  published_at   = %[3]q
+}
+`, collectionID, publicationID, publishedAt)
+}
+
+func TestSplitCollectionPublicationID(t *testing.T) {
+	tests := []struct {
+		id                          string
+		collectionID, publicationID string
+		ok                          bool
+	}{
+		{id: "gid://shopify/Collection/1:gid://shopify/Publication/2", collectionID: "gid://shopify/Collection/1", publicationID: "gid://shopify/Publication/2", ok: true},
+		{id: "gid://shopify/Collection/1", ok: false},
+		{id: "gid://shopify/Publication/2", ok: false},
+		{id: ":gid://shopify/Publication/2", ok: false},
+	}
+	for _, tt := range tests {
+		collectionID, publicationID, ok := splitCollectionPublicationID(tt.id)
+		if collectionID != tt.collectionID || publicationID != tt.publicationID || ok != tt.ok {
+			t.Errorf("splitCollectionPublicationID(%q) = %q, %q, %v", tt.id, collectionID, publicationID, ok)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomerAccountSettingsResource{}
+var _ resource.ResourceWithImportState = &CustomerAccountSettingsResource{}
+
+// CustomerAccountSettingsResource defines the resource implementation.
+type CustomerAccountSettingsResource struct {
+	client *shopify.Client
+}
+
+func NewCustomerAccountSettingsResource() resource.Resource {
+	return &CustomerAccountSettingsResource{}
+}
+
+// CustomerAccountSettingsResourceModel describes the resource data model.
+type CustomerAccountSettingsResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	CustomerAccounts        types.String `tfsdk:"customer_accounts"`
+	CustomerAccountsVersion types.String `tfsdk:"customer_accounts_version"`
+	LoginLinksVisible       types.Bool   `tfsdk:"login_links_visible"`
+	LoginRequiredAtCheckout types.Bool   `tfsdk:"login_required_at_checkout"`
+	URL                     types.String `tfsdk:"url"`
+}
+
+func (r *CustomerAccountSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_account_settings"
+}
+
+func (r *CustomerAccountSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tracks the customer account settings of the shop, e.g. to refer to the URL of the new customer accounts. " +
+			"The settings always exist, so creating the resource adopts the current settings, and destroying it only removes it from the state.\n\n" +
+			"The Admin API can only read the settings, so every attribute is computed, and the settings are changed in the Shopify admin, " +
+			"under Settings > Customer accounts. Requires the `read_customers` scope.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the shop.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_accounts": schema.StringAttribute{
+				MarkdownDescription: "Whether the customers can log in, one of `DISABLED`, `OPTIONAL` or `REQUIRED`.",
+				Computed:            true,
+			},
+			"customer_accounts_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the customer accounts, `CLASSIC` or `NEW_CUSTOMER_ACCOUNTS`.",
+				Computed:            true,
+			},
+			"login_links_visible": schema.BoolAttribute{
+				MarkdownDescription: "Whether the links to log in are shown on the online store and the checkout.",
+				Computed:            true,
+			},
+			"login_required_at_checkout": schema.BoolAttribute{
+				MarkdownDescription: "Whether the customers must log in to check out.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the customer accounts, null unless the shop uses the new customer accounts.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CustomerAccountSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *CustomerAccountSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Adopt the current settings, as there is nothing to change
+	settings, err := r.client.GetCustomerAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read customer account settings", err))
+		return
+	}
+
+	createdData := convertCustomerAccountSettingsToResourceModel(settings)
+	tflog.Trace(ctx, "adopted the customer account settings", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *CustomerAccountSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	settings, err := r.client.GetCustomerAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read customer account settings", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertCustomerAccountSettingsToResourceModel(settings))...)
+}
+
+func (r *CustomerAccountSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute is computed, so there is nothing to update but the state
+	settings, err := r.client.GetCustomerAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read customer account settings", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertCustomerAccountSettingsToResourceModel(settings))...)
+}
+
+func (r *CustomerAccountSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The customer account settings can't be deleted, so leave them as they are.
+	tflog.Trace(ctx, "removed the customer account settings from the state")
+}
+
+func (r *CustomerAccountSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertCustomerAccountSettingsToResourceModel(settings *shopify.CustomerAccountSettings) *CustomerAccountSettingsResourceModel {
+	return &CustomerAccountSettingsResourceModel{
+		ID:                      types.StringValue(settings.ShopID),
+		CustomerAccounts:        types.StringValue(settings.CustomerAccounts),
+		CustomerAccountsVersion: types.StringValue(settings.CustomerAccountsVersion),
+		LoginLinksVisible:       types.BoolValue(settings.LoginLinksVisibleOnStorefrontAndCheckout),
+		LoginRequiredAtCheckout: types.BoolValue(settings.LoginRequiredAtCheckout),
+		URL:                     types.StringPointerValue(settings.URL),
+	}
+}
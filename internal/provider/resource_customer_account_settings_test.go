@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomerAccountSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "shopify_customer_account_settings" "test" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("shopify_customer_account_settings.test", "id"),
+					resource.TestCheckResourceAttrSet("shopify_customer_account_settings.test", "customer_accounts"),
+					resource.TestCheckResourceAttrSet("shopify_customer_account_settings.test", "customer_accounts_version"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_customer_account_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomerAddressResource{}
+var _ resource.ResourceWithImportState = &CustomerAddressResource{}
+var _ resource.ResourceWithValidateConfig = &CustomerAddressResource{}
+
+// CustomerAddressResource defines the resource implementation.
+type CustomerAddressResource struct {
+	client *shopify.Client
+}
+
+func NewCustomerAddressResource() resource.Resource {
+	return &CustomerAddressResource{}
+}
+
+// CustomerAddressResourceModel describes the resource data model.
+type CustomerAddressResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	CustomerID   types.String `tfsdk:"customer_id"`
+	FirstName    types.String `tfsdk:"first_name"`
+	LastName     types.String `tfsdk:"last_name"`
+	Company      types.String `tfsdk:"company"`
+	Address1     types.String `tfsdk:"address1"`
+	Address2     types.String `tfsdk:"address2"`
+	City         types.String `tfsdk:"city"`
+	ProvinceCode types.String `tfsdk:"province_code"`
+	CountryCode  types.String `tfsdk:"country_code"`
+	Zip          types.String `tfsdk:"zip"`
+	Phone        types.String `tfsdk:"phone"`
+	Default      types.Bool   `tfsdk:"default"`
+}
+
+func (r *CustomerAddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_address"
+}
+
+func (r *CustomerAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an address of a customer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique numeric identifier for the address.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the customer that the address belongs to. Both the numeric ID and `gid://shopify/Customer/<id>` are accepted.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "The first name of the customer.",
+				Optional:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "The last name of the customer.",
+				Optional:            true,
+			},
+			"company": schema.StringAttribute{
+				MarkdownDescription: "The company of the customer.",
+				Optional:            true,
+			},
+			"address1": schema.StringAttribute{
+				MarkdownDescription: "The first line of the address, typically the street address or PO Box number.",
+				Optional:            true,
+			},
+			"address2": schema.StringAttribute{
+				MarkdownDescription: "The second line of the address, typically the apartment, suite, or unit number.",
+				Optional:            true,
+			},
+			"city": schema.StringAttribute{
+				MarkdownDescription: "The name of the city, district, village, or town.",
+				Optional:            true,
+			},
+			"province_code": schema.StringAttribute{
+				MarkdownDescription: "The code for the region of the address, such as the province, state, or district. For example `QC` for Quebec, Canada.",
+				Optional:            true,
+			},
+			"country_code": schema.StringAttribute{
+				MarkdownDescription: "The two-letter country code corresponding to the country, e.g. `CA`.",
+				Optional:            true,
+			},
+			"zip": schema.StringAttribute{
+				MarkdownDescription: "The zip or postal code of the address.",
+				Optional:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "The phone number of the address.",
+				Optional:            true,
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the address is the default address of the customer. Setting it to `true` makes the previous default address a non-default one. " +
+					"Shopify doesn't allow to unset the default address directly, so only `true` can be configured, and only one address per customer should configure it.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomerAddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomerAddressResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CustomerID.IsNull() && !data.CustomerID.IsUnknown() {
+		if _, err := utils.ParseNumericID(data.CustomerID.ValueString(), "Customer"); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("customer_id"), "Invalid customer_id", err.Error())
+		}
+	}
+	if !data.Default.IsNull() && !data.Default.IsUnknown() && !data.Default.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default"),
+			"Invalid default",
+			"The default address can't be unset directly. Set default = true on another address of the customer instead, or omit the attribute.",
+		)
+	}
+}
+
+func (r *CustomerAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *CustomerAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomerAddressResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	customerID, diags := parseCustomerID(data.CustomerID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := goshopify.CustomerAddress{
+		FirstName:    data.FirstName.ValueString(),
+		LastName:     data.LastName.ValueString(),
+		Company:      data.Company.ValueString(),
+		Address1:     data.Address1.ValueString(),
+		Address2:     data.Address2.ValueString(),
+		City:         data.City.ValueString(),
+		ProvinceCode: data.ProvinceCode.ValueString(),
+		CountryCode:  data.CountryCode.ValueString(),
+		Zip:          data.Zip.ValueString(),
+		Phone:        data.Phone.ValueString(),
+	}
+	createdAddress, err := r.client.CreateCustomerAddress(ctx, customerID, address)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create customer address", err))
+		return
+	}
+	tflog.Trace(ctx, "created a customer address", map[string]interface{}{
+		"id": createdAddress.Id,
+	})
+
+	if data.Default.ValueBool() && !createdAddress.Default {
+		// Save the created address first, so that it's tracked, and replaced on the next apply, if it can't be set as the default
+		resp.Diagnostics.Append(resp.State.Set(ctx, convertCustomerAddressToResourceModel(createdAddress, data))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createdAddress, err = r.client.SetDefaultCustomerAddress(ctx, customerID, createdAddress.Id)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to set the default customer address", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertCustomerAddressToResourceModel(createdAddress, data))...)
+}
+
+func (r *CustomerAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomerAddressResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	customerID, diags := parseCustomerID(data.CustomerID)
+	resp.Diagnostics.Append(diags...)
+	id, diags := parseCustomerAddressID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	address, err := r.client.GetCustomerAddress(ctx, customerID, id)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read customer address", err))
+		return
+	}
+	if address == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertCustomerAddressToResourceModel(address, data))...)
+}
+
+func (r *CustomerAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CustomerAddressResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	customerID, diags := parseCustomerID(data.CustomerID)
+	resp.Diagnostics.Append(diags...)
+	id, diags := parseCustomerAddressID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := shopify.CustomerAddressUpdateInput{
+		ID:           id,
+		FirstName:    data.FirstName.ValueString(),
+		LastName:     data.LastName.ValueString(),
+		Company:      data.Company.ValueString(),
+		Address1:     data.Address1.ValueString(),
+		Address2:     data.Address2.ValueString(),
+		City:         data.City.ValueString(),
+		ProvinceCode: data.ProvinceCode.ValueString(),
+		CountryCode:  data.CountryCode.ValueString(),
+		Zip:          data.Zip.ValueString(),
+		Phone:        data.Phone.ValueString(),
+	}
+	updatedAddress, err := r.client.UpdateCustomerAddress(ctx, customerID, &input)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update customer address", err))
+		return
+	}
+
+	// Making the address the default one demotes the previous default address of the customer
+	if data.Default.ValueBool() && !updatedAddress.Default {
+		updatedAddress, err = r.client.SetDefaultCustomerAddress(ctx, customerID, id)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to set the default customer address", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertCustomerAddressToResourceModel(updatedAddress, data))...)
+}
+
+func (r *CustomerAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomerAddressResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	customerID, diags := parseCustomerID(data.CustomerID)
+	resp.Diagnostics.Append(diags...)
+	id, diags := parseCustomerAddressID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteCustomerAddress(ctx, customerID, id); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete customer address", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a customer address", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *CustomerAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The customer ID may be a GID, which contains colons itself
+	i := strings.LastIndex(req.ID, ":")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: customer_id:address_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("customer_id"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID[i+1:])...)
+}
+
+// parseCustomerID parses the numeric ID of the customer.
+// The GraphQL global ID, e.g. gid://shopify/Customer/123, is accepted as well.
+func parseCustomerID(id types.String) (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed, err := utils.ParseNumericID(id.ValueString(), "Customer")
+	if err != nil {
+		diags.AddAttributeError(path.Root("customer_id"), "Failed to parse customer_id", err.Error())
+		return 0, diags
+	}
+	return parsed, diags
+}
+
+// parseCustomerAddressID parses the numeric ID of the customer address.
+func parseCustomerAddressID(id types.String) (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed, err := utils.ParseNumericID(id.ValueString(), "MailingAddress")
+	if err != nil {
+		diags.AddError("Failed to parse ID", err.Error())
+		return 0, diags
+	}
+	return parsed, diags
+}
+
+func convertCustomerAddressToResourceModel(address *goshopify.CustomerAddress, data CustomerAddressResourceModel) *CustomerAddressResourceModel {
+	return &CustomerAddressResourceModel{
+		ID:           types.StringValue(strconv.FormatUint(address.Id, 10)),
+		CustomerID:   data.CustomerID,
+		FirstName:    convert.EmptyAsNull(address.FirstName, data.FirstName),
+		LastName:     convert.EmptyAsNull(address.LastName, data.LastName),
+		Company:      convert.EmptyAsNull(address.Company, data.Company),
+		Address1:     convert.EmptyAsNull(address.Address1, data.Address1),
+		Address2:     convert.EmptyAsNull(address.Address2, data.Address2),
+		City:         convert.EmptyAsNull(address.City, data.City),
+		ProvinceCode: convert.EmptyAsNull(address.ProvinceCode, data.ProvinceCode),
+		CountryCode:  convert.EmptyAsNull(address.CountryCode, data.CountryCode),
+		Zip:          convert.EmptyAsNull(address.Zip, data.Zip),
+		Phone:        convert.EmptyAsNull(address.Phone, data.Phone),
+		Default:      types.BoolValue(address.Default),
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCustomerAddressResource(t *testing.T) {
+	customerID := envOrSkip(t, "SHOPIFY_TEST_CUSTOMER_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomerAddressResourceConfig(customerID, "1 Main Street"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_customer_address.test", "customer_id", customerID),
+					resource.TestCheckResourceAttr("shopify_customer_address.test", "address1", "1 Main Street"),
+					resource.TestCheckResourceAttr("shopify_customer_address.test", "country_code", "CA"),
+					resource.TestCheckNoResourceAttr("shopify_customer_address.test", "address2"),
+					resource.TestCheckResourceAttrSet("shopify_customer_address.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_customer_address.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["shopify_customer_address.test"]
+					return customerID + ":" + rs.Primary.ID, nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomerAddressResourceConfig(customerID, "2 Main Street"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_customer_address.test", "address1", "2 Main Street"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomerAddressResourceConfig(customerID, address1 string) string {
+	return fmt.Sprintf(`
+resource "shopify_customer_address" "test" {
+  customer_id   = %[1]q
+  first_name    = "Test"
+  last_name     = "Customer"
+  address1      = %[2]q
+  city          = "Ottawa"
+  province_code = "ON"
+  country_code  = "CA"
+  zip           = "K1A 0B1"
+}
+`, customerID, address1)
+}
+
+func TestCustomerAddressResource_createDefaultError(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomerAddressResource{
+		client: newTestShopifyClient(t, func(w http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodPut {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"errors":"Internal Server Error"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"customer_address":{"id":2,"customer_id":1,"address1":"1 Main Street","country_code":"CA","default":false}}`))
+		}),
+	}
+	plan := newTestResourcePlan(t, r, &CustomerAddressResourceModel{
+		ID:          types.StringUnknown(),
+		CustomerID:  types.StringValue("1"),
+		Address1:    types.StringValue("1 Main Street"),
+		CountryCode: types.StringValue("CA"),
+		Default:     types.BoolValue(true),
+	})
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error setting the default address")
+	}
+	// The created address is kept in the state, not to create another one on the next apply
+	var data CustomerAddressResourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if data.ID.ValueString() != "2" || data.Default.ValueBool() {
+		t.Errorf("unexpected state: id %s, default %s", data.ID, data.Default)
+	}
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewCustomerMetafieldResource() resource.Resource {
+	return &ownerMetafieldResource{owner: metafieldOwner{
+		name:        "customer",
+		gidType:     "Customer",
+		attribute:   "customer_id",
+		description: "Manages a metafield of a customer, e.g. a loyalty tier synced from a CRM.",
+	}}
+}
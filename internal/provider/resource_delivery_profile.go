@@ -0,0 +1,582 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/pkg/xslice"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeliveryProfileResource{}
+var _ resource.ResourceWithImportState = &DeliveryProfileResource{}
+var _ resource.ResourceWithValidateConfig = &DeliveryProfileResource{}
+
+// DeliveryProfileResource defines the resource implementation.
+type DeliveryProfileResource struct {
+	client *shopify.Client
+}
+
+func NewDeliveryProfileResource() resource.Resource {
+	return &DeliveryProfileResource{}
+}
+
+// DeliveryProfileResourceModel describes the resource data model.
+type DeliveryProfileResourceModel struct {
+	ID             types.String                         `tfsdk:"id"`
+	Name           types.String                         `tfsdk:"name"`
+	LocationGroups []*DeliveryProfileLocationGroupModel `tfsdk:"location_groups"`
+}
+
+type DeliveryProfileLocationGroupModel struct {
+	ID          types.String         `tfsdk:"id"`
+	LocationIDs []types.String       `tfsdk:"location_ids"`
+	Zones       []*DeliveryZoneModel `tfsdk:"zones"`
+}
+
+type DeliveryZoneModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Name      types.String            `tfsdk:"name"`
+	Countries []*DeliveryCountryModel `tfsdk:"countries"`
+	Rates     []*DeliveryRateModel    `tfsdk:"rates"`
+}
+
+type DeliveryCountryModel struct {
+	Code          types.String   `tfsdk:"code"`
+	ProvinceCodes []types.String `tfsdk:"province_codes"`
+}
+
+type DeliveryRateModel struct {
+	ID           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	Description  types.String  `tfsdk:"description"`
+	Active       types.Bool    `tfsdk:"active"`
+	Price        types.Float64 `tfsdk:"price"`
+	CurrencyCode types.String  `tfsdk:"currency_code"`
+}
+
+func (r *DeliveryProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delivery_profile"
+}
+
+func (r *DeliveryProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom shipping profile, which sets the shipping rates of the zones its locations ship to.\n\n" +
+			"Location groups are matched to the existing ones by their locations, and zones and rates by their names, " +
+			"so renaming a zone or a rate replaces it in Shopify.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the delivery profile.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the delivery profile.",
+				Required:            true,
+			},
+			"location_groups": schema.ListNestedAttribute{
+				MarkdownDescription: "The groups of locations shipping to the same zones. A location can only be in one group.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the location group.",
+						},
+						"location_ids": schema.SetAttribute{
+							MarkdownDescription: "The IDs of the locations, e.g. `gid://shopify/Location/1234567890`.",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+						"zones": schema.ListNestedAttribute{
+							MarkdownDescription: "The zones the locations ship to.",
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The ID of the zone.",
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name of the zone, unique in the delivery profile.",
+										Required:            true,
+									},
+									"countries": schema.ListNestedAttribute{
+										MarkdownDescription: "The countries of the zone.",
+										Required:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"code": schema.StringAttribute{
+													MarkdownDescription: "The two-letter code of the country, e.g. `US`.",
+													Required:            true,
+												},
+												"province_codes": schema.SetAttribute{
+													MarkdownDescription: "The codes of the provinces of the country in the zone, e.g. `CA`. All the provinces are in the zone when unset.",
+													ElementType:         types.StringType,
+													Optional:            true,
+												},
+											},
+										},
+									},
+									"rates": schema.ListNestedAttribute{
+										MarkdownDescription: "The shipping rates of the zone.",
+										Required:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.StringAttribute{
+													Computed:            true,
+													MarkdownDescription: "The ID of the rate.",
+												},
+												"name": schema.StringAttribute{
+													MarkdownDescription: "The name of the rate shown to the customers at checkout, unique in the zone.",
+													Required:            true,
+												},
+												"description": schema.StringAttribute{
+													MarkdownDescription: "The description of the rate.",
+													Optional:            true,
+												},
+												"active": schema.BoolAttribute{
+													MarkdownDescription: "Whether the rate is offered at checkout. Defaults to `true`.",
+													Optional:            true,
+													Computed:            true,
+													Default:             booldefault.StaticBool(true),
+												},
+												"price": schema.Float64Attribute{
+													MarkdownDescription: "The price of the rate.",
+													Required:            true,
+												},
+												"currency_code": schema.StringAttribute{
+													MarkdownDescription: "The currency of the price, e.g. `USD`.",
+													Required:            true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DeliveryProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *DeliveryProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DeliveryProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Zones and rates are matched by name, so the names must be unique
+	zoneNames := map[string]bool{}
+	for i, locationGroup := range data.LocationGroups {
+		for j, zone := range locationGroup.Zones {
+			zonePath := path.Root("location_groups").AtListIndex(i).AtName("zones").AtListIndex(j)
+			if !zone.Name.IsUnknown() {
+				if zoneNames[zone.Name.ValueString()] {
+					resp.Diagnostics.AddAttributeError(zonePath.AtName("name"), "Duplicate zone name",
+						fmt.Sprintf("The zone name %q is used more than once in the delivery profile.", zone.Name.ValueString()))
+				}
+				zoneNames[zone.Name.ValueString()] = true
+			}
+			rateNames := map[string]bool{}
+			for k, rate := range zone.Rates {
+				if rate.Name.IsUnknown() {
+					continue
+				}
+				if rateNames[rate.Name.ValueString()] {
+					resp.Diagnostics.AddAttributeError(zonePath.AtName("rates").AtListIndex(k).AtName("name"), "Duplicate rate name",
+						fmt.Sprintf("The rate name %q is used more than once in the zone.", rate.Name.ValueString()))
+				}
+				rateNames[rate.Name.ValueString()] = true
+			}
+		}
+	}
+}
+
+func (r *DeliveryProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeliveryProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.CreateDeliveryProfile(ctx, convertDeliveryProfileModelToCreateInput(&data))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create delivery profile", err))
+		return
+	}
+	tflog.Trace(ctx, "created a delivery profile", map[string]interface{}{
+		"id": profile.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertDeliveryProfileToResourceModel(profile, &data))...)
+}
+
+func (r *DeliveryProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeliveryProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetDeliveryProfile(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read delivery profile", err))
+		return
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertDeliveryProfileToResourceModel(profile, &data))...)
+}
+
+func (r *DeliveryProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeliveryProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Diff against the current profile in Shopify, which knows the IDs of the rate definitions to update
+	profile, err := r.client.GetDeliveryProfile(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read delivery profile", err))
+		return
+	}
+	if profile == nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update delivery profile", errors.New("the delivery profile doesn't exist")))
+		return
+	}
+
+	profile, err = r.client.UpdateDeliveryProfile(ctx, data.ID.ValueString(), convertDeliveryProfileModelToUpdateInput(&data, profile))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update delivery profile", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertDeliveryProfileToResourceModel(profile, &data))...)
+}
+
+func (r *DeliveryProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeliveryProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteDeliveryProfile(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete delivery profile", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a delivery profile", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *DeliveryProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertDeliveryProfileModelToCreateInput(data *DeliveryProfileResourceModel) *shopify.DeliveryProfileInput {
+	input := &shopify.DeliveryProfileInput{Name: data.Name.ValueStringPointer()}
+	for _, locationGroup := range data.LocationGroups {
+		input.LocationGroupsToCreate = append(input.LocationGroupsToCreate, convertDeliveryLocationGroupModelToCreateInput(locationGroup))
+	}
+	return input
+}
+
+func convertDeliveryLocationGroupModelToCreateInput(locationGroup *DeliveryProfileLocationGroupModel) *shopify.DeliveryProfileLocationGroupInput {
+	input := &shopify.DeliveryProfileLocationGroupInput{Locations: convertStringValuesToStrings(locationGroup.LocationIDs)}
+	for _, zone := range locationGroup.Zones {
+		input.ZonesToCreate = append(input.ZonesToCreate, convertDeliveryZoneModelToInput(zone, nil, nil))
+	}
+	return input
+}
+
+// convertDeliveryProfileModelToUpdateInput diffs the planned location groups, zones and rates against the current profile,
+// creating the new ones, updating the existing ones and deleting the ones not planned anymore.
+// A planned location group is the current one sharing any of its locations, and zones and rates are matched by name.
+func convertDeliveryProfileModelToUpdateInput(data *DeliveryProfileResourceModel, profile *shopify.DeliveryProfile) *shopify.DeliveryProfileInput {
+	input := &shopify.DeliveryProfileInput{Name: data.Name.ValueStringPointer()}
+	matchedLocationGroups := map[string]bool{}
+	for _, locationGroup := range data.LocationGroups {
+		locationIDs := convertStringValuesToStrings(locationGroup.LocationIDs)
+		current, ok := xslice.FindBy(profile.ProfileLocationGroups, func(v *shopify.DeliveryProfileLocationGroup) bool {
+			return !matchedLocationGroups[v.LocationGroup.ID] && sharesAnyString(v.LocationIDs(), locationIDs)
+		})
+		if !ok {
+			input.LocationGroupsToCreate = append(input.LocationGroupsToCreate, convertDeliveryLocationGroupModelToCreateInput(locationGroup))
+			continue
+		}
+		matchedLocationGroups[current.LocationGroup.ID] = true
+
+		groupInput := &shopify.DeliveryProfileLocationGroupInput{
+			ID:                &current.LocationGroup.ID,
+			LocationsToAdd:    subtractStrings(locationIDs, current.LocationIDs()),
+			LocationsToRemove: subtractStrings(current.LocationIDs(), locationIDs),
+		}
+		matchedZones := map[string]bool{}
+		for _, zone := range locationGroup.Zones {
+			currentZone, ok := xslice.FindBy(current.LocationGroupZones.Nodes, func(v *shopify.DeliveryLocationGroupZone) bool {
+				return v.Zone.Name == zone.Name.ValueString()
+			})
+			if !ok {
+				groupInput.ZonesToCreate = append(groupInput.ZonesToCreate, convertDeliveryZoneModelToInput(zone, nil, nil))
+				continue
+			}
+			matchedZones[currentZone.Zone.ID] = true
+			groupInput.ZonesToUpdate = append(groupInput.ZonesToUpdate, convertDeliveryZoneModelToInput(zone, currentZone, &input.MethodDefinitionsToDelete))
+		}
+		for _, currentZone := range current.LocationGroupZones.Nodes {
+			if !matchedZones[currentZone.Zone.ID] {
+				input.ZonesToDelete = append(input.ZonesToDelete, currentZone.Zone.ID)
+			}
+		}
+		input.LocationGroupsToUpdate = append(input.LocationGroupsToUpdate, groupInput)
+	}
+	for _, current := range profile.ProfileLocationGroups {
+		if !matchedLocationGroups[current.LocationGroup.ID] {
+			input.LocationGroupsToDelete = append(input.LocationGroupsToDelete, current.LocationGroup.ID)
+		}
+	}
+	return input
+}
+
+// convertDeliveryZoneModelToInput returns the input to create the zone, or to update the current zone if it's not nil.
+// The IDs of the current rates not planned anymore are appended to methodDefinitionsToDelete.
+func convertDeliveryZoneModelToInput(zone *DeliveryZoneModel, current *shopify.DeliveryLocationGroupZone, methodDefinitionsToDelete *[]string) *shopify.DeliveryLocationGroupZoneInput {
+	input := &shopify.DeliveryLocationGroupZoneInput{Name: zone.Name.ValueString()}
+	for _, country := range zone.Countries {
+		countryInput := &shopify.DeliveryCountryInput{
+			Code:                country.Code.ValueStringPointer(),
+			IncludeAllProvinces: country.ProvinceCodes == nil,
+		}
+		for _, provinceCode := range country.ProvinceCodes {
+			countryInput.Provinces = append(countryInput.Provinces, &shopify.DeliveryProvinceInput{Code: provinceCode.ValueString()})
+		}
+		input.Countries = append(input.Countries, countryInput)
+	}
+
+	var currentRates []*shopify.DeliveryMethodDefinition
+	if current != nil {
+		input.ID = &current.Zone.ID
+		currentRates = current.MethodDefinitions.Nodes
+	}
+	matchedRates := map[string]bool{}
+	for _, rate := range zone.Rates {
+		rateInput := &shopify.DeliveryMethodDefinitionInput{
+			Name:           rate.Name.ValueString(),
+			Description:    rate.Description.ValueStringPointer(),
+			Active:         rate.Active.ValueBool(),
+			RateDefinition: &shopify.DeliveryRateDefinitionInput{Price: convertAmountToMoney(rate.Price, rate.CurrencyCode)},
+		}
+		currentRate, ok := xslice.FindBy(currentRates, func(v *shopify.DeliveryMethodDefinition) bool {
+			return v.Name == rate.Name.ValueString()
+		})
+		if !ok {
+			input.MethodDefinitionsToCreate = append(input.MethodDefinitionsToCreate, rateInput)
+			continue
+		}
+		matchedRates[currentRate.ID] = true
+		rateInput.ID = &currentRate.ID
+		if currentRate.RateProvider.ID != "" {
+			rateInput.RateDefinition.ID = &currentRate.RateProvider.ID
+		}
+		input.MethodDefinitionsToUpdate = append(input.MethodDefinitionsToUpdate, rateInput)
+	}
+	for _, currentRate := range currentRates {
+		if !matchedRates[currentRate.ID] {
+			*methodDefinitionsToDelete = append(*methodDefinitionsToDelete, currentRate.ID)
+		}
+	}
+	return input
+}
+
+func convertDeliveryProfileToResourceModel(profile *shopify.DeliveryProfile, data *DeliveryProfileResourceModel) *DeliveryProfileResourceModel {
+	matchedLocationGroups := map[*DeliveryProfileLocationGroupModel]bool{}
+	locationGroups := make([]*DeliveryProfileLocationGroupModel, 0, len(profile.ProfileLocationGroups))
+	order := make(map[*DeliveryProfileLocationGroupModel]int, len(profile.ProfileLocationGroups))
+	for _, locationGroup := range profile.ProfileLocationGroups {
+		current, _ := xslice.FindBy(data.LocationGroups, func(v *DeliveryProfileLocationGroupModel) bool {
+			return !matchedLocationGroups[v] && sharesAnyString(convertStringValuesToStrings(v.LocationIDs), locationGroup.LocationIDs())
+		})
+		matchedLocationGroups[current] = true
+		model := convertDeliveryLocationGroupToModel(locationGroup, current)
+		order[model] = indexOf(data.LocationGroups, current)
+		locationGroups = append(locationGroups, model)
+	}
+	// Sort location groups by order in the original data not to produce unnecessary diffs
+	sort.SliceStable(locationGroups, func(i, j int) bool {
+		return order[locationGroups[i]] < order[locationGroups[j]]
+	})
+
+	return &DeliveryProfileResourceModel{
+		ID:             types.StringValue(profile.ID),
+		Name:           types.StringValue(profile.Name),
+		LocationGroups: locationGroups,
+	}
+}
+
+func convertDeliveryLocationGroupToModel(locationGroup *shopify.DeliveryProfileLocationGroup, data *DeliveryProfileLocationGroupModel) *DeliveryProfileLocationGroupModel {
+	var currentZones []*DeliveryZoneModel
+	if data != nil {
+		currentZones = data.Zones
+	}
+	zones := make([]*DeliveryZoneModel, 0, len(locationGroup.LocationGroupZones.Nodes))
+	order := make(map[*DeliveryZoneModel]int, len(locationGroup.LocationGroupZones.Nodes))
+	for _, zone := range locationGroup.LocationGroupZones.Nodes {
+		current, _ := xslice.FindBy(currentZones, func(v *DeliveryZoneModel) bool {
+			return v.Name.ValueString() == zone.Zone.Name
+		})
+		model := convertDeliveryZoneToModel(zone, current)
+		order[model] = indexOf(currentZones, current)
+		zones = append(zones, model)
+	}
+	sort.SliceStable(zones, func(i, j int) bool {
+		return order[zones[i]] < order[zones[j]]
+	})
+
+	locationIDs := make([]types.String, 0, len(locationGroup.LocationGroup.Locations.Nodes))
+	for _, id := range locationGroup.LocationIDs() {
+		locationIDs = append(locationIDs, types.StringValue(id))
+	}
+	return &DeliveryProfileLocationGroupModel{
+		ID:          types.StringValue(locationGroup.LocationGroup.ID),
+		LocationIDs: locationIDs,
+		Zones:       zones,
+	}
+}
+
+func convertDeliveryZoneToModel(zone *shopify.DeliveryLocationGroupZone, data *DeliveryZoneModel) *DeliveryZoneModel {
+	var currentCountries []*DeliveryCountryModel
+	var currentRates []*DeliveryRateModel
+	if data != nil {
+		currentCountries = data.Countries
+		currentRates = data.Rates
+	}
+
+	countries := make([]*DeliveryCountryModel, 0, len(zone.Zone.Countries))
+	countryOrder := make(map[*DeliveryCountryModel]int, len(zone.Zone.Countries))
+	for _, country := range zone.Zone.Countries {
+		// The rest of the world isn't a country the resource can configure
+		if country.Code.CountryCode == nil {
+			continue
+		}
+		current, _ := xslice.FindBy(currentCountries, func(v *DeliveryCountryModel) bool {
+			return v.Code.ValueString() == *country.Code.CountryCode
+		})
+		model := &DeliveryCountryModel{Code: types.StringValue(*country.Code.CountryCode)}
+		// Shopify lists every province of a country including all of them, so keep them unset unless they're configured
+		if current != nil && current.ProvinceCodes != nil {
+			for _, province := range country.Provinces {
+				model.ProvinceCodes = append(model.ProvinceCodes, types.StringValue(province.Code))
+			}
+		}
+		countryOrder[model] = indexOf(currentCountries, current)
+		countries = append(countries, model)
+	}
+	sort.SliceStable(countries, func(i, j int) bool {
+		return countryOrder[countries[i]] < countryOrder[countries[j]]
+	})
+
+	rates := make([]*DeliveryRateModel, 0, len(zone.MethodDefinitions.Nodes))
+	rateOrder := make(map[*DeliveryRateModel]int, len(zone.MethodDefinitions.Nodes))
+	for _, methodDefinition := range zone.MethodDefinitions.Nodes {
+		current, _ := xslice.FindBy(currentRates, func(v *DeliveryRateModel) bool {
+			return v.Name.ValueString() == methodDefinition.Name
+		})
+		model := &DeliveryRateModel{
+			ID:           types.StringValue(methodDefinition.ID),
+			Name:         types.StringValue(methodDefinition.Name),
+			Description:  types.StringValue(methodDefinition.Description),
+			Active:       types.BoolValue(methodDefinition.Active),
+			Price:        types.Float64Null(),
+			CurrencyCode: types.StringNull(),
+		}
+		if current != nil {
+			model.Price, model.CurrencyCode = current.Price, current.CurrencyCode
+		}
+		// Shopify API handles empty string and null as the same value
+		if methodDefinition.Description == "" && (current == nil || current.Description.IsNull()) {
+			model.Description = types.StringNull()
+		}
+		model.Price, model.CurrencyCode = convertMoneyToModel(methodDefinition.RateProvider.Price, model.Price, model.CurrencyCode)
+		rateOrder[model] = indexOf(currentRates, current)
+		rates = append(rates, model)
+	}
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rateOrder[rates[i]] < rateOrder[rates[j]]
+	})
+
+	return &DeliveryZoneModel{
+		ID:        types.StringValue(zone.Zone.ID),
+		Name:      types.StringValue(zone.Zone.Name),
+		Countries: countries,
+		Rates:     rates,
+	}
+}
+
+// indexOf returns the index of v in s, or the length of s if it's not there so that new items are sorted last.
+func indexOf[T comparable](s []T, v T) int {
+	for i, item := range s {
+		if item == v {
+			return i
+		}
+	}
+	return len(s)
+}
+
+func convertStringValuesToStrings(values []types.String) []string {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		s = append(s, v.ValueString())
+	}
+	return s
+}
+
+// sharesAnyString returns whether a and b have any string in common.
+func sharesAnyString(a, b []string) bool {
+	for _, v := range a {
+		if _, ok := xslice.FindBy(b, func(w string) bool { return v == w }); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// subtractStrings returns the strings in a but not in b.
+func subtractStrings(a, b []string) []string {
+	var diff []string
+	for _, v := range a {
+		if _, ok := xslice.FindBy(b, func(w string) bool { return v == w }); !ok {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
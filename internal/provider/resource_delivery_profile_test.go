@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccDeliveryProfileResource(t *testing.T) {
+	locationID := envOrSkip(t, "SHOPIFY_TEST_LOCATION_ID")
+	name := "tf-test-" + randResourceID(30)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeliveryProfileResourceConfig(name, locationID, "Standard", "5.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "name", name),
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "location_groups.0.location_ids.#", "1"),
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "location_groups.0.zones.0.name", "Domestic"),
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "location_groups.0.zones.0.rates.0.name", "Standard"),
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "location_groups.0.zones.0.rates.0.price", "5"),
+					resource.TestCheckResourceAttrSet("shopify_delivery_profile.test", "location_groups.0.zones.0.rates.0.id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_delivery_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDeliveryProfileResourceConfig(name+"-updated", locationID, "Express", "12.5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "name", name+"-updated"),
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "location_groups.0.zones.0.rates.#", "1"),
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "location_groups.0.zones.0.rates.0.name", "Express"),
+					resource.TestCheckResourceAttr("shopify_delivery_profile.test", "location_groups.0.zones.0.rates.0.price", "12.5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeliveryProfileResourceConfig(name, locationID, rateName, price string) string {
+	return fmt.Sprintf(`
+resource "shopify_delivery_profile" "test" {
+  name = %[1]q
+  location_groups = [
+    {
+      location_ids = [%[2]q]
+      zones = [
+        {
+          name = "Domestic"
+          countries = [
+            { code = "US" },
+          ]
+          rates = [
+            {
+              name          = %[3]q
+              price         = %[4]s
+              currency_code = "USD"
+            },
+          ]
+        },
+      ]
+    },
+  ]
+}
+`, name, locationID, rateName, price)
+}
+
+func TestConvertDeliveryProfileModelToUpdateInput(t *testing.T) {
+	var profile shopify.DeliveryProfile
+	if err := json.Unmarshal([]byte(`{
+  "id": "gid://shopify/DeliveryProfile/1",
+  "name": "Profile",
+  "profileLocationGroups": [
+    {
+      "locationGroup": {"id": "gid://shopify/DeliveryLocationGroup/1", "locations": {"nodes": [{"id": "gid://shopify/Location/1"}, {"id": "gid://shopify/Location/2"}]}},
+      "locationGroupZones": {"nodes": [
+        {
+          "zone": {"id": "gid://shopify/DeliveryZone/1", "name": "Domestic", "countries": [{"code": {"countryCode": "US"}}]},
+          "methodDefinitions": {"nodes": [
+            {"id": "gid://shopify/DeliveryMethodDefinition/1", "name": "Standard", "rateProvider": {"__typename": "DeliveryRateDefinition", "id": "gid://shopify/DeliveryRateDefinition/1"}},
+            {"id": "gid://shopify/DeliveryMethodDefinition/2", "name": "Express", "rateProvider": {"__typename": "DeliveryRateDefinition", "id": "gid://shopify/DeliveryRateDefinition/2"}}
+          ]}
+        },
+        {
+          "zone": {"id": "gid://shopify/DeliveryZone/2", "name": "Europe", "countries": [{"code": {"countryCode": "FR"}}]},
+          "methodDefinitions": {"nodes": []}
+        }
+      ]}
+    },
+    {
+      "locationGroup": {"id": "gid://shopify/DeliveryLocationGroup/2", "locations": {"nodes": [{"id": "gid://shopify/Location/3"}]}},
+      "locationGroupZones": {"nodes": []}
+    }
+  ]
+}`), &profile); err != nil {
+		t.Fatal(err)
+	}
+
+	data := &DeliveryProfileResourceModel{
+		Name: types.StringValue("Profile"),
+		LocationGroups: []*DeliveryProfileLocationGroupModel{
+			{
+				LocationIDs: []types.String{types.StringValue("gid://shopify/Location/2"), types.StringValue("gid://shopify/Location/4")},
+				Zones: []*DeliveryZoneModel{
+					{
+						Name:      types.StringValue("Domestic"),
+						Countries: []*DeliveryCountryModel{{Code: types.StringValue("US"), ProvinceCodes: []types.String{types.StringValue("CA")}}},
+						Rates: []*DeliveryRateModel{
+							{Name: types.StringValue("Standard"), Active: types.BoolValue(true), Price: types.Float64Value(5), CurrencyCode: types.StringValue("USD")},
+							{Name: types.StringValue("Overnight"), Active: types.BoolValue(true), Price: types.Float64Value(20), CurrencyCode: types.StringValue("USD")},
+						},
+					},
+					{
+						Name:      types.StringValue("Asia"),
+						Countries: []*DeliveryCountryModel{{Code: types.StringValue("JP")}},
+					},
+				},
+			},
+			{
+				LocationIDs: []types.String{types.StringValue("gid://shopify/Location/5")},
+			},
+		},
+	}
+
+	input := convertDeliveryProfileModelToUpdateInput(data, &profile)
+	got, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"Profile",` +
+		`"locationGroupsToCreate":[{"locations":["gid://shopify/Location/5"]}],` +
+		`"locationGroupsToUpdate":[{"id":"gid://shopify/DeliveryLocationGroup/1",` +
+		`"locationsToAdd":["gid://shopify/Location/4"],"locationsToRemove":["gid://shopify/Location/1"],` +
+		`"zonesToCreate":[{"name":"Asia","countries":[{"code":"JP","restOfWorld":false,"includeAllProvinces":true}]}],` +
+		`"zonesToUpdate":[{"id":"gid://shopify/DeliveryZone/1","name":"Domestic",` +
+		`"countries":[{"code":"US","restOfWorld":false,"provinces":[{"code":"CA"}],"includeAllProvinces":false}],` +
+		`"methodDefinitionsToCreate":[{"name":"Overnight","active":true,"rateDefinition":{"price":{"amount":"20","currencyCode":"USD"}}}],` +
+		`"methodDefinitionsToUpdate":[{"id":"gid://shopify/DeliveryMethodDefinition/1","name":"Standard","active":true,` +
+		`"rateDefinition":{"id":"gid://shopify/DeliveryRateDefinition/1","price":{"amount":"5","currencyCode":"USD"}}}]}]}],` +
+		`"locationGroupsToDelete":["gid://shopify/DeliveryLocationGroup/2"],` +
+		`"zonesToDelete":["gid://shopify/DeliveryZone/2"],` +
+		`"methodDefinitionsToDelete":["gid://shopify/DeliveryMethodDefinition/2"]}`
+	if string(got) != want {
+		t.Errorf("convertDeliveryProfileModelToUpdateInput() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscountRedeemCodeBulkResource{}
+var _ resource.ResourceWithValidateConfig = &DiscountRedeemCodeBulkResource{}
+
+const (
+	generatedDiscountCodeLength  = 12
+	generatedDiscountCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// DiscountRedeemCodeBulkResource defines the resource implementation.
+type DiscountRedeemCodeBulkResource struct {
+	client *shopify.Client
+}
+
+func NewDiscountRedeemCodeBulkResource() resource.Resource {
+	return &DiscountRedeemCodeBulkResource{}
+}
+
+// DiscountRedeemCodeBulkResourceModel describes the resource data model.
+type DiscountRedeemCodeBulkResourceModel struct {
+	ID            types.String                         `tfsdk:"id"`
+	DiscountID    types.String                         `tfsdk:"discount_id"`
+	Codes         types.List                           `tfsdk:"codes"`
+	GenerateCount types.Int64                          `tfsdk:"generate_count"`
+	ImportedCount types.Int64                          `tfsdk:"imported_count"`
+	FailedCount   types.Int64                          `tfsdk:"failed_count"`
+	Results       []*DiscountRedeemCodeBulkResultModel `tfsdk:"results"`
+}
+
+// DiscountRedeemCodeBulkResultModel describes the result of adding a single code.
+type DiscountRedeemCodeBulkResultModel struct {
+	Code  types.String `tfsdk:"code"`
+	ID    types.String `tfsdk:"id"`
+	Error types.String `tfsdk:"error"`
+}
+
+func (r *DiscountRedeemCodeBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discount_redeem_code_bulk"
+}
+
+func (r *DiscountRedeemCodeBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Adds redeem codes to a code discount in bulk. Up to %d codes can be added by a single resource. Destroying the resource deletes the codes.", shopify.MaxDiscountRedeemCodeBulkSize),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the bulk creation of the codes.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"discount_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the code discount to add the codes to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"codes": schema.ListAttribute{
+				MarkdownDescription: "The codes to add. Exactly one of `codes` and `generate_count` must be set. When `generate_count` is set, this is the list of generated codes.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"generate_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of random codes to generate. Exactly one of `codes` and `generate_count` must be set.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"imported_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of codes that were added successfully.",
+				Computed:            true,
+			},
+			"failed_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of codes that couldn't be added.",
+				Computed:            true,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The result of adding each code.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "The code.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the redeem code, if it was added.",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "The reason the code couldn't be added, if it failed.",
+							Computed:            true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *DiscountRedeemCodeBulkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscountRedeemCodeBulkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Codes.IsUnknown() || data.GenerateCount.IsUnknown() {
+		return
+	}
+
+	if data.Codes.IsNull() == data.GenerateCount.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("codes"), "Invalid Attribute Combination", "Exactly one of codes and generate_count must be set.")
+		return
+	}
+	if !data.Codes.IsNull() && len(data.Codes.Elements()) > shopify.MaxDiscountRedeemCodeBulkSize {
+		resp.Diagnostics.AddAttributeError(path.Root("codes"), "Too many codes", fmt.Sprintf("At most %d codes can be added, got %d.", shopify.MaxDiscountRedeemCodeBulkSize, len(data.Codes.Elements())))
+	}
+	if !data.GenerateCount.IsNull() && (data.GenerateCount.ValueInt64() < 1 || data.GenerateCount.ValueInt64() > shopify.MaxDiscountRedeemCodeBulkSize) {
+		resp.Diagnostics.AddAttributeError(path.Root("generate_count"), "Invalid generate_count", fmt.Sprintf("generate_count must be between 1 and %d, got %d.", shopify.MaxDiscountRedeemCodeBulkSize, data.GenerateCount.ValueInt64()))
+	}
+}
+
+func (r *DiscountRedeemCodeBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *DiscountRedeemCodeBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscountRedeemCodeBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var codes []string
+	if data.Codes.IsNull() || data.Codes.IsUnknown() {
+		for i := int64(0); i < data.GenerateCount.ValueInt64(); i++ {
+			code, err := generateDiscountCode()
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to generate discount code", err.Error())
+				return
+			}
+			codes = append(codes, code)
+		}
+	} else {
+		resp.Diagnostics.Append(data.Codes.ElementsAs(ctx, &codes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	bulkCreation, err := r.client.AddDiscountRedeemCodes(ctx, data.DiscountID.ValueString(), codes)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to add discount redeem codes", err))
+		return
+	}
+
+	createdData := convertDiscountRedeemCodeBulkCreationToResourceModel(bulkCreation, data, codes)
+	tflog.Trace(ctx, "created discount redeem codes", map[string]interface{}{
+		"id": createdData.ID,
+	})
+	if createdData.FailedCount.ValueInt64() > 0 {
+		resp.Diagnostics.AddWarning(
+			"Some discount codes couldn't be added",
+			fmt.Sprintf("%d of %d codes couldn't be added. Check the results attribute for the reasons.", createdData.FailedCount.ValueInt64(), len(codes)),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *DiscountRedeemCodeBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscountRedeemCodeBulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bulkCreation, err := r.client.GetDiscountRedeemCodeBulkCreation(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read discount redeem code bulk creation", err))
+		return
+	}
+	if bulkCreation == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var codes []string
+	resp.Diagnostics.Append(data.Codes.ElementsAs(ctx, &codes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertDiscountRedeemCodeBulkCreationToResourceModel(bulkCreation, data, codes))...)
+}
+
+func (r *DiscountRedeemCodeBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update.
+	var data DiscountRedeemCodeBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscountRedeemCodeBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscountRedeemCodeBulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]string, 0, len(data.Results))
+	for _, result := range data.Results {
+		if !result.ID.IsNull() {
+			ids = append(ids, result.ID.ValueString())
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	err := r.client.DeleteDiscountRedeemCodes(ctx, data.DiscountID.ValueString(), ids)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete discount redeem codes", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted discount redeem codes", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+// generateDiscountCode generates a random code, avoiding characters that are easily confused with each other.
+func generateDiscountCode() (string, error) {
+	code := make([]byte, generatedDiscountCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(generatedDiscountCodeCharset))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = generatedDiscountCodeCharset[n.Int64()]
+	}
+	return string(code), nil
+}
+
+func convertDiscountRedeemCodeBulkCreationToResourceModel(bulkCreation *shopify.DiscountRedeemCodeBulkCreation, data DiscountRedeemCodeBulkResourceModel, codes []string) *DiscountRedeemCodeBulkResourceModel {
+	codeValues := make([]attr.Value, 0, len(codes))
+	for _, code := range codes {
+		codeValues = append(codeValues, types.StringValue(code))
+	}
+	results := make([]*DiscountRedeemCodeBulkResultModel, 0, len(bulkCreation.Codes.Nodes))
+	for _, code := range bulkCreation.Codes.Nodes {
+		result := &DiscountRedeemCodeBulkResultModel{
+			Code:  types.StringValue(code.Code),
+			ID:    types.StringNull(),
+			Error: types.StringNull(),
+		}
+		if code.DiscountRedeemCode != nil {
+			result.ID = types.StringValue(code.DiscountRedeemCode.ID)
+		}
+		if err := code.Errors.Error(); err != nil {
+			result.Error = types.StringValue(err.Error())
+		}
+		results = append(results, result)
+	}
+	return &DiscountRedeemCodeBulkResourceModel{
+		ID:            types.StringValue(bulkCreation.ID),
+		DiscountID:    data.DiscountID,
+		Codes:         types.ListValueMust(types.StringType, codeValues),
+		GenerateCount: data.GenerateCount,
+		ImportedCount: types.Int64Value(int64(bulkCreation.ImportedCount)),
+		FailedCount:   types.Int64Value(int64(bulkCreation.FailedCount)),
+		Results:       results,
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDiscountRedeemCodeBulkResource(t *testing.T) {
+	discountID := envOrSkip(t, "SHOPIFY_TEST_CODE_DISCOUNT_ID")
+	code := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Explicit codes
+			{
+				Config: testAccDiscountRedeemCodeBulkResourceCodesConfig(discountID, code),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_discount_redeem_code_bulk.test", "codes.#", "1"),
+					resource.TestCheckResourceAttr("shopify_discount_redeem_code_bulk.test", "codes.0", code),
+					resource.TestCheckResourceAttr("shopify_discount_redeem_code_bulk.test", "imported_count", "1"),
+					resource.TestCheckResourceAttr("shopify_discount_redeem_code_bulk.test", "failed_count", "0"),
+					resource.TestCheckResourceAttrSet("shopify_discount_redeem_code_bulk.test", "results.0.id"),
+				),
+			},
+			// Generated codes
+			{
+				Config: testAccDiscountRedeemCodeBulkResourceCountConfig(discountID, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_discount_redeem_code_bulk.test", "codes.#", "3"),
+					resource.TestCheckResourceAttr("shopify_discount_redeem_code_bulk.test", "imported_count", "3"),
+					resource.TestCheckResourceAttr("shopify_discount_redeem_code_bulk.test", "results.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDiscountRedeemCodeBulkResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDiscountRedeemCodeBulkResourceCountConfig("gid://shopify/DiscountCodeNode/1", 251),
+				ExpectError: regexp.MustCompile(`Invalid generate_count`),
+			},
+		},
+	})
+}
+
+func testAccDiscountRedeemCodeBulkResourceCodesConfig(discountID, code string) string {
+	return fmt.Sprintf(`
+resource "shopify_discount_redeem_code_bulk" "test" {
+  discount_id = %[1]q
+  codes       = [%[2]q]
+}
+`, discountID, code)
+}
+
+func testAccDiscountRedeemCodeBulkResourceCountConfig(discountID string, count int) string {
+	return fmt.Sprintf(`
+resource "shopify_discount_redeem_code_bulk" "test" {
+  discount_id    = %[1]q
+  generate_count = %[2]d
+}
+`, discountID, count)
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FileAltTextResource{}
+var _ resource.ResourceWithImportState = &FileAltTextResource{}
+
+// FileAltTextResource defines the resource implementation.
+type FileAltTextResource struct {
+	client *shopify.Client
+}
+
+func NewFileAltTextResource() resource.Resource {
+	return &FileAltTextResource{}
+}
+
+// FileAltTextResourceModel describes the resource data model.
+type FileAltTextResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	FileID   types.String `tfsdk:"file_id"`
+	Alt      types.String `tfsdk:"alt"`
+	Filename types.String `tfsdk:"filename"`
+}
+
+func (r *FileAltTextResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_alt_text"
+}
+
+func (r *FileAltTextResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the alt text and the filename of an existing file, without managing its upload. " +
+			"Destroying the resource only removes it from the state, leaving the file as it is.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the file, e.g. `gid://shopify/MediaImage/1234567890`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"alt": schema.StringAttribute{
+				MarkdownDescription: "The alternative text of the file, read by screen readers.",
+				Required:            true,
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "The name of the file, including its extension. The file is left with its name when unset. " +
+					"The filename can't be read back, so changes made outside of Terraform aren't detected.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *FileAltTextResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *FileAltTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FileAltTextResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.UpdateFile(ctx, convertFileAltTextResourceModelToInput(data, types.StringNull()))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update file", err))
+		return
+	}
+
+	data.ID = types.StringValue(file.ID)
+	data.Alt = types.StringValue(stringValueOrEmpty(file.Alt))
+	tflog.Trace(ctx, "created a file alt text", map[string]interface{}{
+		"id": data.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileAltTextResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FileAltTextResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.GetFile(ctx, data.FileID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read file", err))
+		return
+	}
+	if file == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(file.ID)
+	data.Alt = types.StringValue(stringValueOrEmpty(file.Alt))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileAltTextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FileAltTextResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.UpdateFile(ctx, convertFileAltTextResourceModelToInput(data, state.Filename))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update file", err))
+		return
+	}
+
+	data.ID = types.StringValue(file.ID)
+	data.Alt = types.StringValue(stringValueOrEmpty(file.Alt))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileAltTextResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The file isn't owned by the resource, so it's left as it is
+	tflog.Trace(ctx, "deleted a file alt text")
+}
+
+func (r *FileAltTextResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_id"), req.ID)...)
+}
+
+// convertFileAltTextResourceModelToInput returns the input of the update. The filename is only sent when it has changed,
+// not to rename the file again on every alt text change.
+func convertFileAltTextResourceModelToInput(data FileAltTextResourceModel, currentFilename types.String) *shopify.FileUpdateInput {
+	input := &shopify.FileUpdateInput{
+		ID:  data.FileID.ValueString(),
+		Alt: data.Alt.ValueStringPointer(),
+	}
+	if !data.Filename.IsNull() && !data.Filename.Equal(currentFilename) {
+		input.Filename = data.Filename.ValueStringPointer()
+	}
+	return input
+}
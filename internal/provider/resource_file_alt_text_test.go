@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFileAltTextResource(t *testing.T) {
+	fileID := envOrSkip(t, "SHOPIFY_TEST_FILE_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFileAltTextResourceConfig(fileID, "A red t-shirt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_file_alt_text.test", "id", fileID),
+					resource.TestCheckResourceAttr("shopify_file_alt_text.test", "alt", "A red t-shirt"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_file_alt_text.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFileAltTextResourceConfig(fileID, "A red t-shirt on a white background"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_file_alt_text.test", "alt", "A red t-shirt on a white background"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFileAltTextResourceConfig(fileID, alt string) string {
+	return fmt.Sprintf(`
+resource "shopify_file_alt_text" "test" {
+  file_id = %[1]q
+  alt     = %[2]q
+}
+`, fileID, alt)
+}
+
+func TestConvertFileAltTextResourceModelToInput(t *testing.T) {
+	data := FileAltTextResourceModel{
+		FileID:   types.StringValue("gid://shopify/MediaImage/1"),
+		Alt:      types.StringValue("A red t-shirt"),
+		Filename: types.StringValue("t-shirt.jpg"),
+	}
+
+	input := convertFileAltTextResourceModelToInput(data, types.StringNull())
+	if input.Filename == nil || *input.Filename != "t-shirt.jpg" {
+		t.Errorf("expected the filename to be set, got %+v", input)
+	}
+
+	input = convertFileAltTextResourceModelToInput(data, types.StringValue("t-shirt.jpg"))
+	if input.Filename != nil {
+		t.Errorf("expected the unchanged filename not to be sent, got %q", *input.Filename)
+	}
+	if input.Alt == nil || *input.Alt != "A red t-shirt" {
+		t.Errorf("expected the alt text to be set, got %+v", input)
+	}
+}
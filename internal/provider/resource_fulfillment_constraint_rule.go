@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FulfillmentConstraintRuleResource{}
+var _ resource.ResourceWithImportState = &FulfillmentConstraintRuleResource{}
+var _ resource.ResourceWithValidateConfig = &FulfillmentConstraintRuleResource{}
+var _ resource.ResourceWithModifyPlan = &FulfillmentConstraintRuleResource{}
+
+// FulfillmentConstraintRuleResource defines the resource implementation.
+type FulfillmentConstraintRuleResource struct {
+	client *shopify.Client
+}
+
+func NewFulfillmentConstraintRuleResource() resource.Resource {
+	return &FulfillmentConstraintRuleResource{}
+}
+
+// FulfillmentConstraintRuleResourceModel describes the resource data model.
+type FulfillmentConstraintRuleResourceModel struct {
+	ID                  types.String              `tfsdk:"id"`
+	FunctionID          types.String              `tfsdk:"function_id"`
+	DeliveryMethodTypes []types.String            `tfsdk:"delivery_method_types"`
+	Metafields          []*FunctionMetafieldModel `tfsdk:"metafields"`
+}
+
+func (r *FulfillmentConstraintRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fulfillment_constraint_rule"
+}
+
+func (r *FulfillmentConstraintRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a fulfillment constraint rule, which constrains how orders are fulfilled with a fulfillment constraint Shopify Function of an app.\n\n" +
+			"A rule can't be updated, so changing any attribute replaces it. Only the configured `metafields` are tracked, " +
+			"the other metafields of the rule are left as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the rule, e.g. `gid://shopify/FulfillmentConstraintRule/1234567890`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Shopify Function implementing the rule, whose API type is `fulfillment_constraints`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"delivery_method_types": schema.SetAttribute{
+				MarkdownDescription: "The delivery methods the rule applies to, any of `" + strings.Join(shopify.DeliveryMethodTypes, "`, `") + "`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+			},
+			"metafields": functionMetafieldsAttribute("The metafields of the rule, which the function reads its configuration from.",
+				listplanmodifier.RequiresReplace()),
+		},
+	}
+}
+
+func (r *FulfillmentConstraintRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *FulfillmentConstraintRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FulfillmentConstraintRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, deliveryMethodType := range data.DeliveryMethodTypes {
+		if deliveryMethodType.IsUnknown() || slices.Contains(shopify.DeliveryMethodTypes, deliveryMethodType.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("delivery_method_types"), "Invalid delivery_method_types",
+			fmt.Sprintf("expected any of %s, got %q", strings.Join(shopify.DeliveryMethodTypes, ", "), deliveryMethodType.ValueString()))
+	}
+
+	resp.Diagnostics.Append(validateFunctionMetafields(data.Metafields)...)
+}
+
+func (r *FulfillmentConstraintRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or without a client to check the function with
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var functionID, stateFunctionID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("function_id"), &functionID)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("function_id"), &stateFunctionID)...)
+	}
+	if resp.Diagnostics.HasError() || functionID.IsUnknown() || functionID.Equal(stateFunctionID) {
+		return
+	}
+
+	function, err := r.client.GetShopifyFunction(ctx, functionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read Shopify Function", err))
+		return
+	}
+	resp.Diagnostics.Append(validateShopifyFunction(functionID.ValueString(), function,
+		"a fulfillment constraint", shopify.FulfillmentConstraintFunctionAPITypes)...)
+}
+
+func (r *FulfillmentConstraintRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FulfillmentConstraintRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.CreateFulfillmentConstraintRule(ctx, &shopify.FulfillmentConstraintRuleCreateInput{
+		FunctionID:          data.FunctionID.ValueString(),
+		DeliveryMethodTypes: convertStringValuesToStrings(data.DeliveryMethodTypes),
+		Metafields:          convertFunctionMetafieldModelsToInputs(data.Metafields),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create fulfillment constraint rule", err))
+		return
+	}
+
+	// The created rule has no metafields, so read it back
+	created, err := r.client.GetFulfillmentConstraintRule(ctx, rule.ID)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read fulfillment constraint rule", err))
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read fulfillment constraint rule", fmt.Errorf("the rule %s disappeared right after its creation", rule.ID)))
+		return
+	}
+
+	createdData := convertFulfillmentConstraintRuleToResourceModel(created, data)
+	tflog.Trace(ctx, "created a fulfillment constraint rule", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *FulfillmentConstraintRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FulfillmentConstraintRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetFulfillmentConstraintRule(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read fulfillment constraint rule", err))
+		return
+	}
+	if rule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertFulfillmentConstraintRuleToResourceModel(rule, data))...)
+}
+
+func (r *FulfillmentConstraintRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, so there is nothing to update in place.
+	var data FulfillmentConstraintRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FulfillmentConstraintRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FulfillmentConstraintRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteFulfillmentConstraintRule(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete fulfillment constraint rule", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a fulfillment constraint rule", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *FulfillmentConstraintRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertFulfillmentConstraintRuleToResourceModel(rule *shopify.FulfillmentConstraintRule, data FulfillmentConstraintRuleResourceModel) *FulfillmentConstraintRuleResourceModel {
+	deliveryMethodTypes := make([]types.String, 0, len(rule.DeliveryMethodTypes))
+	for _, deliveryMethodType := range rule.DeliveryMethodTypes {
+		deliveryMethodTypes = append(deliveryMethodTypes, types.StringValue(deliveryMethodType))
+	}
+	return &FulfillmentConstraintRuleResourceModel{
+		ID:                  types.StringValue(rule.ID),
+		FunctionID:          types.StringValue(rule.Function.ID),
+		DeliveryMethodTypes: deliveryMethodTypes,
+		Metafields:          convertMetafieldsToFunctionMetafieldModels(rule.Metafields, data.Metafields),
+	}
+}
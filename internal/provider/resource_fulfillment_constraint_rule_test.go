@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccFulfillmentConstraintRuleResource(t *testing.T) {
+	functionID := envOrSkip(t, "SHOPIFY_TEST_FULFILLMENT_CONSTRAINT_FUNCTION_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFulfillmentConstraintRuleResourceConfig(functionID, `{"bundle":true}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_fulfillment_constraint_rule.test", "function_id", functionID),
+					resource.TestCheckResourceAttr("shopify_fulfillment_constraint_rule.test", "delivery_method_types.#", "1"),
+					resource.TestCheckResourceAttr("shopify_fulfillment_constraint_rule.test", "metafields.#", "1"),
+					resource.TestCheckResourceAttrSet("shopify_fulfillment_constraint_rule.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "shopify_fulfillment_constraint_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metafields"},
+			},
+			// Replace and Read testing
+			{
+				Config: testAccFulfillmentConstraintRuleResourceConfig(functionID, `{"bundle":false}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_fulfillment_constraint_rule.test", "metafields.0.value", `{"bundle":false}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccFulfillmentConstraintRuleResourceConfig(functionID, configuration string) string {
+	return fmt.Sprintf(`
+resource "shopify_fulfillment_constraint_rule" "test" {
+  function_id           = %[1]q
+  delivery_method_types = ["SHIPPING"]
+  metafields = [
+    {
+      namespace = "$app:fulfillment-constraints"
+      key       = "configuration"
+      type      = "json"
+      value     = %[2]q
+    }
+  ]
+}
+`, functionID, configuration)
+}
+
+func TestConvertFulfillmentConstraintRuleToResourceModel(t *testing.T) {
+	rule := &shopify.FulfillmentConstraintRule{
+		ID:                  "gid://shopify/FulfillmentConstraintRule/1",
+		DeliveryMethodTypes: []string{"SHIPPING", "LOCAL"},
+		Metafields: []*shopify.Metafield{
+			{Namespace: "$app:fulfillment-constraints", Key: "configuration", Type: "json", Value: `{"bundle":true}`},
+			{Namespace: "other", Key: "unmanaged", Type: "single_line_text_field", Value: "x"},
+		},
+	}
+	rule.Function.ID = "fn-1"
+	data := FulfillmentConstraintRuleResourceModel{
+		Metafields: []*FunctionMetafieldModel{
+			{Namespace: types.StringValue("$app:fulfillment-constraints"), Key: types.StringValue("configuration"), Value: types.StringValue(`{ "bundle": true }`)},
+		},
+	}
+
+	got := convertFulfillmentConstraintRuleToResourceModel(rule, data)
+	if got.FunctionID.ValueString() != "fn-1" || len(got.DeliveryMethodTypes) != 2 {
+		t.Errorf("unexpected rule model: %+v", got)
+	}
+	if len(got.Metafields) != 1 || got.Metafields[0].Value.ValueString() != `{ "bundle": true }` {
+		t.Errorf("expected only the configured metafield with its configured value, got %+v", got.Metafields)
+	}
+}
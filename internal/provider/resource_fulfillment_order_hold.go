@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/pkg/xslice"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FulfillmentOrderHoldResource{}
+var _ resource.ResourceWithImportState = &FulfillmentOrderHoldResource{}
+
+// FulfillmentOrderHoldResource defines the resource implementation.
+type FulfillmentOrderHoldResource struct {
+	client *shopify.Client
+}
+
+func NewFulfillmentOrderHoldResource() resource.Resource {
+	return &FulfillmentOrderHoldResource{}
+}
+
+// FulfillmentOrderHoldResourceModel describes the resource data model.
+type FulfillmentOrderHoldResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	FulfillmentOrderID types.String `tfsdk:"fulfillment_order_id"`
+	Reason             types.String `tfsdk:"reason"`
+	ReasonNotes        types.String `tfsdk:"reason_notes"`
+	Held               types.Bool   `tfsdk:"held"`
+}
+
+func (r *FulfillmentOrderHoldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fulfillment_order_hold"
+}
+
+func (r *FulfillmentOrderHoldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Places a hold on a fulfillment order, preventing it from being fulfilled until the hold is released. Destroying the resource releases the hold.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the fulfillment hold.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fulfillment_order_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the fulfillment order to hold.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: `The reason for the fulfillment hold.
+Possible values are:
+  - AWAITING_PAYMENT
+  - AWAITING_RETURN_ITEMS
+  - HIGH_RISK_OF_FRAUD
+  - INCORRECT_ADDRESS
+  - INVENTORY_OUT_OF_STOCK
+  - ONLINE_STORE_POST_PURCHASE_CROSS_SELL
+  - OTHER
+  - UNKNOWN_DELIVERY_DATE
+`,
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"reason_notes": schema.StringAttribute{
+				MarkdownDescription: "Additional information about the fulfillment hold reason.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"held": schema.BoolAttribute{
+				MarkdownDescription: "Whether the fulfillment order is currently held by this hold. Becomes false once the fulfillment order has been fulfilled or cancelled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *FulfillmentOrderHoldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *FulfillmentOrderHoldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FulfillmentOrderHoldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fulfillmentOrder, err := r.client.GetFulfillmentOrder(ctx, data.FulfillmentOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read fulfillment order", err))
+		return
+	}
+	if fulfillmentOrder == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fulfillment_order_id"), "Fulfillment order not found", fmt.Sprintf("Fulfillment order %s does not exist.", data.FulfillmentOrderID.ValueString()))
+		return
+	}
+	if fulfillmentOrder.IsFinished() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fulfillment_order_id"),
+			"Fulfillment order can't be held",
+			fmt.Sprintf("Fulfillment order %s is already %s, so it can no longer be held.", fulfillmentOrder.ID, strings.ToLower(fulfillmentOrder.Status)),
+		)
+		return
+	}
+
+	input := shopify.FulfillmentOrderHoldInput{
+		Reason:      data.Reason.ValueString(),
+		ReasonNotes: data.ReasonNotes.ValueStringPointer(),
+	}
+	hold, fulfillmentOrder, err := r.client.HoldFulfillmentOrder(ctx, data.FulfillmentOrderID.ValueString(), &input)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to hold fulfillment order", err))
+		return
+	}
+
+	createdData := convertFulfillmentHoldToResourceModel(hold, fulfillmentOrder, data)
+	tflog.Trace(ctx, "created a fulfillment order hold", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *FulfillmentOrderHoldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FulfillmentOrderHoldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fulfillmentOrder, err := r.client.GetFulfillmentOrder(ctx, data.FulfillmentOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read fulfillment order", err))
+		return
+	}
+	if fulfillmentOrder == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	hold, ok := xslice.FindBy(fulfillmentOrder.FulfillmentHolds, func(v *shopify.FulfillmentHold) bool {
+		return v.ID == data.ID.ValueString()
+	})
+	if !ok {
+		// A shipped or cancelled order can't be held again, so keep the resource
+		// around as released instead of planning to recreate the hold.
+		if fulfillmentOrder.IsFinished() {
+			data.Held = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertFulfillmentHoldToResourceModel(hold, fulfillmentOrder, data))...)
+}
+
+func (r *FulfillmentOrderHoldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update.
+	var data FulfillmentOrderHoldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FulfillmentOrderHoldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FulfillmentOrderHoldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fulfillmentOrder, err := r.client.GetFulfillmentOrder(ctx, data.FulfillmentOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read fulfillment order", err))
+		return
+	}
+	if fulfillmentOrder == nil || fulfillmentOrder.IsFinished() {
+		tflog.Warn(ctx, "fulfillment order is already finished, skipping the hold release", map[string]interface{}{
+			"id":                   data.ID,
+			"fulfillment_order_id": data.FulfillmentOrderID,
+		})
+		return
+	}
+	if _, ok := xslice.FindBy(fulfillmentOrder.FulfillmentHolds, func(v *shopify.FulfillmentHold) bool {
+		return v.ID == data.ID.ValueString()
+	}); !ok {
+		return
+	}
+
+	err = r.client.ReleaseFulfillmentOrderHold(ctx, data.FulfillmentOrderID.ValueString(), []string{data.ID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to release fulfillment order hold", err))
+		return
+	}
+	tflog.Trace(ctx, "released a fulfillment order hold", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *FulfillmentOrderHoldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Both IDs are GIDs, which contain colons themselves
+	i := strings.LastIndex(req.ID, ":gid://")
+	if i <= 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: fulfillment_order_id:hold_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fulfillment_order_id"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID[i+1:])...)
+}
+
+func convertFulfillmentHoldToResourceModel(hold *shopify.FulfillmentHold, fulfillmentOrder *shopify.FulfillmentOrder, data FulfillmentOrderHoldResourceModel) *FulfillmentOrderHoldResourceModel {
+	reasonNotes := types.StringNull()
+	if hold.ReasonNotes != nil {
+		reasonNotes = convert.EmptyAsNull(*hold.ReasonNotes, data.ReasonNotes)
+	}
+	return &FulfillmentOrderHoldResourceModel{
+		ID:                 types.StringValue(hold.ID),
+		FulfillmentOrderID: types.StringValue(fulfillmentOrder.ID),
+		Reason:             types.StringValue(hold.Reason),
+		ReasonNotes:        reasonNotes,
+		Held:               types.BoolValue(fulfillmentOrder.Status == shopify.FulfillmentOrderStatusOnHold),
+	}
+}
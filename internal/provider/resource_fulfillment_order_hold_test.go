@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFulfillmentOrderHoldResource(t *testing.T) {
+	fulfillmentOrderID := envOrSkip(t, "SHOPIFY_TEST_FULFILLMENT_ORDER_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFulfillmentOrderHoldResourceConfig(fulfillmentOrderID, "Waiting for the address check"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_fulfillment_order_hold.test", "fulfillment_order_id", fulfillmentOrderID),
+					resource.TestCheckResourceAttr("shopify_fulfillment_order_hold.test", "reason", "INCORRECT_ADDRESS"),
+					resource.TestCheckResourceAttr("shopify_fulfillment_order_hold.test", "reason_notes", "Waiting for the address check"),
+					resource.TestCheckResourceAttr("shopify_fulfillment_order_hold.test", "held", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_fulfillment_order_hold.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccFulfillmentOrderHoldImportStateIdFunc("shopify_fulfillment_order_hold.test"),
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: testAccFulfillmentOrderHoldResourceConfig(fulfillmentOrderID, "Waiting for the customer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_fulfillment_order_hold.test", "reason_notes", "Waiting for the customer"),
+					resource.TestCheckResourceAttr("shopify_fulfillment_order_hold.test", "held", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFulfillmentOrderHoldResourceConfig(fulfillmentOrderID, reasonNotes string) string {
+	return fmt.Sprintf(`
+resource "shopify_fulfillment_order_hold" "test" {
+  fulfillment_order_id = %[1]q
+  reason               = "INCORRECT_ADDRESS"
+  reason_notes         = %[2]q
+}
+`, fulfillmentOrderID, reasonNotes)
+}
+
+func testAccFulfillmentOrderHoldImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["fulfillment_order_id"] + ":" + rs.Primary.ID, nil
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GiftCardConfigurationResource{}
+var _ resource.ResourceWithImportState = &GiftCardConfigurationResource{}
+var _ resource.ResourceWithValidateConfig = &GiftCardConfigurationResource{}
+
+// GiftCardConfigurationResource defines the resource implementation.
+type GiftCardConfigurationResource struct {
+	client *shopify.Client
+}
+
+func NewGiftCardConfigurationResource() resource.Resource {
+	return &GiftCardConfigurationResource{}
+}
+
+// GiftCardConfigurationResourceModel describes the resource data model.
+type GiftCardConfigurationResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Expires      types.Bool   `tfsdk:"expires"`
+	ExpiryMonths types.Int64  `tfsdk:"expiry_months"`
+}
+
+func (r *GiftCardConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gift_card_configuration"
+}
+
+func (r *GiftCardConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the gift card policy of the shop, i.e. whether the gift cards issued by the shop expire by default. " +
+			"The configuration always exists, so creating the resource adopts the current configuration, " +
+			"and destroying it only removes it from the state. Settings that aren't configured are left unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the shop.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.BoolAttribute{
+				MarkdownDescription: "Whether the gift cards expire by default.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"expiry_months": schema.Int64Attribute{
+				MarkdownDescription: "The number of months after which the gift cards expire. Only applies when `expires` is true.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GiftCardConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GiftCardConfigurationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ExpiryMonths.IsNull() || data.ExpiryMonths.IsUnknown() {
+		return
+	}
+	if data.ExpiryMonths.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("expiry_months"), "Invalid expiry_months", "expiry_months must be at least 1")
+	}
+	if !data.Expires.IsNull() && !data.Expires.IsUnknown() && !data.Expires.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("expiry_months"), "Invalid expiry_months", "expiry_months can't be set when expires is false")
+	}
+}
+
+func (r *GiftCardConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *GiftCardConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GiftCardConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopt the current configuration, changing only the configured settings
+	configuration, err := r.client.GetGiftCardConfiguration(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read gift card configuration", err))
+		return
+	}
+	if input := convertGiftCardConfigurationResourceModelToInput(data, configuration); input != nil {
+		configuration, err = r.client.UpdateGiftCardConfiguration(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update gift card configuration", err))
+			return
+		}
+	}
+
+	createdData := convertGiftCardConfigurationToResourceModel(configuration)
+	tflog.Trace(ctx, "adopted the gift card configuration", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *GiftCardConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GiftCardConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.GetGiftCardConfiguration(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read gift card configuration", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertGiftCardConfigurationToResourceModel(configuration))...)
+}
+
+func (r *GiftCardConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GiftCardConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.GetGiftCardConfiguration(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read gift card configuration", err))
+		return
+	}
+	if input := convertGiftCardConfigurationResourceModelToInput(data, configuration); input != nil {
+		configuration, err = r.client.UpdateGiftCardConfiguration(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update gift card configuration", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertGiftCardConfigurationToResourceModel(configuration))...)
+}
+
+func (r *GiftCardConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The gift card configuration can't be deleted, so leave it as it is.
+	tflog.Trace(ctx, "removed the gift card configuration from the state")
+}
+
+func (r *GiftCardConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// convertGiftCardConfigurationResourceModelToInput returns the input to apply the configured settings
+// that differ from the current ones, or nil if there is nothing to change.
+func convertGiftCardConfigurationResourceModelToInput(data GiftCardConfigurationResourceModel, current *shopify.GiftCardConfiguration) *shopify.GiftCardConfigurationInput {
+	expiry := shopify.GiftCardExpiry{}
+	if current.Expiry != nil {
+		expiry = *current.Expiry
+	}
+	changed := false
+	if !data.Expires.IsNull() && !data.Expires.IsUnknown() && data.Expires.ValueBool() != expiry.Enabled {
+		expiry.Enabled = data.Expires.ValueBool()
+		changed = true
+	}
+	if !data.ExpiryMonths.IsNull() && !data.ExpiryMonths.IsUnknown() && (expiry.Months == nil || data.ExpiryMonths.ValueInt64() != *expiry.Months) {
+		expiry.Enabled = true
+		expiry.Months = data.ExpiryMonths.ValueInt64Pointer()
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if !expiry.Enabled {
+		expiry.Months = nil
+	}
+	return &shopify.GiftCardConfigurationInput{Expiry: &expiry}
+}
+
+func convertGiftCardConfigurationToResourceModel(configuration *shopify.GiftCardConfiguration) *GiftCardConfigurationResourceModel {
+	data := &GiftCardConfigurationResourceModel{
+		ID:           types.StringValue(configuration.ShopID),
+		Expires:      types.BoolValue(false),
+		ExpiryMonths: types.Int64Null(),
+	}
+	if configuration.Expiry != nil && configuration.Expiry.Enabled {
+		data.Expires = types.BoolValue(true)
+		data.ExpiryMonths = types.Int64PointerValue(configuration.Expiry.Months)
+	}
+	return data
+}
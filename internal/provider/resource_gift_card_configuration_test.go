@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+func TestAccGiftCardConfigurationResource(t *testing.T) {
+	// The test changes the gift card configuration of the shop, so it only runs when explicitly enabled
+	envOrSkip(t, "SHOPIFY_TEST_GIFT_CARD_CONFIGURATION")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGiftCardConfigurationResourceConfig(`
+  expires       = true
+  expiry_months = 24
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_gift_card_configuration.test", "expires", "true"),
+					resource.TestCheckResourceAttr("shopify_gift_card_configuration.test", "expiry_months", "24"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGiftCardConfigurationResourceConfig(`
+  expires = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_gift_card_configuration.test", "expires", "false"),
+					resource.TestCheckNoResourceAttr("shopify_gift_card_configuration.test", "expiry_months"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGiftCardConfigurationResourceConfig(attributes string) string {
+	return fmt.Sprintf(`
+resource "shopify_gift_card_configuration" "test" {
+%s
+}
+`, attributes)
+}
+
+func TestConvertGiftCardConfigurationResourceModelToInput(t *testing.T) {
+	current := &shopify.GiftCardConfiguration{Expiry: &shopify.GiftCardExpiry{Enabled: true, Months: utils.Ptr(int64(12))}}
+
+	input := convertGiftCardConfigurationResourceModelToInput(GiftCardConfigurationResourceModel{
+		Expires:      types.BoolNull(),
+		ExpiryMonths: types.Int64Value(24),
+	}, current)
+	if input == nil || !input.Expiry.Enabled || *input.Expiry.Months != 24 {
+		t.Errorf("expected the expiry to change to 24 months, got %+v", input)
+	}
+
+	input = convertGiftCardConfigurationResourceModelToInput(GiftCardConfigurationResourceModel{
+		Expires:      types.BoolValue(false),
+		ExpiryMonths: types.Int64Null(),
+	}, current)
+	if input == nil || input.Expiry.Enabled || input.Expiry.Months != nil {
+		t.Errorf("expected the expiry to be disabled, got %+v", input)
+	}
+
+	input = convertGiftCardConfigurationResourceModelToInput(GiftCardConfigurationResourceModel{
+		Expires:      types.BoolValue(true),
+		ExpiryMonths: types.Int64Value(12),
+	}, current)
+	if input != nil {
+		t.Errorf("expected no change, got %+v", input)
+	}
+}
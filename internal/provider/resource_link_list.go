@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LinkListResource{}
+var _ resource.ResourceWithImportState = &LinkListResource{}
+
+// LinkListResource defines the resource implementation.
+// Link lists have no API of their own anymore, they are the menus of the online store navigation.
+type LinkListResource struct {
+	client *shopify.Client
+}
+
+func NewLinkListResource() resource.Resource {
+	return &LinkListResource{}
+}
+
+// LinkListResourceModel describes the resource data model.
+type LinkListResourceModel struct {
+	ID     types.String         `tfsdk:"id"`
+	Title  types.String         `tfsdk:"title"`
+	Handle types.String         `tfsdk:"handle"`
+	Links  []*LinkListLinkModel `tfsdk:"links"`
+}
+
+type LinkListLinkModel struct {
+	Title      types.String `tfsdk:"title"`
+	Type       types.String `tfsdk:"type"`
+	URL        types.String `tfsdk:"url"`
+	ResourceID types.String `tfsdk:"resource_id"`
+}
+
+func (r *LinkListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link_list"
+}
+
+func (r *LinkListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a link list of the online store navigation, as used by themes through `linklists`. " +
+			"Link lists are the menus of the online store, so the resource is backed by the menu API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the menu of the link list.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the link list.",
+				Required:            true,
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "The handle of the link list, used by themes to access it, e.g. `linklists['footer']`.",
+				Required:            true,
+			},
+			"links": schema.ListNestedAttribute{
+				MarkdownDescription: "The links of the link list, in order.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the link.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the link, e.g. `HTTP`, `FRONTPAGE`, `COLLECTION`, `PRODUCT`, `PAGE`, `BLOG` or `SEARCH`.",
+							Required:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the link, required when `type` is `HTTP`.",
+							Optional:            true,
+						},
+						"resource_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the resource the link points to, e.g. `gid://shopify/Collection/1234567890` when `type` is `COLLECTION`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *LinkListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *LinkListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LinkListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	menu, err := r.client.CreateMenu(ctx, convertLinkListResourceModelToInput(data))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create link list", err))
+		return
+	}
+
+	createdData := convertMenuToLinkListResourceModel(menu, data)
+	tflog.Trace(ctx, "created a link list", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *LinkListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LinkListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	menu, err := r.client.GetMenu(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read link list", err))
+		return
+	}
+	if menu == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMenuToLinkListResourceModel(menu, data))...)
+}
+
+func (r *LinkListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LinkListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	menu, err := r.client.UpdateMenu(ctx, data.ID.ValueString(), convertLinkListResourceModelToInput(data))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update link list", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertMenuToLinkListResourceModel(menu, data))...)
+}
+
+func (r *LinkListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LinkListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteMenu(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete link list", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a link list", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *LinkListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertLinkListResourceModelToInput(data LinkListResourceModel) *shopify.MenuInput {
+	items := make([]*shopify.MenuItemInput, 0, len(data.Links))
+	for _, link := range data.Links {
+		items = append(items, &shopify.MenuItemInput{
+			Title:      link.Title.ValueString(),
+			Type:       link.Type.ValueString(),
+			URL:        link.URL.ValueStringPointer(),
+			ResourceID: link.ResourceID.ValueStringPointer(),
+		})
+	}
+	return &shopify.MenuInput{
+		Title:  data.Title.ValueString(),
+		Handle: data.Handle.ValueString(),
+		Items:  items,
+	}
+}
+
+func convertMenuToLinkListResourceModel(menu *shopify.Menu, data LinkListResourceModel) *LinkListResourceModel {
+	links := make([]*LinkListLinkModel, 0, len(menu.Items))
+	for i, item := range menu.Items {
+		// Shopify computes the URL of the links to resources, which is kept null when it isn't configured
+		url := types.StringPointerValue(item.URL)
+		if item.Type != "HTTP" && (i >= len(data.Links) || data.Links[i].URL.IsNull()) {
+			url = types.StringNull()
+		}
+		links = append(links, &LinkListLinkModel{
+			Title:      types.StringValue(item.Title),
+			Type:       types.StringValue(item.Type),
+			URL:        url,
+			ResourceID: types.StringPointerValue(item.ResourceID),
+		})
+	}
+	return &LinkListResourceModel{
+		ID:     types.StringValue(menu.ID),
+		Title:  types.StringValue(menu.Title),
+		Handle: types.StringValue(menu.Handle),
+		Links:  links,
+	}
+}
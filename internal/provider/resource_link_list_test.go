@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+func TestAccLinkListResource(t *testing.T) {
+	handle := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLinkListResourceConfig(handle, "Footer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("shopify_link_list.test", "id"),
+					resource.TestCheckResourceAttr("shopify_link_list.test", "handle", handle),
+					resource.TestCheckResourceAttr("shopify_link_list.test", "links.#", "2"),
+					resource.TestCheckResourceAttr("shopify_link_list.test", "links.1.url", "https://example.com/contact"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_link_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccLinkListResourceConfig(handle, "Footer Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_link_list.test", "title", "Footer Updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinkListResourceConfig(handle, title string) string {
+	return fmt.Sprintf(`
+resource "shopify_link_list" "test" {
+  title  = %[2]q
+  handle = %[1]q
+  links = [
+    {
+      title = "Home"
+      type  = "FRONTPAGE"
+    },
+    {
+      title = "Contact"
+      type  = "HTTP"
+      url   = "https://example.com/contact"
+    },
+  ]
+}
+`, handle, title)
+}
+
+func TestConvertMenuToLinkListResourceModel(t *testing.T) {
+	menu := &shopify.Menu{
+		ID:     "gid://shopify/Menu/1",
+		Title:  "Footer",
+		Handle: "footer",
+		Items: []*shopify.MenuItem{
+			{Title: "Home", Type: "FRONTPAGE", URL: utils.Ptr("/")},
+			{Title: "Contact", Type: "HTTP", URL: utils.Ptr("https://example.com/contact")},
+		},
+	}
+	data := LinkListResourceModel{
+		Links: []*LinkListLinkModel{
+			{Title: types.StringValue("Home"), Type: types.StringValue("FRONTPAGE"), URL: types.StringNull()},
+			{Title: types.StringValue("Contact"), Type: types.StringValue("HTTP"), URL: types.StringValue("https://example.com/contact")},
+		},
+	}
+
+	model := convertMenuToLinkListResourceModel(menu, data)
+	if !model.Links[0].URL.IsNull() {
+		t.Errorf("expected the computed URL of the frontpage link to be null, got %s", model.Links[0].URL)
+	}
+	if model.Links[1].URL.ValueString() != "https://example.com/contact" {
+		t.Errorf("unexpected URL: %s", model.Links[1].URL)
+	}
+}
@@ -0,0 +1,13 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewMetafieldResource() resource.Resource {
+	return &ownerMetafieldResource{owner: metafieldOwner{
+		attribute: "owner_id",
+		description: "Manages a metafield of any owner given by its GID, e.g. a product, a collection or a variant. " +
+			"The value is stored verbatim, so it must be formatted for its type, e.g. a JSON object for `json` or a JSON list for `list.*` types.",
+	}}
+}
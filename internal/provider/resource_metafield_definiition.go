@@ -2,22 +2,32 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
 	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MetafieldDefinitionResource{}
 var _ resource.ResourceWithImportState = &MetafieldDefinitionResource{}
+var _ resource.ResourceWithValidateConfig = &MetafieldDefinitionResource{}
 
 // MetafieldDefinitionResource defines the resource implementation.
 type MetafieldDefinitionResource struct {
@@ -30,22 +40,43 @@ func NewMetafieldDefinitionResource() resource.Resource {
 
 // MetafieldDefinitionResourceModel describes the resource data model.
 type MetafieldDefinitionResourceModel struct {
-	ID          types.String                          `tfsdk:"id"`
-	Name        types.String                          `tfsdk:"name"`
-	Description types.String                          `tfsdk:"description"`
-	OwnerType   types.String                          `tfsdk:"owner_type"`
-	Namespace   types.String                          `tfsdk:"namespace"`
-	Key         types.String                          `tfsdk:"key"`
-	Type        types.String                          `tfsdk:"type"`
-	Pin         types.Bool                            `tfsdk:"pin"`
-	Validations []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+	ID                           types.String                          `tfsdk:"id"`
+	Name                         types.String                          `tfsdk:"name"`
+	Description                  types.String                          `tfsdk:"description"`
+	OwnerType                    types.String                          `tfsdk:"owner_type"`
+	OwnerID                      types.String                          `tfsdk:"owner_id"`
+	Namespace                    types.String                          `tfsdk:"namespace"`
+	Key                          types.String                          `tfsdk:"key"`
+	Type                         types.String                          `tfsdk:"type"`
+	Pin                          types.Bool                            `tfsdk:"pin"`
+	Validations                  []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+	ExternallyManagedValidations types.Bool                            `tfsdk:"externally_managed_validations"`
+	AppOwned                     types.Bool                            `tfsdk:"app_owned"`
+	StandardTemplate             types.Bool                            `tfsdk:"standard_template"`
+	CollectionConditionEnabled   types.Bool                            `tfsdk:"collection_condition_enabled"`
+	ListMin                      types.Int64                           `tfsdk:"list_min"`
+	ListMax                      types.Int64                           `tfsdk:"list_max"`
+	MetaobjectDefinitionID       types.String                          `tfsdk:"metaobject_definition_id"`
+	Access                       types.Object                          `tfsdk:"access"`
+	Capabilities                 types.Object                          `tfsdk:"capabilities"`
 }
 
-type MetafieldDefinitionValidationModel struct {
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
+var metafieldDefinitionCapabilitiesAttrTypes = map[string]attr.Type{
+	"smart_collection_condition": types.BoolType,
+	"admin_filterable":           types.BoolType,
+	"unique_values":              types.BoolType,
 }
 
+// MetafieldDefinitionCapabilitiesModel describes whether the capabilities of the metafield definition are enabled.
+type MetafieldDefinitionCapabilitiesModel struct {
+	SmartCollectionCondition types.Bool `tfsdk:"smart_collection_condition"`
+	AdminFilterable          types.Bool `tfsdk:"admin_filterable"`
+	UniqueValues             types.Bool `tfsdk:"unique_values"`
+}
+
+// MetafieldDefinitionValidationModel is the validation model shared by the metafield and metaobject definitions.
+type MetafieldDefinitionValidationModel = convert.ValidationModel
+
 func (r *MetafieldDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_metafield_definition"
 }
@@ -100,6 +131,13 @@ Possible values are:
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the resource that owns the metafields when it's a singleton. Resolves to the shop ID when `owner_type` is `SHOP`, otherwise null.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"namespace": schema.StringAttribute{
 				MarkdownDescription: `The container for a group of metafields that the metafield is or will be associated with. Used in tandem with ` + "`key`" + ` to lookup a metafield on a resource, preventing conflicts with other metafields with the same ` + "`key.`" + `
 					Must be 3-255 characters long and can contain alphanumeric, hyphen, and underscore characters.`,
@@ -113,9 +151,12 @@ Possible values are:
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: `The type of data that each of the metafields that belong to the metafield definition will store. Refer to the list of [supported types](https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types).`,
-				Required:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: `The type of data that each of the metafields that belong to the metafield definition will store. Refer to the list of [supported types](https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types).
+Deprecated type names, e.g. ` + "`string`" + `, are accepted, and replacing one with its new name doesn't recreate the definition.`,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceIfMetafieldTypeChanged, "Changing the type recreates the metafield definition, unless it's a deprecated type name replaced by its new name.", "Changing the type recreates the metafield definition, unless it's a deprecated type name replaced by its new name."),
+				},
 			},
 			"pin": schema.BoolAttribute{
 				MarkdownDescription: "Whether to pin the metafield definition.",
@@ -132,17 +173,134 @@ Possible values are:
 							Required:            true,
 						},
 						"value": schema.StringAttribute{
-							MarkdownDescription: "The value for the metafield definition validation.",
+							MarkdownDescription: "The value for the metafield definition validation. The metaobject definitions of the `metaobject_definition_id` and `metaobject_definition_ids` validations can be referred to by type instead of GID.",
 							Required:            true,
 						},
 					},
 				},
 				Optional: true,
 			},
+			"externally_managed_validations": externallyManagedValidationsSchemaAttribute(),
+			"app_owned": schema.BoolAttribute{
+				MarkdownDescription: "Whether the namespace is reserved to an app, i.e. `$app` or `app--<app_id>`. " +
+					"A definition reserved to another app than the one of the provider can't be imported, as the app depends on it.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"standard_template": schema.BoolAttribute{
+				MarkdownDescription: "Whether the definition has been enabled from a standard template of Shopify.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_condition_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the metafields can actually be used in the conditions of smart collections. " +
+					"Enabling the smart collection condition capability has no effect on the definitions that aren't eligible for it, " +
+					"which depends on the type, e.g. `json` is never eligible, and on the owner type, so this tells whether it applies.",
+				Computed: true,
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities of the definition. The capabilities which aren't configured are left as they are in Shopify, " +
+					"which enables some of them by default. A capability only takes effect if the definition is eligible for it, which depends on its type and owner type.",
+				Attributes: map[string]schema.Attribute{
+					"smart_collection_condition": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metafields can be used in the conditions of smart collections. See `collection_condition_enabled` for whether it applies.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"admin_filterable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the resources can be filtered by the metafields in the Shopify admin.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"unique_values": schema.BoolAttribute{
+						MarkdownDescription: "Whether the value of each metafield must be unique among the resources of the owner type.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access": schema.SingleNestedAttribute{
+				MarkdownDescription: "The access to the metafields of the definition. The access which isn't configured is set by Shopify.",
+				Attributes: map[string]schema.Attribute{
+					"admin": schema.StringAttribute{
+						MarkdownDescription: "The access of the Admin API, e.g. `MERCHANT_READ` or `MERCHANT_READ_WRITE`.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"storefront": schema.StringAttribute{
+						MarkdownDescription: "The access of the Storefront API, either `PUBLIC_READ` or `NONE`. " +
+							"`LEGACY_LIQUID_ONLY`, which can only be read, is left as it is until another access is configured.",
+						Optional: true,
+						Computed: true,
+					},
+					"customer_account": schema.StringAttribute{
+						MarkdownDescription: "The access of the Customer Account API, one of `READ`, `READ_WRITE` and `NONE`.",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"list_min": schema.Int64Attribute{
+				MarkdownDescription: "The minimum number of values of a list type, e.g. `list.product_reference`. Sets the `list.min` validation, which must not be in `validations` as well.",
+				Optional:            true,
+			},
+			"list_max": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of values of a list type, e.g. `list.product_reference`. Sets the `list.max` validation, which must not be in `validations` as well.",
+				Optional:            true,
+			},
+			"metaobject_definition_id": schema.StringAttribute{
+				MarkdownDescription: "The ID, or the type, of the metaobject definition of the referenced metaobjects, required by the `metaobject_reference` and `list.metaobject_reference` types. " +
+					"Sets the `metaobject_definition_id` validation, which must not be in `validations` as well.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+func (r *MetafieldDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MetafieldDefinitionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metafieldType := data.Type
+	if metafieldType.IsNull() || metafieldType.IsUnknown() {
+		return
+	}
+	if shopify.IsDeprecatedMetafieldType(metafieldType.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("type"),
+			"Deprecated metafield type",
+			fmt.Sprintf("The type %q is deprecated, use %q instead. The definition isn't recreated by the change.", metafieldType.ValueString(), shopify.CanonicalMetafieldType(metafieldType.ValueString())),
+		)
+	}
+	resp.Diagnostics.Append(validateTypedValidations(data)...)
+}
+
 func (r *MetafieldDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -164,17 +322,35 @@ func (r *MetafieldDefinitionResource) Create(ctx context.Context, req resource.C
 		Description: data.Description.ValueString(),
 		Namespace:   data.Namespace.ValueString(),
 		OwnerType:   data.OwnerType.ValueString(),
-		Type:        data.Type.ValueString(),
+		Type:        shopify.CanonicalMetafieldType(data.Type.ValueString()),
 		Pin:         data.Pin.ValueBool(),
-		Validations: convertValidationModelsToValidations(data.Validations),
+		Validations: convert.ValidationModelsToValidations(withTypedValidations(data)),
+	}
+	var diags diag.Diagnostics
+	input.Access, diags = metafieldAccessInput(ctx, data.Access, types.ObjectNull(convert.MetafieldAccessAttrTypes))
+	resp.Diagnostics.Append(diags...)
+	input.Capabilities, diags = metafieldDefinitionCapabilitiesInput(ctx, data.Capabilities, types.ObjectNull(metafieldDefinitionCapabilitiesAttrTypes))
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
 	}
 	createdMetafieldDefinition, err := r.client.CreateMetafieldDefinition(ctx, &input)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metafield definition, got error: %s", err))
+		var takenErr *shopify.MetafieldDefinitionTakenError
+		if errors.As(err, &takenErr) {
+			resp.Diagnostics.AddAttributeError(path.Root("key"), "Metafield definition already exists", metafieldDefinitionTakenErrorDetail(takenErr))
+			return
+		}
+		resp.Diagnostics.Append(diagFromClientError("Unable to create metafield definition", err))
 		return
 	}
 
+	createdMetafieldDefinition.Validations = keepValidationReferences(ctx, r.client, createdMetafieldDefinition.Validations, withTypedValidations(data))
 	createdData := convertMetafieldDefinitionToResourceModel(createdMetafieldDefinition, data)
+	createdData.OwnerID, err = r.resolveOwnerID(ctx, createdData.OwnerType.ValueString(), data.OwnerID)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to resolve owner ID", err))
+		return
+	}
 	tflog.Trace(ctx, "created a metafield definition", map[string]interface{}{
 		"id": createdData.ID,
 	})
@@ -191,11 +367,17 @@ func (r *MetafieldDefinitionResource) Read(ctx context.Context, req resource.Rea
 
 	metafieldDefinition, err := r.client.GetMetafieldDefinition(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metafield definition, got error: %s", err))
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metafield definition", err))
 		return
 	}
 
+	metafieldDefinition.Validations = keepValidationReferences(ctx, r.client, metafieldDefinition.Validations, withTypedValidations(data))
 	metafieldDefinitionModel := convertMetafieldDefinitionToResourceModel(metafieldDefinition, data)
+	metafieldDefinitionModel.OwnerID, err = r.resolveOwnerID(ctx, metafieldDefinitionModel.OwnerType.ValueString(), data.OwnerID)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to resolve owner ID", err))
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, metafieldDefinitionModel)...)
 }
 
@@ -209,18 +391,44 @@ func (r *MetafieldDefinitionResource) Update(ctx context.Context, req resource.U
 	input := shopify.MetafieldDefinitionUpdateInput{
 		Key:         data.Key.ValueString(),
 		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
+		Description: convert.NullIfEmpty(data.Description),
 		Namespace:   data.Namespace.ValueString(),
 		OwnerType:   data.OwnerType.ValueString(),
 		Pin:         data.Pin.ValueBool(),
-		Validations: convertValidationModelsToValidations(data.Validations),
+		Validations: convert.ValidationModelsToValidations(withTypedValidations(data)),
+	}
+	var state MetafieldDefinitionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var diags diag.Diagnostics
+	input.Access, diags = metafieldAccessInput(ctx, data.Access, state.Access)
+	resp.Diagnostics.Append(diags...)
+	input.Capabilities, diags = metafieldDefinitionCapabilitiesInput(ctx, data.Capabilities, state.Capabilities)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	if data.ExternallyManagedValidations.ValueBool() {
+		currentDefinition, err := r.client.GetMetafieldDefinition(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to read metafield definition", err))
+			return
+		}
+		input.Validations = convert.MergeExternalValidations(withTypedValidations(data), withTypedValidations(state), currentDefinition.Validations)
 	}
 	updatedMetafieldDefinition, err := r.client.UpdateMetafieldDefinition(ctx, &input)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metafield definition, got error: %s", err))
+		resp.Diagnostics.Append(metafieldDefinitionUpdateErrorDiagnostics(path.Root("validations"), "Unable to update metafield definition", err)...)
 		return
 	}
+	updatedMetafieldDefinition.Validations = keepValidationReferences(ctx, r.client, updatedMetafieldDefinition.Validations, withTypedValidations(data))
 	updateData := convertMetafieldDefinitionToResourceModel(updatedMetafieldDefinition, data)
+	updateData.OwnerID, err = r.resolveOwnerID(ctx, updateData.OwnerType.ValueString(), data.OwnerID)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to resolve owner ID", err))
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updateData)...)
 }
 
@@ -233,7 +441,7 @@ func (r *MetafieldDefinitionResource) Delete(ctx context.Context, req resource.D
 
 	err := r.client.DeleteMetafieldDefinition(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metafield definition, got error: %s", err))
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete metafield definition", err))
 		return
 	}
 	tflog.Trace(ctx, "deleted a metafield definition", map[string]interface{}{
@@ -242,48 +450,291 @@ func (r *MetafieldDefinitionResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *MetafieldDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	metafieldDefinition, err := r.client.GetMetafieldDefinition(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metafield definition", err))
+		return
+	}
+	if metafieldDefinition != nil {
+		resp.Diagnostics.Append(checkNotReservedToAnotherApp(ctx, r.client, "metafield definition", "namespace", metafieldDefinition.Namespace)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// resolveOwnerID returns the ID of the singleton owner of the metafields.
+// Only SHOP has a single owner, so every other owner type resolves to null.
+func (r *MetafieldDefinitionResource) resolveOwnerID(ctx context.Context, ownerType string, current types.String) (types.String, error) {
+	if ownerType != "SHOP" {
+		return types.StringNull(), nil
+	}
+	// The shop never changes for a configured provider, so reuse the known value
+	if !current.IsNull() && !current.IsUnknown() {
+		return current, nil
+	}
+	shop, err := r.client.GetShop(ctx)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(shop.ID), nil
+}
+
+// metafieldDefinitionTakenErrorDetail explains how to resolve the collision with an existing metafield definition,
+// which is usually another resource in the configuration managing the same definition.
+func metafieldDefinitionTakenErrorDetail(err *shopify.MetafieldDefinitionTakenError) string {
+	detail := fmt.Sprintf("A metafield definition with owner_type %q, namespace %q and key %q already exists", err.OwnerType, err.Namespace, err.Key)
+	if err.ExistingID == "" {
+		return detail + ". Another resource in the configuration may manage the same definition."
+	}
+	return fmt.Sprintf(
+		"%s: %s. Another resource in the configuration may manage the same definition; otherwise import it with `terraform import <address> %s`.",
+		detail, err.ExistingID, err.ExistingID,
+	)
+}
+
+// metafieldDefinitionUpdateErrorDiagnostics reports the user errors about the validations on the validations attribute.
+// Shopify rejects the validations which the existing metafield values don't satisfy, e.g. a max_length below the
+// length of a stored value, and there is no option to force them, so the values have to be fixed first.
+func metafieldDefinitionUpdateErrorDiagnostics(validationsPath path.Path, summary string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var userErrs *shopify.UserErrorsError
+	if !errors.As(err, &userErrs) {
+		diags.Append(diagFromClientError(summary, err))
+		return diags
+	}
+	for _, userError := range userErrs.UserErrors {
+		if slices.Contains(userError.Field, "validations") {
+			diags.AddAttributeError(validationsPath, "Validations rejected by Shopify",
+				fmt.Sprintf("[%s] %s\n\nExisting metafield values may violate the new validations. "+
+					"Update or delete the values that don't satisfy them, or relax the validations, and apply again.", shopify.ErrorCodeValidation, userError.Message))
+			continue
+		}
+		diags.Append(diagFromClientError(summary, shopify.UserErrors{userError}.Error()))
+	}
+	return diags
+}
+
 func convertMetafieldDefinitionToResourceModel(definition *shopify.MetafieldDefinition, state MetafieldDefinitionResourceModel) *MetafieldDefinitionResourceModel {
-	description := types.StringValue(definition.Description)
-	if len(definition.Description) == 0 && state.Description.IsNull() {
-		description = types.StringNull()
+	description := convert.EmptyAsNull(definition.Description, state.Description)
+	validations, typed := splitTypedValidations(definition.Validations, state)
+	if state.ExternallyManagedValidations.ValueBool() {
+		validations = convert.ManagedValidations(validations, state.Validations)
 	}
 	return &MetafieldDefinitionResourceModel{
-		ID:          types.StringValue(definition.ID),
-		Name:        types.StringValue(definition.Name),
-		Description: description,
-		OwnerType:   types.StringValue(definition.OwnerType),
-		Namespace:   types.StringValue(definition.Namespace),
-		Key:         types.StringValue(definition.Key),
-		Type:        types.StringValue(definition.Type.Name),
-		Pin:         types.BoolValue(definition.PinnedPosition != nil),
-		Validations: convertValidationsToModels(definition.Validations),
+		ID:                           types.StringValue(definition.ID),
+		Name:                         types.StringValue(definition.Name),
+		Description:                  description,
+		OwnerType:                    types.StringValue(definition.OwnerType),
+		OwnerID:                      types.StringNull(),
+		Namespace:                    types.StringValue(definition.Namespace),
+		Key:                          types.StringValue(definition.Key),
+		Type:                         convertMetafieldTypeToModel(definition.Type.Name, state.Type),
+		Pin:                          types.BoolValue(definition.PinnedPosition != nil),
+		Validations:                  convert.ValidationsToModels(validations, state.Validations),
+		ExternallyManagedValidations: types.BoolValue(state.ExternallyManagedValidations.ValueBool()),
+		AppOwned:                     types.BoolValue(shopify.IsAppReserved(definition.Namespace)),
+		StandardTemplate:             types.BoolValue(definition.StandardTemplate != nil),
+		CollectionConditionEnabled:   types.BoolValue(collectionConditionEnabled(definition)),
+		ListMin:                      typed.ListMin,
+		ListMax:                      typed.ListMax,
+		MetaobjectDefinitionID:       typed.MetaobjectDefinitionID,
+		Access:                       convert.MetafieldAccessToObject(definition.Access),
+		Capabilities:                 convertMetafieldDefinitionCapabilitiesToObject(definition.Capabilities),
+	}
+}
+
+// convertMetafieldDefinitionCapabilitiesToObject returns whether each capability is enabled, whether or not the definition is eligible for it.
+func convertMetafieldDefinitionCapabilitiesToObject(capabilities *shopify.MetafieldDefinitionCapabilities) types.Object {
+	if capabilities == nil {
+		return types.ObjectNull(metafieldDefinitionCapabilitiesAttrTypes)
+	}
+	enabled := func(capability *shopify.MetafieldDefinitionCapability) types.Bool {
+		return types.BoolValue(capability != nil && capability.Enabled)
 	}
+	return types.ObjectValueMust(metafieldDefinitionCapabilitiesAttrTypes, map[string]attr.Value{
+		"smart_collection_condition": enabled(capabilities.SmartCollectionCondition),
+		"admin_filterable":           enabled(capabilities.AdminFilterable),
+		"unique_values":              enabled(capabilities.UniqueValues),
+	})
 }
 
-func convertValidationModelsToValidations(validationModels []*MetafieldDefinitionValidationModel) []*shopify.MetafieldDefinitionValidation {
-	validations := make([]*shopify.MetafieldDefinitionValidation, 0, len(validationModels))
-	for _, model := range validationModels {
-		validations = append(validations, &shopify.MetafieldDefinitionValidation{
-			Name:  model.Name.ValueString(),
-			Value: model.Value.ValueString(),
-		})
+// metafieldDefinitionCapabilitiesInput returns the capabilities to send to Shopify, which are the planned ones that have changed,
+// so that the capabilities changed outside of Terraform or enabled by default are left as they are.
+func metafieldDefinitionCapabilitiesInput(ctx context.Context, plan, state types.Object) (*shopify.MetafieldDefinitionCapabilitiesInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.IsNull() || plan.IsUnknown() {
+		return nil, diags
+	}
+	var planned, current MetafieldDefinitionCapabilitiesModel
+	diags.Append(plan.As(ctx, &planned, basetypes.ObjectAsOptions{})...)
+	if !state.IsNull() && !state.IsUnknown() {
+		diags.Append(state.As(ctx, &current, basetypes.ObjectAsOptions{})...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	changed := func(planned, current types.Bool) *shopify.MetafieldDefinitionCapabilityInput {
+		if planned.IsNull() || planned.IsUnknown() || planned.Equal(current) {
+			return nil
+		}
+		return &shopify.MetafieldDefinitionCapabilityInput{Enabled: planned.ValueBool()}
+	}
+	input := &shopify.MetafieldDefinitionCapabilitiesInput{
+		SmartCollectionCondition: changed(planned.SmartCollectionCondition, current.SmartCollectionCondition),
+		AdminFilterable:          changed(planned.AdminFilterable, current.AdminFilterable),
+		UniqueValues:             changed(planned.UniqueValues, current.UniqueValues),
+	}
+	if *input == (shopify.MetafieldDefinitionCapabilitiesInput{}) {
+		return nil, diags
 	}
-	return validations
+	return input, diags
 }
 
-func convertValidationsToModels(validations []*shopify.MetafieldDefinitionValidation) []*MetafieldDefinitionValidationModel {
-	if len(validations) == 0 {
-		return nil
+// metafieldAccessInput returns the access to send to Shopify, which is only sent when it's planned and has changed,
+// so that the access changed outside of Terraform is left as it is.
+func metafieldAccessInput(ctx context.Context, plan, state types.Object) (*shopify.MetafieldAccess, diag.Diagnostics) {
+	if plan.IsNull() || plan.IsUnknown() || plan.Equal(state) {
+		return nil, nil
 	}
-	validationModels := make([]*MetafieldDefinitionValidationModel, 0, len(validations))
+	var access convert.MetafieldAccessModel
+	diags := plan.As(ctx, &access, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return access.ToShopifyModel(), diags
+}
+
+// collectionConditionEnabled returns whether the smart collection condition capability applies to the definition,
+// i.e. it's both enabled and eligible, as Shopify may keep an enabled capability the definition isn't eligible for.
+func collectionConditionEnabled(definition *shopify.MetafieldDefinition) bool {
+	if definition.Capabilities == nil || definition.Capabilities.SmartCollectionCondition == nil {
+		return false
+	}
+	capability := definition.Capabilities.SmartCollectionCondition
+	return capability.Enabled && capability.Eligible
+}
+
+// convertMetafieldTypeToModel keeps the current type when it's a deprecated name of the type,
+// as Shopify only returns the new name.
+func convertMetafieldTypeToModel(name string, current types.String) types.String {
+	if !current.IsNull() && !current.IsUnknown() && shopify.CanonicalMetafieldType(current.ValueString()) == name {
+		return current
+	}
+	return types.StringValue(name)
+}
+
+// requiresReplaceIfMetafieldTypeChanged doesn't recreate the definition when a deprecated type name is replaced by its new name.
+func requiresReplaceIfMetafieldTypeChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = shopify.CanonicalMetafieldType(req.StateValue.ValueString()) != shopify.CanonicalMetafieldType(req.PlanValue.ValueString())
+}
+
+func externallyManagedValidationsSchemaAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether validations can also be added outside of Terraform, e.g. by an app sharing the definition. " +
+			"When true, only the validations declared in the configuration are managed: the others are neither shown as a diff nor removed.",
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// typedValidationModels returns the validations set by the typed attributes, e.g. list.min by list_min.
+func typedValidationModels(data MetafieldDefinitionResourceModel) []*MetafieldDefinitionValidationModel {
+	var models []*MetafieldDefinitionValidationModel
+	if !data.ListMin.IsNull() {
+		models = append(models, &MetafieldDefinitionValidationModel{Name: types.StringValue("list.min"), Value: types.StringValue(strconv.FormatInt(data.ListMin.ValueInt64(), 10))})
+	}
+	if !data.ListMax.IsNull() {
+		models = append(models, &MetafieldDefinitionValidationModel{Name: types.StringValue("list.max"), Value: types.StringValue(strconv.FormatInt(data.ListMax.ValueInt64(), 10))})
+	}
+	if !data.MetaobjectDefinitionID.IsNull() {
+		models = append(models, &MetafieldDefinitionValidationModel{Name: types.StringValue("metaobject_definition_id"), Value: data.MetaobjectDefinitionID})
+	}
+	return models
+}
+
+// withTypedValidations returns the validations along with the ones set by the typed attributes.
+func withTypedValidations(data MetafieldDefinitionResourceModel) []*MetafieldDefinitionValidationModel {
+	return append(slices.Clone(data.Validations), typedValidationModels(data)...)
+}
+
+// typedValidations holds the values of the typed attributes setting validations.
+type typedValidations struct {
+	ListMin                types.Int64
+	ListMax                types.Int64
+	MetaobjectDefinitionID types.String
+}
+
+// splitTypedValidations returns the values of the typed attributes set in the current data, and the other validations.
+// The validations of the typed attributes which aren't set, e.g. after an import, stay in the validations.
+func splitTypedValidations(validations []*shopify.MetafieldDefinitionValidation, current MetafieldDefinitionResourceModel) ([]*shopify.MetafieldDefinitionValidation, typedValidations) {
+	typed := typedValidations{
+		ListMin:                types.Int64Null(),
+		ListMax:                types.Int64Null(),
+		MetaobjectDefinitionID: types.StringNull(),
+	}
+	others := make([]*shopify.MetafieldDefinitionValidation, 0, len(validations))
 	for _, validation := range validations {
-		validationModels = append(validationModels, &MetafieldDefinitionValidationModel{
-			Name:  types.StringValue(validation.Name),
-			Value: types.StringValue(validation.Value),
-		})
+		switch {
+		case validation.Name == "list.min" && !current.ListMin.IsNull():
+			if n, err := strconv.ParseInt(validation.Value, 10, 64); err == nil {
+				typed.ListMin = types.Int64Value(n)
+				continue
+			}
+		case validation.Name == "list.max" && !current.ListMax.IsNull():
+			if n, err := strconv.ParseInt(validation.Value, 10, 64); err == nil {
+				typed.ListMax = types.Int64Value(n)
+				continue
+			}
+		case validation.Name == "metaobject_definition_id" && !current.MetaobjectDefinitionID.IsNull():
+			typed.MetaobjectDefinitionID = types.StringValue(validation.Value)
+			continue
+		}
+		others = append(others, validation)
+	}
+	return others, typed
+}
+
+// validateTypedValidations checks that the typed attributes and the validations apply to the type of the definition,
+// e.g. that list_min is only set for a list type, and that the validations of a list of references aren't scalar ones.
+func validateTypedValidations(data MetafieldDefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	metafieldType := data.Type.ValueString()
+	if !shopify.IsListMetafieldType(metafieldType) {
+		for _, attribute := range []struct {
+			name  string
+			value types.Int64
+		}{{"list_min", data.ListMin}, {"list_max", data.ListMax}} {
+			if !attribute.value.IsNull() {
+				diags.AddAttributeError(path.Root(attribute.name), "Invalid list validation",
+					fmt.Sprintf("%s only applies to list types, e.g. list.product_reference, not to %q.", attribute.name, metafieldType))
+			}
+		}
+	}
+	if !data.ListMin.IsNull() && !data.ListMin.IsUnknown() && !data.ListMax.IsNull() && !data.ListMax.IsUnknown() && data.ListMin.ValueInt64() > data.ListMax.ValueInt64() {
+		diags.AddAttributeError(path.Root("list_min"), "Invalid list validation", "list_min must not be greater than list_max.")
+	}
+	if !data.MetaobjectDefinitionID.IsNull() && shopify.MetafieldItemType(metafieldType) != "metaobject_reference" {
+		diags.AddAttributeError(path.Root("metaobject_definition_id"), "Invalid metaobject_definition_id",
+			fmt.Sprintf("metaobject_definition_id only applies to the metaobject_reference and list.metaobject_reference types, not to %q.", metafieldType))
+	}
+
+	typed := typedValidationModels(data)
+	for i, validation := range data.Validations {
+		if validation == nil || validation.Name.IsNull() || validation.Name.IsUnknown() {
+			continue
+		}
+		name := validation.Name.ValueString()
+		if slices.ContainsFunc(typed, func(model *MetafieldDefinitionValidationModel) bool { return model.Name.ValueString() == name }) {
+			diags.AddAttributeError(path.Root("validations").AtListIndex(i).AtName("name"), "Duplicate validation",
+				fmt.Sprintf("The validation %q is already set by a typed attribute, remove it from validations.", name))
+			continue
+		}
+		if err := shopify.CheckMetafieldValidation(metafieldType, name); err != nil {
+			diags.AddAttributeError(path.Root("validations").AtListIndex(i).AtName("name"), "Invalid validation", fmt.Sprintf("The validation doesn't apply to the type: %s.", err))
+		}
 	}
-	return validationModels
+	return diags
 }
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetafieldDefinitionSetResource{}
+var _ resource.ResourceWithImportState = &MetafieldDefinitionSetResource{}
+
+// MetafieldDefinitionSetResource defines the resource implementation.
+type MetafieldDefinitionSetResource struct {
+	client *shopify.Client
+}
+
+func NewMetafieldDefinitionSetResource() resource.Resource {
+	return &MetafieldDefinitionSetResource{}
+}
+
+// MetafieldDefinitionSetResourceModel describes the resource data model.
+type MetafieldDefinitionSetResourceModel struct {
+	ID          types.String                                `tfsdk:"id"`
+	OwnerType   types.String                                `tfsdk:"owner_type"`
+	Namespace   types.String                                `tfsdk:"namespace"`
+	Definitions map[string]*MetafieldDefinitionSetItemModel `tfsdk:"definitions"`
+}
+
+// MetafieldDefinitionSetItemModel describes a metafield definition in the set, keyed by the metafield key.
+type MetafieldDefinitionSetItemModel struct {
+	ID          types.String                          `tfsdk:"id"`
+	Name        types.String                          `tfsdk:"name"`
+	Description types.String                          `tfsdk:"description"`
+	Type        types.String                          `tfsdk:"type"`
+	Pin         types.Bool                            `tfsdk:"pin"`
+	Validations []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+}
+
+func (r *MetafieldDefinitionSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metafield_definition_set"
+}
+
+func (r *MetafieldDefinitionSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages multiple metafield definitions sharing the same owner type and namespace as a unit.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the set, in the format `owner_type:namespace`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_type": schema.StringAttribute{
+				MarkdownDescription: "The resource type that the metafield definitions are attached to. Refer to `shopify_metafield_definition` for the possible values.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The container for the group of metafields that the metafield definitions are associated with.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"definitions": schema.MapNestedAttribute{
+				MarkdownDescription: "The metafield definitions keyed by the metafield key. Changing the type of a definition will recreate it.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique ID of the metafield definition.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The human-readable name for the metafield definition.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description for the metafield definition.",
+							Optional:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: `The type of data that each of the metafields that belong to the metafield definition will store. Refer to the list of [supported types](https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types).`,
+							Required:            true,
+						},
+						"pin": schema.BoolAttribute{
+							MarkdownDescription: "Whether to pin the metafield definition.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"validations": schema.ListNestedAttribute{
+							MarkdownDescription: "Custom validations that apply to values assigned to the field. Refer to the list of [supported validations](https://shopify.dev/docs/apps/build/custom-data/metafields/definitions/list-of-validation-options).",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name for the metafield definition validation.",
+										Required:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value for the metafield definition validation. The metaobject definitions of the `metaobject_definition_id` and `metaobject_definition_ids` validations can be referred to by type instead of GID.",
+										Required:            true,
+									},
+								},
+							},
+							Optional: true,
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *MetafieldDefinitionSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *MetafieldDefinitionSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetafieldDefinitionSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdData := &MetafieldDefinitionSetResourceModel{
+		ID:          types.StringValue(metafieldDefinitionSetID(data.OwnerType.ValueString(), data.Namespace.ValueString())),
+		OwnerType:   data.OwnerType,
+		Namespace:   data.Namespace,
+		Definitions: make(map[string]*MetafieldDefinitionSetItemModel, len(data.Definitions)),
+	}
+	for key, item := range data.Definitions {
+		created, err := r.client.CreateMetafieldDefinition(ctx, convertMetafieldDefinitionSetItemToInput(&data, key, item))
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError(fmt.Sprintf("Unable to create metafield definition %q", key), err))
+			// Save the definitions created so far not to lose track of them
+			resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+			return
+		}
+		created.Validations = keepSetItemValidationReferences(ctx, r.client, created, item)
+		createdData.Definitions[key] = convertMetafieldDefinitionToSetItemModel(created, item)
+	}
+	tflog.Trace(ctx, "created a metafield definition set", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *MetafieldDefinitionSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetafieldDefinitionSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definitions, err := r.client.ListMetafieldDefinitions(ctx, data.OwnerType.ValueString(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metafield definitions", err))
+		return
+	}
+
+	// On import there is no definition in the state yet, so take every definition in the namespace
+	importing := data.Definitions == nil
+	items := make(map[string]*MetafieldDefinitionSetItemModel, len(definitions))
+	for _, definition := range definitions {
+		item, ok := data.Definitions[definition.Key]
+		if !ok && !importing {
+			continue
+		}
+		definition.Validations = keepSetItemValidationReferences(ctx, r.client, definition, item)
+		items[definition.Key] = convertMetafieldDefinitionToSetItemModel(definition, item)
+	}
+	data.ID = types.StringValue(metafieldDefinitionSetID(data.OwnerType.ValueString(), data.Namespace.ValueString()))
+	data.Definitions = items
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetafieldDefinitionSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MetafieldDefinitionSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedData := &MetafieldDefinitionSetResourceModel{
+		ID:          state.ID,
+		OwnerType:   data.OwnerType,
+		Namespace:   data.Namespace,
+		Definitions: make(map[string]*MetafieldDefinitionSetItemModel, len(data.Definitions)),
+	}
+	// Keep every definition that still exists in the state on failure
+	saveState := func() {
+		for key, item := range state.Definitions {
+			if _, ok := updatedData.Definitions[key]; !ok {
+				updatedData.Definitions[key] = item
+			}
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
+	}
+
+	for key, oldItem := range state.Definitions {
+		if newItem, ok := data.Definitions[key]; ok && newItem.Type.Equal(oldItem.Type) {
+			continue
+		}
+		if err := r.client.DeleteMetafieldDefinition(ctx, oldItem.ID.ValueString()); err != nil {
+			resp.Diagnostics.Append(diagFromClientError(fmt.Sprintf("Unable to delete metafield definition %q", key), err))
+			saveState()
+			return
+		}
+		delete(state.Definitions, key)
+	}
+
+	for key, newItem := range data.Definitions {
+		oldItem, ok := state.Definitions[key]
+		if !ok {
+			created, err := r.client.CreateMetafieldDefinition(ctx, convertMetafieldDefinitionSetItemToInput(&data, key, newItem))
+			if err != nil {
+				resp.Diagnostics.Append(diagFromClientError(fmt.Sprintf("Unable to create metafield definition %q", key), err))
+				saveState()
+				return
+			}
+			created.Validations = keepSetItemValidationReferences(ctx, r.client, created, newItem)
+			updatedData.Definitions[key] = convertMetafieldDefinitionToSetItemModel(created, newItem)
+			continue
+		}
+		if reflect.DeepEqual(oldItem, newItem) {
+			updatedData.Definitions[key] = oldItem
+			continue
+		}
+		input := shopify.MetafieldDefinitionUpdateInput{
+			Key:         key,
+			Name:        newItem.Name.ValueString(),
+			Description: convert.NullIfEmpty(newItem.Description),
+			Namespace:   data.Namespace.ValueString(),
+			OwnerType:   data.OwnerType.ValueString(),
+			Pin:         newItem.Pin.ValueBool(),
+			Validations: convert.ValidationModelsToValidations(newItem.Validations),
+		}
+		updated, err := r.client.UpdateMetafieldDefinition(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.Append(metafieldDefinitionUpdateErrorDiagnostics(path.Root("definitions").AtMapKey(key).AtName("validations"), fmt.Sprintf("Unable to update metafield definition %q", key), err)...)
+			saveState()
+			return
+		}
+		updated.Validations = keepSetItemValidationReferences(ctx, r.client, updated, newItem)
+		updatedData.Definitions[key] = convertMetafieldDefinitionToSetItemModel(updated, newItem)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
+}
+
+func (r *MetafieldDefinitionSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetafieldDefinitionSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key, item := range data.Definitions {
+		if err := r.client.DeleteMetafieldDefinition(ctx, item.ID.ValueString()); err != nil {
+			resp.Diagnostics.Append(diagFromClientError(fmt.Sprintf("Unable to delete metafield definition %q", key), err))
+			return
+		}
+	}
+	tflog.Trace(ctx, "deleted a metafield definition set", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *MetafieldDefinitionSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ownerType, namespace, ok := strings.Cut(req.ID, ":")
+	if !ok || ownerType == "" || namespace == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner_type:namespace. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(checkNotReservedToAnotherApp(ctx, r.client, "metafield definition set", "namespace", namespace)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), metafieldDefinitionSetID(ownerType, namespace))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_type"), ownerType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+}
+
+func metafieldDefinitionSetID(ownerType, namespace string) string {
+	return ownerType + ":" + namespace
+}
+
+func convertMetafieldDefinitionSetItemToInput(data *MetafieldDefinitionSetResourceModel, key string, item *MetafieldDefinitionSetItemModel) *shopify.MetafieldDefinitionInput {
+	return &shopify.MetafieldDefinitionInput{
+		Key:         key,
+		Name:        item.Name.ValueString(),
+		Description: item.Description.ValueString(),
+		Namespace:   data.Namespace.ValueString(),
+		OwnerType:   data.OwnerType.ValueString(),
+		Type:        shopify.CanonicalMetafieldType(item.Type.ValueString()),
+		Pin:         item.Pin.ValueBool(),
+		Validations: convert.ValidationModelsToValidations(item.Validations),
+	}
+}
+
+// keepSetItemValidationReferences returns the validations of the definition read with their references written as in the item,
+// which is nil when importing.
+func keepSetItemValidationReferences(ctx context.Context, client *shopify.Client, definition *shopify.MetafieldDefinition, item *MetafieldDefinitionSetItemModel) []*shopify.MetafieldDefinitionValidation {
+	if item == nil {
+		return definition.Validations
+	}
+	return keepValidationReferences(ctx, client, definition.Validations, item.Validations)
+}
+
+func convertMetafieldDefinitionToSetItemModel(definition *shopify.MetafieldDefinition, item *MetafieldDefinitionSetItemModel) *MetafieldDefinitionSetItemModel {
+	// Shopify API handles empty string and null as the same value
+	description := types.StringValue(definition.Description)
+	if len(definition.Description) == 0 && (item == nil || item.Description.IsNull()) {
+		description = types.StringNull()
+	}
+	var validations []*MetafieldDefinitionValidationModel
+	currentType := types.StringNull()
+	if item != nil {
+		validations = item.Validations
+		currentType = item.Type
+	}
+	return &MetafieldDefinitionSetItemModel{
+		ID:          types.StringValue(definition.ID),
+		Name:        types.StringValue(definition.Name),
+		Description: description,
+		Type:        convertMetafieldTypeToModel(definition.Type.Name, currentType),
+		Pin:         types.BoolValue(definition.PinnedPosition != nil),
+		Validations: convert.ValidationsToModels(definition.Validations, validations),
+	}
+}
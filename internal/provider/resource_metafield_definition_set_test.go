@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetafieldDefinitionSetResource(t *testing.T) {
+	namespace := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMetafieldDefinitionSetResourceConfig(namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "id", "PRODUCT:"+namespace),
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.%", "2"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.color.name", "Color"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.color.type", "single_line_text_field"),
+					resource.TestCheckResourceAttrSet("shopify_metafield_definition_set.test", "definitions.color.id"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.size.type", "number_integer"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_metafield_definition_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetafieldDefinitionSetResourceUpdateConfig(namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.%", "2"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.color.name", "Main color"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.color.pin", "true"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition_set.test", "definitions.material.type", "multi_line_text_field"),
+					resource.TestCheckNoResourceAttr("shopify_metafield_definition_set.test", "definitions.size.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetafieldDefinitionSetResourceConfig(namespace string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition_set" "test" {
+  owner_type = "PRODUCT"
+  namespace  = %[1]q
+  definitions = {
+    color = {
+      name = "Color"
+      type = "single_line_text_field"
+    }
+    size = {
+      name = "Size"
+      type = "number_integer"
+    }
+  }
+}
+`, namespace)
+}
+
+func testAccMetafieldDefinitionSetResourceUpdateConfig(namespace string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition_set" "test" {
+  owner_type = "PRODUCT"
+  namespace  = %[1]q
+  definitions = {
+    color = {
+      name = "Main color"
+      type = "single_line_text_field"
+      pin  = true
+    }
+    material = {
+      name        = "Material"
+      description = "What the product is made of"
+      type        = "multi_line_text_field"
+    }
+  }
+}
+`, namespace)
+}
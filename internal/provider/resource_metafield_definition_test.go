@@ -1,10 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
 )
 
 func TestAccMetafieldDefinitionResource(t *testing.T) {
@@ -23,6 +33,10 @@ func TestAccMetafieldDefinitionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "owner_type", "CUSTOMER"),
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "type", "single_line_text_field"),
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "pin", "false"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "collection_condition_enabled", "false"),
+					resource.TestCheckResourceAttrSet("shopify_metafield_definition.test", "access.admin"),
+					resource.TestCheckResourceAttrSet("shopify_metafield_definition.test", "access.storefront"),
+					resource.TestCheckResourceAttrSet("shopify_metafield_definition.test", "capabilities.admin_filterable"),
 				),
 			},
 			// ImportState testing
@@ -40,12 +54,286 @@ func TestAccMetafieldDefinitionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "pin", "true"),
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "validations.0.name", "min"),
 					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "validations.0.value", "10"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "access.storefront", "PUBLIC_READ"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "access.customer_account", "READ"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "capabilities.unique_values", "true"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccMetafieldDefinitionResource_listProductReference(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetafieldDefinitionResourceListConfig(metafieldKey, 1, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "type", "list.product_reference"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "list_min", "1"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "list_max", "5"),
+					resource.TestCheckNoResourceAttr("shopify_metafield_definition.test", "validations"),
+				),
+			},
+			{
+				Config: testAccMetafieldDefinitionResourceListConfig(metafieldKey, 2, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "list_min", "2"),
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "list_max", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetafieldDefinitionResource_listMetaobjectReference(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	metaobjectType := randResourceID(60)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetafieldDefinitionResourceMetaobjectReferenceConfig(metafieldKey, metaobjectType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "type", "list.metaobject_reference"),
+					resource.TestCheckResourceAttrPair("shopify_metafield_definition.test", "metaobject_definition_id", "shopify_metaobject_definition.test", "id"),
+					resource.TestCheckNoResourceAttr("shopify_metafield_definition.test", "validations"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetafieldDefinitionResource_metaobjectDefinitionType(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	metaobjectType := randResourceID(60)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The metaobject definition referred to by type is kept as written
+			{
+				Config: testAccMetafieldDefinitionResourceMetaobjectReferenceConfigWithReference(metafieldKey, metaobjectType, "type"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("shopify_metafield_definition.test", "metaobject_definition_id", "shopify_metaobject_definition.test", "type"),
+				),
+			},
+			// Switching to the GID of the same definition doesn't recreate the metafield definition
+			{
+				Config: testAccMetafieldDefinitionResourceMetaobjectReferenceConfigWithReference(metafieldKey, metaobjectType, "id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("shopify_metafield_definition.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("shopify_metafield_definition.test", "metaobject_definition_id", "shopify_metaobject_definition.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetafieldDefinitionResource_shop(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetafieldDefinitionResourceShopConfig(metafieldKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "owner_type", "SHOP"),
+					resource.TestCheckResourceAttrSet("shopify_metafield_definition.test", "owner_id"),
+				),
+			},
+			{
+				ResourceName:      "shopify_metafield_definition.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMetafieldDefinitionResource_deprecatedType(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The deprecated name is kept in the state, without drift on refresh
+			{
+				Config: testAccMetafieldDefinitionResourceTypeConfig(metafieldKey, "string"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "type", "string"),
+				),
+			},
+			// Migrating to the new name doesn't recreate the definition
+			{
+				Config: testAccMetafieldDefinitionResourceTypeConfig(metafieldKey, "single_line_text_field"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("shopify_metafield_definition.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "type", "single_line_text_field"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetafieldDefinitionResource_withoutDescription(t *testing.T) {
+	metafieldKey := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetafieldDefinitionResourceConfig(metafieldKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("shopify_metafield_definition.test", "description"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccMetafieldDefinitionResourceUpdateConfig(metafieldKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafield_definition.test", "description", "Updated description"),
+				),
+			},
+			// Removing the description clears it
+			{
+				Config: testAccMetafieldDefinitionResourceConfig(metafieldKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("shopify_metafield_definition.test", "description"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestConvertMetafieldTypeToModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		current types.String
+		want    types.String
+	}{
+		{name: "null", current: types.StringNull(), want: types.StringValue("single_line_text_field")},
+		{name: "deprecated name", current: types.StringValue("string"), want: types.StringValue("string")},
+		{name: "new name", current: types.StringValue("single_line_text_field"), want: types.StringValue("single_line_text_field")},
+		{name: "other type", current: types.StringValue("integer"), want: types.StringValue("single_line_text_field")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertMetafieldTypeToModel("single_line_text_field", tt.current); !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCollectionConditionEnabled(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities *shopify.MetafieldDefinitionCapabilities
+		want         bool
+	}{
+		{
+			name: "eligible type",
+			capabilities: &shopify.MetafieldDefinitionCapabilities{
+				SmartCollectionCondition: &shopify.MetafieldDefinitionCapability{Enabled: true, Eligible: true},
+			},
+			want: true,
+		},
+		{
+			name: "ineligible type",
+			capabilities: &shopify.MetafieldDefinitionCapabilities{
+				SmartCollectionCondition: &shopify.MetafieldDefinitionCapability{Enabled: true, Eligible: false},
+			},
+			want: false,
+		},
+		{
+			name: "eligible type without the capability",
+			capabilities: &shopify.MetafieldDefinitionCapabilities{
+				SmartCollectionCondition: &shopify.MetafieldDefinitionCapability{Enabled: false, Eligible: true},
+			},
+			want: false,
+		},
+		{name: "no capabilities", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition := &shopify.MetafieldDefinition{Capabilities: tt.capabilities}
+			if got := collectionConditionEnabled(definition); got != tt.want {
+				t.Errorf("collectionConditionEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetafieldDefinitionResource_resolveOwnerID(t *testing.T) {
+	var requests int
+	r := &MetafieldDefinitionResource{
+		client: newTestShopifyClient(t, func(w http.ResponseWriter, req *http.Request) {
+			requests++
+			_, _ = w.Write([]byte(`{"data":{"shop":{"id":"gid://shopify/Shop/1","name":"Test","myshopifyDomain":"test.myshopify.com"}}}`))
+		}),
+	}
+	ctx := context.Background()
+
+	ownerID, err := r.resolveOwnerID(ctx, "SHOP", types.StringUnknown())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ownerID.ValueString() != "gid://shopify/Shop/1" {
+		t.Errorf("unexpected owner id: %s", ownerID)
+	}
+
+	// The resolved value is kept on subsequent reads without querying the shop again
+	ownerID, err = r.resolveOwnerID(ctx, "SHOP", ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ownerID.ValueString() != "gid://shopify/Shop/1" {
+		t.Errorf("unexpected owner id: %s", ownerID)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	ownerID, err = r.resolveOwnerID(ctx, "CUSTOMER", types.StringUnknown())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ownerID.IsNull() {
+		t.Errorf("expected null owner id, got %s", ownerID)
+	}
+}
+
 func testAccMetafieldDefinitionResourceConfig(metafieldKey string) string {
 	return fmt.Sprintf(`
 resource "shopify_metafield_definition" "test" {
@@ -74,6 +362,306 @@ resource "shopify_metafield_definition" "test" {
 	  value = "10"	
     }
   ] 
+  access = {
+    storefront       = "PUBLIC_READ"
+    customer_account = "READ"
+  }
+  capabilities = {
+    unique_values = true
+  }
+}
+`, metafieldKey)
+}
+
+func testAccMetafieldDefinitionResourceShopConfig(metafieldKey string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition" "test" {
+  key        = %[1]q
+  name       = "Terraform Test"
+  namespace  = "testacc"
+  owner_type = "SHOP"
+  type       = "single_line_text_field"
 }
 `, metafieldKey)
 }
+
+func testAccMetafieldDefinitionResourceTypeConfig(metafieldKey, metafieldType string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition" "test" {
+  key        = %[1]q
+  name       = "Terraform Test"
+  namespace  = "testacc"
+  owner_type = "CUSTOMER"
+  type       = %[2]q
+}
+`, metafieldKey, metafieldType)
+}
+
+func testAccMetafieldDefinitionResourceListConfig(metafieldKey string, listMin, listMax int) string {
+	return fmt.Sprintf(`
+resource "shopify_metafield_definition" "test" {
+  key        = %[1]q
+  name       = "Terraform Test"
+  namespace  = "testacc"
+  owner_type = "PRODUCT"
+  type       = "list.product_reference"
+  list_min   = %[2]d
+  list_max   = %[3]d
+}
+`, metafieldKey, listMin, listMax)
+}
+
+func testAccMetafieldDefinitionResourceMetaobjectReferenceConfig(metafieldKey, metaobjectType string) string {
+	return testAccMetafieldDefinitionResourceMetaobjectReferenceConfigWithReference(metafieldKey, metaobjectType, "id")
+}
+
+// testAccMetafieldDefinitionResourceMetaobjectReferenceConfigWithReference refers to the metaobject definition
+// by the attribute, either its id or its type.
+func testAccMetafieldDefinitionResourceMetaobjectReferenceConfigWithReference(metafieldKey, metaobjectType, attribute string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "test" {
+  name = "Terraform Test"
+  type = %[2]q
+  field_definitions = [
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    }
+  ]
+}
+
+resource "shopify_metafield_definition" "test" {
+  key                      = %[1]q
+  name                     = "Terraform Test"
+  namespace                = "testacc"
+  owner_type               = "PRODUCT"
+  type                     = "list.metaobject_reference"
+  metaobject_definition_id = shopify_metaobject_definition.test.%[3]s
+}
+`, metafieldKey, metaobjectType, attribute)
+}
+
+func TestMetafieldDefinitionUpdateErrorDiagnostics(t *testing.T) {
+	client := newTestShopifyClient(t, func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"metafieldDefinitionUpdate":{"updatedDefinition":null,"userErrors":[` +
+			`{"field":["definition","validations","0","value"],"message":"Validations are not met by existing metafields","code":"INVALID_INPUT"},` +
+			`{"field":["definition","name"],"message":"Name is too long","code":"TOO_LONG"}]}}}`))
+	})
+	_, err := client.UpdateMetafieldDefinition(context.Background(), &shopify.MetafieldDefinitionUpdateInput{
+		Key:       "test",
+		Namespace: "test",
+		OwnerType: "PRODUCT",
+		Validations: []*shopify.MetafieldDefinitionValidation{
+			{Name: "max", Value: "1"},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected the update to be rejected")
+	}
+
+	validationsPath := path.Root("validations")
+	diags := metafieldDefinitionUpdateErrorDiagnostics(validationsPath, "Unable to update metafield definition", err)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+	attrDiag, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !attrDiag.Path().Equal(validationsPath) {
+		t.Errorf("expected a diagnostic on the validations, got %v", diags[0])
+	}
+	if diags[1].Summary() != "Client Error" {
+		t.Errorf("expected a client error for the name, got %v", diags[1])
+	}
+}
+
+func TestSplitTypedValidations(t *testing.T) {
+	validations := []*shopify.MetafieldDefinitionValidation{
+		{Name: "list.min", Value: "1"},
+		{Name: "list.max", Value: "5"},
+		{Name: "metaobject_definition_id", Value: "gid://shopify/MetaobjectDefinition/1"},
+	}
+	current := MetafieldDefinitionResourceModel{
+		ListMax:                types.Int64Value(3),
+		MetaobjectDefinitionID: types.StringValue("gid://shopify/MetaobjectDefinition/1"),
+	}
+
+	others, typed := splitTypedValidations(validations, current)
+	// list_min isn't set, e.g. after an import, so list.min stays in the validations
+	if want := []*shopify.MetafieldDefinitionValidation{{Name: "list.min", Value: "1"}}; !reflect.DeepEqual(others, want) {
+		t.Errorf("unexpected validations: %v", others)
+	}
+	want := typedValidations{
+		ListMin:                types.Int64Null(),
+		ListMax:                types.Int64Value(5),
+		MetaobjectDefinitionID: types.StringValue("gid://shopify/MetaobjectDefinition/1"),
+	}
+	if typed != want {
+		t.Errorf("got %v, want %v", typed, want)
+	}
+}
+
+func TestWithTypedValidations(t *testing.T) {
+	data := MetafieldDefinitionResourceModel{
+		Type:                   types.StringValue("list.metaobject_reference"),
+		Validations:            []*MetafieldDefinitionValidationModel{{Name: types.StringValue("list.max"), Value: types.StringValue("3")}},
+		ListMin:                types.Int64Value(1),
+		MetaobjectDefinitionID: types.StringValue("gid://shopify/MetaobjectDefinition/1"),
+	}
+
+	got := convert.ValidationModelsToValidations(withTypedValidations(data))
+	want := []*shopify.MetafieldDefinitionValidation{
+		{Name: "list.max", Value: "3"},
+		{Name: "list.min", Value: "1"},
+		{Name: "metaobject_definition_id", Value: "gid://shopify/MetaobjectDefinition/1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected validations: %v", got)
+	}
+	if len(data.Validations) != 1 {
+		t.Errorf("the validations of the data must not be modified: %v", data.Validations)
+	}
+}
+
+func TestValidateTypedValidations(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  MetafieldDefinitionResourceModel
+		paths []path.Path
+	}{
+		{
+			name: "list of product references",
+			data: MetafieldDefinitionResourceModel{
+				Type:    types.StringValue("list.product_reference"),
+				ListMin: types.Int64Value(1),
+				ListMax: types.Int64Value(5),
+			},
+		},
+		{
+			name: "list validations on a scalar type",
+			data: MetafieldDefinitionResourceModel{
+				Type:        types.StringValue("product_reference"),
+				ListMin:     types.Int64Value(1),
+				Validations: []*MetafieldDefinitionValidationModel{{Name: types.StringValue("list.max"), Value: types.StringValue("5")}},
+			},
+			paths: []path.Path{path.Root("list_min"), path.Root("validations").AtListIndex(0).AtName("name")},
+		},
+		{
+			name: "scalar validation on a list of references",
+			data: MetafieldDefinitionResourceModel{
+				Type:        types.StringValue("list.product_reference"),
+				Validations: []*MetafieldDefinitionValidationModel{{Name: types.StringValue("max"), Value: types.StringValue("5")}},
+			},
+			paths: []path.Path{path.Root("validations").AtListIndex(0).AtName("name")},
+		},
+		{
+			name: "list of metaobject references",
+			data: MetafieldDefinitionResourceModel{
+				Type:                   types.StringValue("list.metaobject_reference"),
+				MetaobjectDefinitionID: types.StringValue("gid://shopify/MetaobjectDefinition/1"),
+				Validations:            []*MetafieldDefinitionValidationModel{{Name: types.StringValue("list.max"), Value: types.StringValue("5")}},
+			},
+		},
+		{
+			name: "metaobject definition on another type",
+			data: MetafieldDefinitionResourceModel{
+				Type:                   types.StringValue("list.product_reference"),
+				MetaobjectDefinitionID: types.StringValue("gid://shopify/MetaobjectDefinition/1"),
+			},
+			paths: []path.Path{path.Root("metaobject_definition_id")},
+		},
+		{
+			name: "validation set twice",
+			data: MetafieldDefinitionResourceModel{
+				Type:        types.StringValue("list.single_line_text_field"),
+				ListMin:     types.Int64Value(1),
+				Validations: []*MetafieldDefinitionValidationModel{{Name: types.StringValue("list.min"), Value: types.StringValue("2")}},
+			},
+			paths: []path.Path{path.Root("validations").AtListIndex(0).AtName("name")},
+		},
+		{
+			name: "list_min greater than list_max",
+			data: MetafieldDefinitionResourceModel{
+				Type:    types.StringValue("list.single_line_text_field"),
+				ListMin: types.Int64Value(5),
+				ListMax: types.Int64Value(1),
+			},
+			paths: []path.Path{path.Root("list_min")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateTypedValidations(tt.data)
+			var paths []path.Path
+			for _, d := range diags {
+				if attrDiag, ok := d.(diag.DiagnosticWithPath); ok {
+					paths = append(paths, attrDiag.Path())
+				}
+			}
+			if len(paths) != len(diags) || !reflect.DeepEqual(paths, tt.paths) {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestMetafieldAccessInput(t *testing.T) {
+	ctx := context.Background()
+	state := convert.MetafieldAccessToObject(&shopify.MetafieldAccess{Admin: "MERCHANT_READ_WRITE", Storefront: "LEGACY_LIQUID_ONLY", CustomerAccount: "NONE"})
+
+	// The access isn't sent unless it has changed
+	for _, plan := range []types.Object{state, types.ObjectNull(convert.MetafieldAccessAttrTypes), types.ObjectUnknown(convert.MetafieldAccessAttrTypes)} {
+		access, diags := metafieldAccessInput(ctx, plan, state)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		if access != nil {
+			t.Errorf("expected no access for %s, got %+v", plan, access)
+		}
+	}
+
+	plan := convert.MetafieldAccessToObject(&shopify.MetafieldAccess{Admin: "MERCHANT_READ_WRITE", Storefront: "LEGACY_LIQUID_ONLY", CustomerAccount: "READ"})
+	access, diags := metafieldAccessInput(ctx, plan, state)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := &shopify.MetafieldAccess{Admin: "MERCHANT_READ_WRITE", CustomerAccount: "READ"}
+	if !reflect.DeepEqual(access, want) {
+		t.Errorf("expected %+v, got %+v", want, access)
+	}
+}
+
+func TestMetafieldDefinitionCapabilitiesInput(t *testing.T) {
+	ctx := context.Background()
+	state := convertMetafieldDefinitionCapabilitiesToObject(&shopify.MetafieldDefinitionCapabilities{
+		SmartCollectionCondition: &shopify.MetafieldDefinitionCapability{Enabled: false, Eligible: true},
+		AdminFilterable:          &shopify.MetafieldDefinitionCapability{Enabled: true, Eligible: true},
+	})
+	if got := state.Attributes()["unique_values"]; !got.Equal(types.BoolValue(false)) {
+		t.Errorf("expected a capability which isn't returned to be disabled, got %s", got)
+	}
+
+	// Nothing is sent unless a capability has changed
+	for _, plan := range []types.Object{state, types.ObjectNull(metafieldDefinitionCapabilitiesAttrTypes), types.ObjectUnknown(metafieldDefinitionCapabilitiesAttrTypes)} {
+		input, diags := metafieldDefinitionCapabilitiesInput(ctx, plan, state)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		if input != nil {
+			t.Errorf("expected no capabilities for %s, got %+v", plan, input)
+		}
+	}
+
+	plan := types.ObjectValueMust(metafieldDefinitionCapabilitiesAttrTypes, map[string]attr.Value{
+		"smart_collection_condition": types.BoolValue(true),
+		"admin_filterable":           types.BoolValue(true),
+		"unique_values":              types.BoolUnknown(),
+	})
+	input, diags := metafieldDefinitionCapabilitiesInput(ctx, plan, state)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := &shopify.MetafieldDefinitionCapabilitiesInput{SmartCollectionCondition: &shopify.MetafieldDefinitionCapabilityInput{Enabled: true}}
+	if !reflect.DeepEqual(input, want) {
+		t.Errorf("expected %+v, got %+v", want, input)
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetafieldsImportResource{}
+var _ resource.ResourceWithModifyPlan = &MetafieldsImportResource{}
+
+// MetafieldsImportResource defines the resource implementation.
+type MetafieldsImportResource struct {
+	client *shopify.Client
+}
+
+func NewMetafieldsImportResource() resource.Resource {
+	return &MetafieldsImportResource{}
+}
+
+// MetafieldsImportResourceModel describes the resource data model.
+type MetafieldsImportResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	SourceFile types.String `tfsdk:"source_file"`
+	// Rows are the hashes of the applied rows keyed by metafieldsImportRowKey.
+	Rows types.Map `tfsdk:"rows"`
+}
+
+// metafieldsImportRow is a row of the source file, which sets a metafield.
+type metafieldsImportRow struct {
+	OwnerID   string `json:"owner_id"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+}
+
+// metafieldsImportColumns are the columns of the source file, which are the fields of a row.
+var metafieldsImportColumns = []string{"owner_id", "namespace", "key", "type", "value"}
+
+func (r *MetafieldsImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metafields_import"
+}
+
+func (r *MetafieldsImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets many metafields of any owners from a CSV or JSON file, e.g. for a data migration. " +
+			"Each row of the file sets the metafield of an owner by its `owner_id`, `namespace`, `key`, `type` and `value`. " +
+			"A CSV file has a header row naming these columns, and a JSON file is an array of objects with these fields.\n\n" +
+			"The applied rows are tracked by the hash of their type and value, so that the plan shows the rows which have changed in the file, " +
+			"and the apply only sets those and deletes the metafields of the removed rows. Many rows are set by a bulk operation, a few by a mutation per row. " +
+			"The rows fail independently of each other, and the rows which have been applied are kept in the state, so that the next apply only retries the failed ones.\n\n" +
+			"The metafields aren't read back, so their changes made elsewhere aren't detected. Destroying the resource deletes the metafields of every row.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the import, which is the path of the source file when it has been created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_file": schema.StringAttribute{
+				MarkdownDescription: "The path of the file with the rows, e.g. `\"${path.module}/metafields.csv\"`. " +
+					"Its format is given by its extension, `.csv` or `.json`.",
+				Required: true,
+			},
+			"rows": schema.MapAttribute{
+				MarkdownDescription: "The hashes of the type and the value of the applied rows, keyed by `<owner_id>/<namespace>/<key>`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *MetafieldsImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *MetafieldsImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is read on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan MetafieldsImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.SourceFile.IsUnknown() {
+		return
+	}
+
+	// Plan the hashes of the rows in the file, so that the rows which have changed are shown and applied
+	rows, err := readMetafieldsImportSourceFile(plan.SourceFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_file"), "Invalid Source File", err.Error())
+		return
+	}
+	hashes, diags := types.MapValueFrom(ctx, types.StringType, hashMetafieldsImportRows(rows))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rows"), hashes)...)
+}
+
+func (r *MetafieldsImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetafieldsImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdData := &MetafieldsImportResourceModel{
+		ID:         data.SourceFile,
+		SourceFile: data.SourceFile,
+	}
+	applied := r.applyRows(ctx, &data, map[string]string{}, &resp.Diagnostics)
+	tflog.Trace(ctx, "created a metafields import", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	// Save the rows applied even on failure not to lose track of them
+	var diags diag.Diagnostics
+	createdData.Rows, diags = types.MapValueFrom(ctx, types.StringType, applied)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *MetafieldsImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The metafields aren't read back, as reading every row would be as slow as applying them, so keep the state as it is
+	var data MetafieldsImportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetafieldsImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MetafieldsImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current := map[string]string{}
+	resp.Diagnostics.Append(state.Rows.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedData := &MetafieldsImportResourceModel{
+		ID:         state.ID,
+		SourceFile: data.SourceFile,
+	}
+	applied := r.applyRows(ctx, &data, current, &resp.Diagnostics)
+
+	var diags diag.Diagnostics
+	updatedData.Rows, diags = types.MapValueFrom(ctx, types.StringType, applied)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
+}
+
+func (r *MetafieldsImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetafieldsImportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current := map[string]string{}
+	resp.Diagnostics.Append(data.Rows.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if failed := r.deleteRows(ctx, slices.Sorted(maps.Keys(current))); len(failed) > 0 {
+		resp.Diagnostics.Append(diagFromClientError(fmt.Sprintf("Unable to delete %d metafields", len(failed)), errors.Join(sortedValues(failed)...)))
+		return
+	}
+	tflog.Trace(ctx, "deleted a metafields import", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+// applyRows deletes the metafields of the rows removed from the file, then sets the rows of the file which differ from the current ones,
+// and returns the hashes of the rows applied. The rows which fail are kept as they are currently, and reported in a single error.
+func (r *MetafieldsImportResource) applyRows(ctx context.Context, data *MetafieldsImportResourceModel, current map[string]string, diags *diag.Diagnostics) map[string]string {
+	applied := maps.Clone(current)
+	rows, err := readMetafieldsImportSourceFile(data.SourceFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_file"), "Invalid Source File", err.Error())
+		return applied
+	}
+	hashes := hashMetafieldsImportRows(rows)
+	// The rows are unknown when the path of the file was unknown, otherwise they're the rows of the file when planned
+	if !data.Rows.IsUnknown() {
+		planned := map[string]string{}
+		diags.Append(data.Rows.ElementsAs(ctx, &planned, false)...)
+		if !maps.Equal(hashes, planned) {
+			diags.AddAttributeError(path.Root("source_file"), "Source File Changed",
+				"The source file has changed since the plan. Plan again to apply its current rows.")
+			return applied
+		}
+	}
+
+	var removed []string
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := hashes[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	failed := r.deleteRows(ctx, removed)
+	for _, key := range removed {
+		if _, ok := failed[key]; !ok {
+			delete(applied, key)
+		}
+	}
+	if len(failed) > 0 {
+		diags.Append(diagFromClientError(fmt.Sprintf("Unable to delete %d metafields", len(failed)), errors.Join(sortedValues(failed)...)))
+	}
+
+	keys, inputs := diffMetafieldsImportRows(rows, current)
+	var setFailed []error
+	for i, err := range r.client.BulkSetMetafields(ctx, inputs) {
+		if err != nil {
+			setFailed = append(setFailed, fmt.Errorf("%s: %w", keys[i], err))
+			continue
+		}
+		applied[keys[i]] = hashes[keys[i]]
+	}
+	if len(setFailed) > 0 {
+		diags.Append(diagFromClientError(fmt.Sprintf("Unable to set %d of %d metafields", len(setFailed), len(keys)), errors.Join(setFailed...)))
+	}
+	return applied
+}
+
+// deleteRows deletes the metafields of the rows by key, and returns the errors by key of the rows which haven't been deleted.
+func (r *MetafieldsImportResource) deleteRows(ctx context.Context, keys []string) map[string]error {
+	inputs := make([]*shopify.MetafieldIdentifierInput, 0, len(keys))
+	for _, key := range keys {
+		inputs = append(inputs, parseMetafieldsImportRowKey(key))
+	}
+	failed := map[string]error{}
+	for i, err := range r.client.BulkDeleteMetafields(ctx, inputs) {
+		if err != nil {
+			failed[keys[i]] = fmt.Errorf("%s: %w", keys[i], err)
+		}
+	}
+	return failed
+}
+
+// sortedValues returns the values of the map in the order of their keys.
+func sortedValues[V any](m map[string]V) []V {
+	values := make([]V, 0, len(m))
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		values = append(values, m[key])
+	}
+	return values
+}
+
+// readMetafieldsImportSourceFile reads the rows of the CSV or JSON file, depending on its extension.
+func readMetafieldsImportSourceFile(name string) ([]*metafieldsImportRow, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rows []*metafieldsImportRow
+	switch ext := strings.ToLower(filepath.Ext(name)); ext {
+	case ".csv":
+		rows, err = parseMetafieldsImportCSV(f)
+	case ".json":
+		rows, err = parseMetafieldsImportJSON(f)
+	default:
+		return nil, fmt.Errorf("unsupported extension %q of %s, expected .csv or .json", ext, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", name, err)
+	}
+	return rows, nil
+}
+
+// parseMetafieldsImportCSV parses the rows of a CSV file whose header row names the columns, in any order.
+func parseMetafieldsImportCSV(reader io.Reader) ([]*metafieldsImportRow, error) {
+	csvReader := csv.NewReader(reader)
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, errors.New("missing header row")
+	}
+	if err != nil {
+		return nil, err
+	}
+	indexes := map[string]int{}
+	for i, column := range header {
+		indexes[strings.TrimSpace(column)] = i
+	}
+	for _, column := range metafieldsImportColumns {
+		if _, ok := indexes[column]; !ok {
+			return nil, fmt.Errorf("missing column %q in the header row", column)
+		}
+	}
+
+	var rows []*metafieldsImportRow
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, &metafieldsImportRow{
+			OwnerID:   record[indexes["owner_id"]],
+			Namespace: record[indexes["namespace"]],
+			Key:       record[indexes["key"]],
+			Type:      record[indexes["type"]],
+			Value:     record[indexes["value"]],
+		})
+	}
+	return rows, validateMetafieldsImportRows(rows)
+}
+
+// parseMetafieldsImportJSON parses the rows of a JSON file, which is an array of objects.
+func parseMetafieldsImportJSON(reader io.Reader) ([]*metafieldsImportRow, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	var rows []*metafieldsImportRow
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, err
+	}
+	return rows, validateMetafieldsImportRows(rows)
+}
+
+// validateMetafieldsImportRows checks that every row identifies a metafield with its type, and that no metafield is set twice.
+func validateMetafieldsImportRows(rows []*metafieldsImportRow) error {
+	keys := make(map[string]int, len(rows))
+	for i, row := range rows {
+		if row == nil || row.OwnerID == "" || row.Namespace == "" || row.Key == "" || row.Type == "" {
+			return fmt.Errorf("row %d: owner_id, namespace, key and type are required", i+1)
+		}
+		key := metafieldsImportRowKey(row)
+		if j, ok := keys[key]; ok {
+			return fmt.Errorf("row %d: duplicate of row %d for %s", i+1, j+1, key)
+		}
+		keys[key] = i
+	}
+	return nil
+}
+
+// metafieldsImportRowKey returns the key of the row in the state, which identifies its metafield.
+// The namespace and the key can't contain a slash, so the key can be split back from the end.
+func metafieldsImportRowKey(row *metafieldsImportRow) string {
+	return row.OwnerID + "/" + row.Namespace + "/" + row.Key
+}
+
+// parseMetafieldsImportRowKey returns the identifier of the metafield of the key of a row.
+func parseMetafieldsImportRowKey(rowKey string) *shopify.MetafieldIdentifierInput {
+	rest, key, _ := cutLast(rowKey, "/")
+	ownerID, namespace, _ := cutLast(rest, "/")
+	return &shopify.MetafieldIdentifierInput{OwnerID: ownerID, Namespace: namespace, Key: key}
+}
+
+// cutLast slices s around the last instance of sep, like strings.Cut does around the first.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// hashMetafieldsImportRows returns the hashes of the type and the value of the rows by key.
+func hashMetafieldsImportRows(rows []*metafieldsImportRow) map[string]string {
+	hashes := make(map[string]string, len(rows))
+	for _, row := range rows {
+		sum := sha256.Sum256([]byte(row.Type + "\x00" + row.Value))
+		hashes[metafieldsImportRowKey(row)] = hex.EncodeToString(sum[:])
+	}
+	return hashes
+}
+
+// diffMetafieldsImportRows returns the keys of the rows to set, in the order of the file, with their inputs.
+// The rows whose hash is the same as the current one aren't set again.
+func diffMetafieldsImportRows(rows []*metafieldsImportRow, current map[string]string) ([]string, []*shopify.MetafieldsSetInput) {
+	hashes := hashMetafieldsImportRows(rows)
+	var keys []string
+	var inputs []*shopify.MetafieldsSetInput
+	for _, row := range rows {
+		key := metafieldsImportRowKey(row)
+		if hash, ok := current[key]; ok && hash == hashes[key] {
+			continue
+		}
+		keys = append(keys, key)
+		inputs = append(inputs, &shopify.MetafieldsSetInput{
+			OwnerID:   row.OwnerID,
+			Namespace: row.Namespace,
+			Key:       row.Key,
+			Type:      row.Type,
+			Value:     row.Value,
+		})
+	}
+	return keys, inputs
+}
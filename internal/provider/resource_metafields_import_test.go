@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccMetafieldsImportResource(t *testing.T) {
+	blogID := envOrSkip(t, "SHOPIFY_TEST_BLOG_ID")
+	sourceFile := filepath.Join(t.TempDir(), "metafields.csv")
+	writeSourceFile := func(rows string) func() {
+		return func() {
+			content := "owner_id,namespace,key,type,value\n" + strings.ReplaceAll(rows, "{{blog_id}}", blogID)
+			if err := os.WriteFile(sourceFile, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: writeSourceFile("{{blog_id}},terraform_test,subtitle,single_line_text_field,Hello\n{{blog_id}},terraform_test,columns,number_integer,2\n"),
+				Config:    testAccMetafieldsImportResourceConfig(sourceFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafields_import.test", "id", sourceFile),
+					resource.TestCheckResourceAttr("shopify_metafields_import.test", "rows.%", "2"),
+					resource.TestCheckResourceAttrSet("shopify_metafields_import.test", fmt.Sprintf("rows.%s/terraform_test/subtitle", blogID)),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: writeSourceFile("{{blog_id}},terraform_test,subtitle,single_line_text_field,Hello again\n"),
+				Config:    testAccMetafieldsImportResourceConfig(sourceFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metafields_import.test", "rows.%", "1"),
+					resource.TestCheckNoResourceAttr("shopify_metafields_import.test", fmt.Sprintf("rows.%s/terraform_test/columns", blogID)),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetafieldsImportResourceConfig(sourceFile string) string {
+	return fmt.Sprintf(`
+resource "shopify_metafields_import" "test" {
+  source_file = %[1]q
+}
+`, sourceFile)
+}
+
+func TestParseMetafieldsImportCSV(t *testing.T) {
+	rows, err := parseMetafieldsImportCSV(strings.NewReader("key,namespace,owner_id,value,type\n" +
+		"color,custom,gid://shopify/Product/1,\"Red, dark\",single_line_text_field\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*metafieldsImportRow{
+		{OwnerID: "gid://shopify/Product/1", Namespace: "custom", Key: "color", Type: "single_line_text_field", Value: "Red, dark"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("unexpected rows: %+v", rows[0])
+	}
+
+	for name, content := range map[string]string{
+		"empty":          "",
+		"missing column": "owner_id,namespace,key,value\n",
+		"missing type":   "owner_id,namespace,key,type,value\ngid://shopify/Product/1,custom,color,,Red\n",
+		"duplicate": "owner_id,namespace,key,type,value\n" +
+			"gid://shopify/Product/1,custom,color,single_line_text_field,Red\n" +
+			"gid://shopify/Product/1,custom,color,single_line_text_field,Blue\n",
+	} {
+		if _, err := parseMetafieldsImportCSV(strings.NewReader(content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestParseMetafieldsImportJSON(t *testing.T) {
+	rows, err := parseMetafieldsImportJSON(strings.NewReader(`[
+  {"owner_id": "gid://shopify/Product/1", "namespace": "custom", "key": "sizes", "type": "list.single_line_text_field", "value": "[\"S\",\"M\"]"}
+]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*metafieldsImportRow{
+		{OwnerID: "gid://shopify/Product/1", Namespace: "custom", Key: "sizes", Type: "list.single_line_text_field", Value: `["S","M"]`},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("unexpected rows: %+v", rows[0])
+	}
+
+	if _, err := parseMetafieldsImportJSON(strings.NewReader(`[{"owner": "gid://shopify/Product/1"}]`)); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestParseMetafieldsImportRowKey(t *testing.T) {
+	row := &metafieldsImportRow{OwnerID: "gid://shopify/Product/1", Namespace: "$app:reviews", Key: "rating"}
+	got := parseMetafieldsImportRowKey(metafieldsImportRowKey(row))
+	want := &shopify.MetafieldIdentifierInput{OwnerID: row.OwnerID, Namespace: row.Namespace, Key: row.Key}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestDiffMetafieldsImportRows(t *testing.T) {
+	unchanged := &metafieldsImportRow{OwnerID: "gid://shopify/Product/1", Namespace: "custom", Key: "unchanged", Type: "single_line_text_field", Value: "A"}
+	changed := &metafieldsImportRow{OwnerID: "gid://shopify/Product/1", Namespace: "custom", Key: "changed", Type: "single_line_text_field", Value: "B"}
+	added := &metafieldsImportRow{OwnerID: "gid://shopify/Product/2", Namespace: "custom", Key: "added", Type: "number_integer", Value: "1"}
+	current := hashMetafieldsImportRows([]*metafieldsImportRow{
+		unchanged,
+		{OwnerID: changed.OwnerID, Namespace: changed.Namespace, Key: changed.Key, Type: changed.Type, Value: "Old"},
+		{OwnerID: "gid://shopify/Product/3", Namespace: "custom", Key: "removed", Type: "single_line_text_field", Value: "C"},
+	})
+
+	keys, inputs := diffMetafieldsImportRows([]*metafieldsImportRow{unchanged, changed, added}, current)
+	wantKeys := []string{"gid://shopify/Product/1/custom/changed", "gid://shopify/Product/2/custom/added"}
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("expected keys %v, got %v", wantKeys, keys)
+	}
+	if len(inputs) != 2 || inputs[0].Value != "B" || inputs[1].Type != "number_integer" {
+		t.Errorf("unexpected inputs: %+v, %+v", inputs[0], inputs[1])
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -13,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
 	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
 	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
 	"github.com/zero-clor/terraform-provider-shopify/pkg/xslice"
@@ -27,6 +31,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MetaobjectDefinitionResource{}
 var _ resource.ResourceWithImportState = &MetaobjectDefinitionResource{}
+var _ resource.ResourceWithValidateConfig = &MetaobjectDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &MetaobjectDefinitionResource{}
 
 // MetaobjectDefinitionResource defines the resource implementation.
 type MetaobjectDefinitionResource struct {
@@ -42,49 +48,117 @@ type MetaobjectFieldDefinitionResourceModel struct {
 
 // MetaobjectDefinitionResourceModel describes the resource data model.
 type MetaobjectDefinitionResourceModel struct {
-	ID                types.String                      `tfsdk:"id"`
-	Name              types.String                      `tfsdk:"name"`
-	Type              types.String                      `tfsdk:"type"`
-	Description       types.String                      `tfsdk:"description"`
-	DisplayNameKey    types.String                      `tfsdk:"display_name_key"`
-	FieldDefinitions  []*MetaobjectFieldDefinitionModel `tfsdk:"field_definitions"`
-	HasThumbnailField types.Bool                        `tfsdk:"has_thumbnail_field"`
-	Access            types.Object                      `tfsdk:"access"`
+	ID                           types.String                      `tfsdk:"id"`
+	Name                         types.String                      `tfsdk:"name"`
+	Type                         types.String                      `tfsdk:"type"`
+	Description                  types.String                      `tfsdk:"description"`
+	DisplayNameKey               types.String                      `tfsdk:"display_name_key"`
+	FieldDefinitions             []*MetaobjectFieldDefinitionModel `tfsdk:"field_definitions"`
+	HasThumbnailField            types.Bool                        `tfsdk:"has_thumbnail_field"`
+	Access                       types.Object                      `tfsdk:"access"`
+	Capabilities                 types.Object                      `tfsdk:"capabilities"`
+	ExternallyManagedValidations types.Bool                        `tfsdk:"externally_managed_validations"`
+	AppOwned                     types.Bool                        `tfsdk:"app_owned"`
 }
 
-type MetaobjectDefinitionAccessModel struct {
-	Admin      types.String `tfsdk:"admin"`
-	Storefront types.String `tfsdk:"storefront"`
+// MetaobjectDefinitionAccessModel is the access model shared with the data sources.
+type MetaobjectDefinitionAccessModel = convert.AccessModel
+
+var metaobjectDefinitionRenderableAttrTypes = map[string]attr.Type{
+	"meta_title_key":       types.StringType,
+	"meta_description_key": types.StringType,
+}
+
+var metaobjectDefinitionOnlineStoreAttrTypes = map[string]attr.Type{
+	"url_handle":           types.StringType,
+	"can_create_redirects": types.BoolType,
+}
+
+var metaobjectDefinitionCapabilitiesAttrTypes = map[string]attr.Type{
+	"publishable":  types.BoolType,
+	"translatable": types.BoolType,
+	"renderable":   types.ObjectType{AttrTypes: metaobjectDefinitionRenderableAttrTypes},
+	"online_store": types.ObjectType{AttrTypes: metaobjectDefinitionOnlineStoreAttrTypes},
+}
+
+type MetaobjectDefinitionCapabilitiesModel struct {
+	Publishable  types.Bool                            `tfsdk:"publishable"`
+	Translatable types.Bool                            `tfsdk:"translatable"`
+	Renderable   *MetaobjectDefinitionRenderableModel  `tfsdk:"renderable"`
+	OnlineStore  *MetaobjectDefinitionOnlineStoreModel `tfsdk:"online_store"`
+}
+
+// MetaobjectDefinitionRenderableModel describes the renderable capability, which is enabled when it's set.
+type MetaobjectDefinitionRenderableModel struct {
+	MetaTitleKey       types.String `tfsdk:"meta_title_key"`
+	MetaDescriptionKey types.String `tfsdk:"meta_description_key"`
 }
 
-func (m *MetaobjectDefinitionAccessModel) toTerraformObject(ctx context.Context) (types.Object, diag.Diagnostics) {
-	return types.ObjectValueFrom(ctx, map[string]attr.Type{
-		"admin":      types.StringType,
-		"storefront": types.StringType,
-	}, m)
+// MetaobjectDefinitionOnlineStoreModel describes the online store capability, which is enabled when it's set.
+type MetaobjectDefinitionOnlineStoreModel struct {
+	URLHandle          types.String `tfsdk:"url_handle"`
+	CanCreateRedirects types.Bool   `tfsdk:"can_create_redirects"`
 }
 
-func (m *MetaobjectDefinitionAccessModel) toShopifyModel() *shopify.MetaobjectAccess {
-	storefront := m.Storefront.ValueString()
-	if storefront == "LEGACY_LIQUID_ONLY" {
-		storefront = ""
+func (m *MetaobjectDefinitionCapabilitiesModel) toTerraformObject(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, metaobjectDefinitionCapabilitiesAttrTypes, m)
+}
+
+func (m *MetaobjectDefinitionCapabilitiesModel) toShopifyModel(data *MetaobjectDefinitionResourceModel) *shopify.MetaobjectCapabilities {
+	capabilities := &shopify.MetaobjectCapabilities{
+		Publishable:  &shopify.MetaobjectCapabilityStatus{Enabled: m.Publishable.ValueBool()},
+		Translatable: &shopify.MetaobjectCapabilityStatus{Enabled: m.Translatable.ValueBool()},
+	}
+	if m.Renderable != nil {
+		capabilities.Renderable = m.Renderable.toShopifyModel(data)
 	}
-	return &shopify.MetaobjectAccess{
-		Admin:      m.Admin.ValueString(),
-		Storefront: storefront,
+	if m.OnlineStore != nil {
+		capabilities.OnlineStore = m.OnlineStore.toShopifyModel()
+	}
+	return capabilities
+}
+
+func (m *MetaobjectDefinitionOnlineStoreModel) toShopifyModel() *shopify.MetaobjectCapabilityOnlineStore {
+	return &shopify.MetaobjectCapabilityOnlineStore{
+		Enabled: true,
+		Data: &shopify.MetaobjectCapabilityOnlineStoreData{
+			URLHandle:       m.URLHandle.ValueString(),
+			CreateRedirects: m.CanCreateRedirects.ValueBoolPointer(),
+		},
+	}
+}
+
+func (m *MetaobjectDefinitionRenderableModel) toShopifyModel(data *MetaobjectDefinitionResourceModel) *shopify.MetaobjectCapabilityRenderable {
+	return &shopify.MetaobjectCapabilityRenderable{
+		Enabled: true,
+		Data: &shopify.MetaobjectCapabilityRenderableData{
+			MetaTitleKey:       convertFieldKeyToShopifyKey(m.MetaTitleKey, data),
+			MetaDescriptionKey: convertFieldKeyToShopifyKey(m.MetaDescriptionKey, data),
+		},
 	}
 }
 
 // MetaobjectFieldDefinitionModel describes the metaobject field definition data model.
 type MetaobjectFieldDefinitionModel struct {
 	Key         types.String                          `tfsdk:"key"`
+	PreviousKey types.String                          `tfsdk:"previous_key"`
 	Name        types.String                          `tfsdk:"name"`
 	Description types.String                          `tfsdk:"description"`
 	Type        types.String                          `tfsdk:"type"`
+	Category    types.String                          `tfsdk:"category"`
 	Required    types.Bool                            `tfsdk:"required"`
 	Validations []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
 }
 
+// shopifyKey returns the key of the field in Shopify.
+// Shopify doesn't support renaming a field key, so a renamed field keeps its previous key.
+func (m *MetaobjectFieldDefinitionModel) shopifyKey() string {
+	if m.PreviousKey.ValueString() != "" {
+		return m.PreviousKey.ValueString()
+	}
+	return m.Key.ValueString()
+}
+
 func (r *MetaobjectDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_metaobject_definition"
 }
@@ -118,6 +192,8 @@ func (r *MetaobjectDefinitionResource) Schema(ctx context.Context, req resource.
 				Optional:            true,
 			},
 			"field_definitions": schema.ListNestedAttribute{
+				MarkdownDescription: "The fields of the metaobject definition. An empty list creates the definition without fields, " +
+					"e.g. to provision it first and add the fields in a later stage.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"key": schema.StringAttribute{
@@ -126,6 +202,14 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 `,
 							Required: true,
 						},
+						"previous_key": schema.StringAttribute{
+							MarkdownDescription: `The key the field had before ` + "`key`" + ` was changed.
+Shopify doesn't support renaming a field key, so changing ` + "`key`" + ` alone deletes the field and its data and creates a new one.
+Setting this to the previous key keeps the existing field and its data, which stays under the previous key in Shopify while being referenced by ` + "`key`" + ` in the configuration.
+So it has to be kept as long as ` + "`key`" + ` differs from it, and can be removed along with setting ` + "`key`" + ` back to the previous key.
+`,
+							Optional: true,
+						},
 						"name": schema.StringAttribute{
 							MarkdownDescription: "A human-readable name for the field. This can be changed at any time.",
 							Optional:            true,
@@ -149,6 +233,10 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 								),
 							},
 						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "The category of the type of the field, e.g. `TEXT` or `REFERENCE`.",
+							Computed:            true,
+						},
 						"required": schema.BoolAttribute{
 							MarkdownDescription: "Whether metaobjects require a saved value for the field.",
 							Optional:            true,
@@ -164,7 +252,7 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 										Required:            true,
 									},
 									"value": schema.StringAttribute{
-										MarkdownDescription: "The value for the metafield definition validation.",
+										MarkdownDescription: "The value for the metafield definition validation. The metaobject definitions of the `metaobject_definition_id` and `metaobject_definition_ids` validations can be referred to by type instead of GID.",
 										Required:            true,
 									},
 								},
@@ -175,6 +263,14 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 				},
 				Required: true,
 			},
+			"app_owned": schema.BoolAttribute{
+				MarkdownDescription: "Whether the type is reserved to an app, i.e. `$app:<type>` or `app--<app_id>--<type>`. " +
+					"A definition reserved to another app than the one of the provider can't be imported, as the app depends on it.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"has_thumbnail_field": schema.BoolAttribute{
 				MarkdownDescription: "Whether this metaobject definition has field whose type can visually represent a metaobject with the thumbnailField.",
 				Computed:            true,
@@ -202,6 +298,65 @@ Must be 3-64 characters long and only contain alphanumeric, hyphen, and undersco
 					objectplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "The capabilities of the metaobject definition. Capabilities removed from the configuration are disabled.",
+				Attributes: map[string]schema.Attribute{
+					"publishable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metaobjects of the definition have a publishable status.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"translatable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the metaobjects of the definition can be translated.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"renderable": schema.SingleNestedAttribute{
+						MarkdownDescription: "Enables the metaobjects of the definition to be rendered as web pages, with the SEO meta tags taken from their fields.",
+						Attributes: map[string]schema.Attribute{
+							"meta_title_key": schema.StringAttribute{
+								MarkdownDescription: "The key of the field definition used as the SEO meta title.",
+								Optional:            true,
+							},
+							"meta_description_key": schema.StringAttribute{
+								MarkdownDescription: "The key of the field definition used as the SEO meta description.",
+								Optional:            true,
+							},
+						},
+						Optional: true,
+					},
+					"online_store": schema.SingleNestedAttribute{
+						MarkdownDescription: "Gives the metaobjects of the definition web pages on the online store, at `/pages/<url_handle>/<metaobject handle>`, " +
+							"rendered with the `metaobject/<type>` template of the theme. It requires `renderable`. " +
+							"There's no `template_suffix`: Shopify's definition capability has none, as the alternate template of a page " +
+							"is chosen per metaobject, by the template suffix of the metaobject's own online store capability.",
+						Attributes: map[string]schema.Attribute{
+							"url_handle": schema.StringAttribute{
+								MarkdownDescription: "The URL handle of the pages of the metaobjects.",
+								Required:            true,
+							},
+							"can_create_redirects": schema.BoolAttribute{
+								MarkdownDescription: "Whether changing the URL handle redirects the previous URLs of the metaobjects to the new ones.",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default: objectdefault.StaticValue(types.ObjectValueMust(metaobjectDefinitionCapabilitiesAttrTypes, map[string]attr.Value{
+					"publishable":  types.BoolValue(false),
+					"translatable": types.BoolValue(false),
+					"renderable":   types.ObjectNull(metaobjectDefinitionRenderableAttrTypes),
+					"online_store": types.ObjectNull(metaobjectDefinitionOnlineStoreAttrTypes),
+				})),
+			},
+			"externally_managed_validations": externallyManagedValidationsSchemaAttribute(),
 		},
 	}
 }
@@ -214,28 +369,215 @@ func (r *MetaobjectDefinitionResource) Configure(ctx context.Context, req resour
 	r.client, _ = req.ProviderData.(*shopify.Client)
 }
 
-func (r *MetaobjectDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data MetaobjectDefinitionResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+func (r *MetaobjectDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var fieldDefinitions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("field_definitions"), &fieldDefinitions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if fieldDefinitions.IsNull() || fieldDefinitions.IsUnknown() {
+		return
+	}
+	var fieldDefinitionModels []*MetaobjectFieldDefinitionModel
+	resp.Diagnostics.Append(fieldDefinitions.ElementsAs(ctx, &fieldDefinitionModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateMetaobjectFieldDefinitionKeys(fieldDefinitionModels)...)
+
+	keys := make([]string, 0, len(fieldDefinitionModels))
+	for _, fieldDefinition := range fieldDefinitionModels {
+		// The keys can't be checked until they're known, so skip the validation for now
+		if fieldDefinition.Key.IsUnknown() {
+			return
+		}
+		keys = append(keys, fieldDefinition.Key.ValueString())
+	}
+
+	var displayNameKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("display_name_key"), &displayNameKey)...)
+	var renderable, onlineStore types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capabilities").AtName("renderable"), &renderable)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capabilities").AtName("online_store"), &onlineStore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The online store pages are rendered with the SEO meta tags of the renderable capability
+	if !onlineStore.IsNull() && !onlineStore.IsUnknown() && renderable.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("capabilities").AtName("online_store"), "Missing renderable capability",
+			"The online_store capability requires the renderable capability, set capabilities.renderable as well.")
+	}
+	resp.Diagnostics.Append(validateMetaobjectFieldKeyReference(path.Root("display_name_key"), displayNameKey, keys)...)
+	if !renderable.IsNull() && !renderable.IsUnknown() {
+		var renderableModel MetaobjectDefinitionRenderableModel
+		resp.Diagnostics.Append(renderable.As(ctx, &renderableModel, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		renderablePath := path.Root("capabilities").AtName("renderable")
+		resp.Diagnostics.Append(validateMetaobjectFieldKeyReference(renderablePath.AtName("meta_title_key"), renderableModel.MetaTitleKey, keys)...)
+		resp.Diagnostics.Append(validateMetaobjectFieldKeyReference(renderablePath.AtName("meta_description_key"), renderableModel.MetaDescriptionKey, keys)...)
+	}
+}
+
+func (r *MetaobjectDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state MetaobjectDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var shopifyFieldDefinitions []*shopify.MetaobjectFieldDefinitionCreateInput
-	for _, fieldDefinitionModel := range data.FieldDefinitions {
-		shopifyFieldDefinitions = append(shopifyFieldDefinitions, convertMetaobjectFieldDefinitionModelToCreateInput(fieldDefinitionModel))
+	if key, ok := removedDisplayNameFieldKey(&plan, &state); ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("display_name_key"),
+			"Field referenced by display_name_key is removed",
+			fmt.Sprintf("The field %q is the display name of the metaobjects, so removing it would leave the metaobject definition "+
+				"with a dangling display_name_key. Set display_name_key to the key of another field in the same change, or keep the field.", key),
+		)
+	}
+
+	resp.Diagnostics.Append(validatePreviousKeys(&plan, &state)...)
+
+	if keepFieldDefinitionCategories(&plan, &state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("field_definitions"), plan.FieldDefinitions)...)
+	}
+}
+
+// keepFieldDefinitionCategories sets the unknown categories of the planned fields whose type is unchanged to the ones in the state,
+// as the category only changes with the type. It returns whether any category has been set.
+func keepFieldDefinitionCategories(plan, state *MetaobjectDefinitionResourceModel) bool {
+	oldFieldDefinitionMap := make(map[string]*MetaobjectFieldDefinitionModel, len(state.FieldDefinitions))
+	for _, fieldDefinition := range state.FieldDefinitions {
+		oldFieldDefinitionMap[fieldDefinition.Key.ValueString()] = fieldDefinition
+	}
+	changed := false
+	for _, newFieldDef := range plan.FieldDefinitions {
+		if !newFieldDef.Category.IsUnknown() {
+			continue
+		}
+		oldKey, ok := findOldMetaobjectFieldDefinitionKey(oldFieldDefinitionMap, newFieldDef)
+		if !ok || !newFieldDef.Type.Equal(oldFieldDefinitionMap[oldKey].Type) {
+			continue
+		}
+		newFieldDef.Category = oldFieldDefinitionMap[oldKey].Category
+		changed = true
+	}
+	return changed
+}
+
+// validatePreviousKeys checks that the planned fields matching a field of the state by key keep its key in Shopify,
+// which can't rename a field, e.g. that previous_key isn't removed after a rename. The update would otherwise target a key that doesn't exist.
+// A field whose type changes is recreated under its new key, so it can drop its previous key.
+func validatePreviousKeys(plan, state *MetaobjectDefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, newFieldDef := range plan.FieldDefinitions {
+		if newFieldDef.Key.IsUnknown() || newFieldDef.PreviousKey.IsUnknown() {
+			continue
+		}
+		oldFieldDef, ok := xslice.FindBy(state.FieldDefinitions, func(v *MetaobjectFieldDefinitionModel) bool {
+			return v.Key.Equal(newFieldDef.Key)
+		})
+		if !ok || !newFieldDef.Type.Equal(oldFieldDef.Type) || newFieldDef.shopifyKey() == oldFieldDef.shopifyKey() {
+			continue
+		}
+		expected := "must be unset"
+		if oldFieldDef.PreviousKey.ValueString() != "" {
+			expected = fmt.Sprintf("must stay %q", oldFieldDef.PreviousKey.ValueString())
+		}
+		diags.AddAttributeError(
+			path.Root("field_definitions").AtListIndex(i).AtName("previous_key"),
+			"Invalid previous_key",
+			fmt.Sprintf("The field %q has the key %q in Shopify, which can't rename a field key, so previous_key %s. "+
+				"Set key back to %[2]q to remove previous_key, or change the type of the field to recreate it under its new key, deleting its data.",
+				newFieldDef.Key.ValueString(), oldFieldDef.shopifyKey(), expected),
+		)
+	}
+	return diags
+}
+
+// removedDisplayNameFieldKey returns the key of the field referenced by the display name key if the plan removes the field.
+// An unset display_name_key keeps the display name key in Shopify, so the one in the state still applies.
+func removedDisplayNameFieldKey(plan, state *MetaobjectDefinitionResourceModel) (string, bool) {
+	if plan.DisplayNameKey.IsUnknown() {
+		return "", false
+	}
+	displayNameKey := plan.DisplayNameKey
+	if displayNameKey.IsNull() {
+		displayNameKey = state.DisplayNameKey
+	}
+	if displayNameKey.ValueString() == "" {
+		return "", false
+	}
+	oldFieldDef, ok := xslice.FindBy(state.FieldDefinitions, func(v *MetaobjectFieldDefinitionModel) bool {
+		return v.Key.Equal(displayNameKey)
+	})
+	if !ok {
+		return "", false
+	}
+	for _, newFieldDef := range plan.FieldDefinitions {
+		if newFieldDef.Key.IsUnknown() || newFieldDef.PreviousKey.IsUnknown() || newFieldDef.shopifyKey() == oldFieldDef.shopifyKey() {
+			return "", false
+		}
+	}
+	return displayNameKey.ValueString(), true
+}
+
+// validateMetaobjectFieldKeyReference checks that the attribute refers to the key of one of the field definitions.
+func validateMetaobjectFieldKeyReference(attributePath path.Path, key types.String, keys []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if key.IsNull() || key.IsUnknown() || slices.Contains(keys, key.ValueString()) {
+		return diags
+	}
+	name, _ := attributePath.Steps().LastStep()
+	diags.AddAttributeError(
+		attributePath,
+		fmt.Sprintf("Invalid %s", name),
+		fmt.Sprintf("%s %q must match the key of one of the field_definitions, got keys: %v", name, key.ValueString(), keys),
+	)
+	return diags
+}
+
+// validateMetaobjectFieldDefinitionKeys checks that no two field definitions share the same key.
+func validateMetaobjectFieldDefinitionKeys(fieldDefinitions []*MetaobjectFieldDefinitionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	keyIndexes := make(map[string]int, len(fieldDefinitions))
+	for i, fieldDefinition := range fieldDefinitions {
+		if fieldDefinition.Key.IsNull() || fieldDefinition.Key.IsUnknown() {
+			continue
+		}
+		key := fieldDefinition.Key.ValueString()
+		if j, ok := keyIndexes[key]; ok {
+			diags.AddAttributeError(
+				path.Root("field_definitions").AtListIndex(i).AtName("key"),
+				"Duplicate field definition key",
+				fmt.Sprintf("The key %q is already used by field_definitions[%d]. The key of each field definition must be unique.", key, j),
+			)
+			continue
+		}
+		keyIndexes[key] = i
 	}
+	return diags
+}
 
-	var displayNameKey *string
-	if data.DisplayNameKey.ValueString() != "" {
-		displayNameKey = data.DisplayNameKey.ValueStringPointer()
+func (r *MetaobjectDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetaobjectDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	displayNameKey := convertDisplayNameKeyToShopifyKey(&data)
 	input := shopify.MetaobjectDefinitionCreateInput{
 		Type:             data.Type.ValueString(),
 		Name:             data.Name.ValueString(),
 		Description:      data.Description.ValueStringPointer(),
 		DisplayNameKey:   displayNameKey,
-		FieldDefinitions: shopifyFieldDefinitions,
+		FieldDefinitions: convertMetaobjectFieldDefinitionModelsToCreateInputs(data.FieldDefinitions),
 	}
 	if !data.Access.IsNull() && !data.Access.IsUnknown() {
 		var access MetaobjectDefinitionAccessModel
@@ -243,14 +585,27 @@ func (r *MetaobjectDefinitionResource) Create(ctx context.Context, req resource.
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		input.Access = access.toShopifyModel()
+		input.Access = access.ToShopifyModel()
+	}
+	if !data.Capabilities.IsNull() && !data.Capabilities.IsUnknown() {
+		var capabilities MetaobjectDefinitionCapabilitiesModel
+		resp.Diagnostics.Append(data.Capabilities.As(ctx, &capabilities, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var diags diag.Diagnostics
+		input.Capabilities, diags = convertMetaobjectCapabilitiesForAPIVersion(capabilities.toShopifyModel(&data), r.client)
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+			return
+		}
 	}
 	createdMetaobjectDefinition, err := r.client.CreateMetaobjectDefinition(ctx, &input)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metaobject definition, got error: %s", err))
+		resp.Diagnostics.Append(diagFromClientError("Unable to create metaobject definition", err))
 		return
 	}
 
+	keepMetaobjectFieldDefinitionReferences(ctx, r.client, createdMetaobjectDefinition, data.FieldDefinitions)
 	createdData, diag := convertMetaobjectDefinitionToResourceModel(ctx, createdMetaobjectDefinition, &data)
 	tflog.Trace(ctx, "created a metaobject definition", map[string]interface{}{
 		"id": createdData.ID,
@@ -271,9 +626,10 @@ func (r *MetaobjectDefinitionResource) Read(ctx context.Context, req resource.Re
 
 	metaobjectDefinition, err := r.client.GetMetaobjectDefinition(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metaobject definition, got error: %s", err))
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metaobject definition", err))
 		return
 	}
+	keepMetaobjectFieldDefinitionReferences(ctx, r.client, metaobjectDefinition, data.FieldDefinitions)
 	metaobjectDefinitionModel, diags := convertMetaobjectDefinitionToResourceModel(ctx, metaobjectDefinition, &data)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
@@ -283,110 +639,67 @@ func (r *MetaobjectDefinitionResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *MetaobjectDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data MetaobjectDefinitionResourceModel
+	var data, state MetaobjectDefinitionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var oldFieldDefinitions []*MetaobjectFieldDefinitionModel
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("field_definitions"), &oldFieldDefinitions)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	oldFieldDefinitionMap := make(map[string]*MetaobjectFieldDefinitionModel, len(oldFieldDefinitions))
-	for _, fieldDefinition := range oldFieldDefinitions {
-		oldFieldDefinitionMap[fieldDefinition.Key.ValueString()] = fieldDefinition
-	}
-
-	var fieldDefinitions1stReq []*shopify.MetaobjectFieldDefinitionOperationInput
-	var fieldDefinitions2ndReq []*shopify.MetaobjectFieldDefinitionOperationInput
-	var recreateFieldDefinitions []string
-	for _, newFieldDef := range data.FieldDefinitions {
-		if oldFieldDef, ok := oldFieldDefinitionMap[newFieldDef.Key.ValueString()]; ok {
-			delete(oldFieldDefinitionMap, newFieldDef.Key.ValueString())
-			if reflect.DeepEqual(oldFieldDef, newFieldDef) {
-				continue
-			}
-			if !newFieldDef.Type.Equal(oldFieldDef.Type) {
-				fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-					Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{
-						Key: oldFieldDef.Key.ValueString(),
-					},
-				})
-				fieldDefinitions2ndReq = append(fieldDefinitions2ndReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-					Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
-				})
-				recreateFieldDefinitions = append(recreateFieldDefinitions, newFieldDef.Key.ValueString())
-			} else {
-				fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-					Update: &shopify.MetaobjectFieldDefinitionUpdateInput{
-						Key:         newFieldDef.Key.ValueString(),
-						Name:        newFieldDef.Name.ValueStringPointer(),
-						Description: newFieldDef.Description.ValueStringPointer(),
-						Required:    newFieldDef.Required.ValueBool(),
-						Validations: convertValidationModelsToValidations(newFieldDef.Validations),
-					},
-				})
-			}
-		} else {
-			fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-				Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
-			})
+	// The validations added outside of Terraform are sent back along with the planned ones not to remove them
+	var currentDefinition *shopify.MetaobjectDefinition
+	if data.ExternallyManagedValidations.ValueBool() {
+		var err error
+		currentDefinition, err = r.client.GetMetaobjectDefinition(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to read metaobject definition", err))
+			return
 		}
 	}
-	if len(recreateFieldDefinitions) > 0 {
-		tflog.Warn(ctx, "")
-	}
 
-	for _, oldFieldDef := range oldFieldDefinitionMap {
-		fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
-			Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{
-				Key: oldFieldDef.Key.ValueString(),
-			},
-		})
-	}
+	fieldDefinitions1stReq, fieldDefinitions2ndReq, recreateFieldDefinitions := convertMetaobjectFieldDefinitionChangesToOperations(state.FieldDefinitions, data.FieldDefinitions, currentDefinition)
 
-	var displayNameKey *string
-	if data.DisplayNameKey.ValueString() != "" {
-		displayNameKey = data.DisplayNameKey.ValueStringPointer()
+	input1stReq, diags := convertMetaobjectDefinitionChangesToUpdateInput(ctx, &data, &state, recreateFieldDefinitions)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
 	}
-	input1stReq := shopify.MetaobjectDefinitionUpdateInput{
-		Name:             data.Name.ValueString(),
-		Description:      data.Description.ValueStringPointer(),
-		DisplayNameKey:   displayNameKey,
-		FieldDefinitions: fieldDefinitions1stReq,
+	input1stReq.FieldDefinitions = fieldDefinitions1stReq
+	capabilitiesInput, diags := convertMetaobjectCapabilitiesToUpdateInput(ctx, state.Capabilities, data.Capabilities, &data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
 	}
-	if !data.Access.IsNull() && !data.Access.IsUnknown() {
-		var access MetaobjectDefinitionAccessModel
-		resp.Diagnostics.Append(data.Access.As(ctx, &access, basetypes.ObjectAsOptions{})...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		input1stReq.Access = access.toShopifyModel()
+	capabilitiesInput, diags = convertMetaobjectCapabilitiesForAPIVersion(capabilitiesInput, r.client)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
 	}
-	updatedMetaobjectDefinition, err := r.client.UpdateMetaobjectDefinition(ctx, data.ID.ValueString(), &input1stReq)
+	input1stReq.Capabilities = capabilitiesInput
+	updatedMetaobjectDefinition, err := r.client.UpdateMetaobjectDefinition(ctx, data.ID.ValueString(), input1stReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metaobject definition, got error: %s", err))
+		resp.Diagnostics.Append(diagFromClientError("Unable to update metaobject definition", err))
 		return
 	}
+	keepMetaobjectFieldDefinitionReferences(ctx, r.client, updatedMetaobjectDefinition, data.FieldDefinitions)
 	updateData, diags := convertMetaobjectDefinitionToResourceModel(ctx, updatedMetaobjectDefinition, &data)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
 
-	if len(fieldDefinitions2ndReq) > 0 {
+	// The display name key referencing a recreated field is only valid once the field is created again
+	var displayNameKey2ndReq *string
+	if slices.Contains(recreateFieldDefinitions, data.DisplayNameKey.ValueString()) {
+		displayNameKey2ndReq = convertDisplayNameKeyToShopifyKey(&data)
+	}
+	if len(fieldDefinitions2ndReq) > 0 || displayNameKey2ndReq != nil {
 		input2ndReq := shopify.MetaobjectDefinitionUpdateInput{
-			Name:             data.Name.ValueString(),
-			Description:      data.Description.ValueStringPointer(),
-			DisplayNameKey:   displayNameKey,
+			DisplayNameKey:   displayNameKey2ndReq,
 			FieldDefinitions: fieldDefinitions2ndReq,
 		}
 		updatedMetaobjectDefinition, err := r.client.UpdateMetaobjectDefinition(ctx, data.ID.ValueString(), &input2ndReq)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metaobject definition, got error: %s", err))
+			resp.Diagnostics.Append(diagFromClientError("Unable to update metaobject definition", err))
 			return
 		}
+		keepMetaobjectFieldDefinitionReferences(ctx, r.client, updatedMetaobjectDefinition, data.FieldDefinitions)
 		updateData, diags = convertMetaobjectDefinitionToResourceModel(ctx, updatedMetaobjectDefinition, &data)
 		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 			return
@@ -396,6 +709,81 @@ func (r *MetaobjectDefinitionResource) Update(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updateData)...)
 }
 
+// convertMetaobjectFieldDefinitionChangesToOperations returns the operations updating the field definitions of the state to the planned ones
+// in as few requests as possible, along with the keys of the recreated fields.
+// Shopify can't change the type of a field, so the field is deleted and created again. The deletes go first in a request,
+// so that a field recreated under another key, e.g. renamed along with its type, is recreated within the same request.
+// A field recreated under the same key needs a 2nd request though, as a request can't both delete and create the same key.
+func convertMetaobjectFieldDefinitionChangesToOperations(state, plan []*MetaobjectFieldDefinitionModel, currentDefinition *shopify.MetaobjectDefinition) (
+	fieldDefinitions1stReq, fieldDefinitions2ndReq []*shopify.MetaobjectFieldDefinitionOperationInput, recreateFieldDefinitions []string) {
+	oldFieldDefinitionMap := make(map[string]*MetaobjectFieldDefinitionModel, len(state))
+	for _, fieldDefinition := range state {
+		oldFieldDefinitionMap[fieldDefinition.Key.ValueString()] = fieldDefinition
+	}
+
+	var deletes []*shopify.MetaobjectFieldDefinitionOperationInput
+	for _, newFieldDef := range plan {
+		oldKey, ok := findOldMetaobjectFieldDefinitionKey(oldFieldDefinitionMap, newFieldDef)
+		if !ok {
+			fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
+			})
+			continue
+		}
+		oldFieldDef := oldFieldDefinitionMap[oldKey]
+		delete(oldFieldDefinitionMap, oldKey)
+		if metaobjectFieldDefinitionsEqual(oldFieldDef, newFieldDef) {
+			continue
+		}
+		if !newFieldDef.Type.Equal(oldFieldDef.Type) {
+			deletes = append(deletes, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{
+					Key: oldFieldDef.shopifyKey(),
+				},
+			})
+			create := &shopify.MetaobjectFieldDefinitionOperationInput{
+				Create: convertMetaobjectFieldDefinitionModelToCreateInput(newFieldDef),
+			}
+			if newFieldDef.shopifyKey() == oldFieldDef.shopifyKey() {
+				fieldDefinitions2ndReq = append(fieldDefinitions2ndReq, create)
+			} else {
+				fieldDefinitions1stReq = append(fieldDefinitions1stReq, create)
+			}
+			recreateFieldDefinitions = append(recreateFieldDefinitions, newFieldDef.Key.ValueString())
+			continue
+		}
+		validations := convert.ValidationModelsToValidations(newFieldDef.Validations)
+		if currentDefinition != nil {
+			if currentFieldDef, ok := xslice.FindBy(currentDefinition.FieldDefinitions, func(v *shopify.MetaobjectFieldDefinition) bool {
+				return v.Key == oldFieldDef.shopifyKey()
+			}); ok {
+				validations = convert.MergeExternalValidations(newFieldDef.Validations, oldFieldDef.Validations, currentFieldDef.Validations)
+			}
+		}
+		fieldDefinitions1stReq = append(fieldDefinitions1stReq, &shopify.MetaobjectFieldDefinitionOperationInput{
+			Update: &shopify.MetaobjectFieldDefinitionUpdateInput{
+				Key:         newFieldDef.shopifyKey(),
+				Name:        newFieldDef.Name.ValueStringPointer(),
+				Description: newFieldDef.Description.ValueStringPointer(),
+				Required:    newFieldDef.Required.ValueBool(),
+				Validations: validations,
+			},
+		})
+	}
+
+	// The removed fields are deleted in the order of the state, so that the request is the same from a run to another
+	for _, oldFieldDef := range state {
+		if _, ok := oldFieldDefinitionMap[oldFieldDef.Key.ValueString()]; ok {
+			deletes = append(deletes, &shopify.MetaobjectFieldDefinitionOperationInput{
+				Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{
+					Key: oldFieldDef.shopifyKey(),
+				},
+			})
+		}
+	}
+	return append(deletes, fieldDefinitions1stReq...), fieldDefinitions2ndReq, recreateFieldDefinitions
+}
+
 func (r *MetaobjectDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data MetaobjectDefinitionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -405,7 +793,7 @@ func (r *MetaobjectDefinitionResource) Delete(ctx context.Context, req resource.
 
 	err := r.client.DeleteMetaobjectDefinition(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metaobject definition, got error: %s", err))
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete metaobject definition", err))
 		return
 	}
 	tflog.Trace(ctx, "deleted a metaobject definition", map[string]interface{}{
@@ -414,79 +802,334 @@ func (r *MetaobjectDefinitionResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *MetaobjectDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var definition *shopify.MetaobjectDefinition
+	var err error
+	if strings.HasPrefix(req.ID, utils.GIDPrefix("MetaobjectDefinition")) {
+		definition, err = r.client.GetMetaobjectDefinition(ctx, req.ID)
+	} else {
+		// Anything else than a GID is the type of the definition
+		definition, err = r.client.GetMetaobjectDefinitionByType(ctx, req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metaobject definition", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Metaobject definition not found", fmt.Sprintf("No metaobject definition has the ID or the type %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(checkNotReservedToAnotherApp(ctx, r.client, "metaobject definition", "type", definition.Type)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), definition.ID)...)
 }
 
 func convertMetaobjectDefinitionToResourceModel(ctx context.Context, definition *shopify.MetaobjectDefinition, data *MetaobjectDefinitionResourceModel) (*MetaobjectDefinitionResourceModel, diag.Diagnostics) {
-	access, diags := convertAccessToModel(definition.Access).toTerraformObject(ctx)
+	access, diags := convert.AccessToModel(definition.Access).ToObject(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	capabilities, diags := convertCapabilitiesToModel(definition.Capabilities, data).toTerraformObject(ctx)
 	if diags.HasError() {
 		return nil, diags
 	}
 	fieldDefinitionModels := make([]*MetaobjectFieldDefinitionModel, 0, len(definition.FieldDefinitions))
 	for _, fieldDefinition := range definition.FieldDefinitions {
 		fieldDefinitionData, _ := xslice.FindBy(data.FieldDefinitions, func(v *MetaobjectFieldDefinitionModel) bool {
-			return v.Key.ValueString() == fieldDefinition.Key
+			return v.shopifyKey() == fieldDefinition.Key
 		})
+		if data.ExternallyManagedValidations.ValueBool() && fieldDefinitionData != nil {
+			managedFieldDefinition := *fieldDefinition
+			managedFieldDefinition.Validations = convert.ManagedValidations(fieldDefinition.Validations, fieldDefinitionData.Validations)
+			fieldDefinition = &managedFieldDefinition
+		}
 		fieldDefinitionModels = append(fieldDefinitionModels, convertMetaobjectFieldDefinitionToModel(fieldDefinition, fieldDefinitionData))
 	}
 
-	// Sort field definitions by order in the original data not to produce unnecessary diffs
+	// Sort field definitions by order in the original data not to produce unnecessary diffs.
+	// Unknown fields go last in the order of Shopify, so a fresh import keeps the order of the definition in Shopify.
 	fieldDefinitionOrderMap := make(map[string]int, len(data.FieldDefinitions))
 	for i, fieldDefinition := range data.FieldDefinitions {
 		fieldDefinitionOrderMap[fieldDefinition.Key.ValueString()] = i
 	}
-	sort.Slice(fieldDefinitionModels, func(i, j int) bool {
-		return fieldDefinitionOrderMap[fieldDefinitionModels[i].Key.ValueString()] < fieldDefinitionOrderMap[fieldDefinitionModels[j].Key.ValueString()]
+	order := func(key string) int {
+		if i, ok := fieldDefinitionOrderMap[key]; ok {
+			return i
+		}
+		return len(data.FieldDefinitions)
+	}
+	sort.SliceStable(fieldDefinitionModels, func(i, j int) bool {
+		return order(fieldDefinitionModels[i].Key.ValueString()) < order(fieldDefinitionModels[j].Key.ValueString())
 	})
 
-	// Shopify API handles empty string and null as the same value
-	// So not to produce inconsistency after apply, we'll set the same value as the plan if it's empoty
-	description := types.StringValue(definition.Description)
-	if definition.Description == "" && data.Description.IsNull() {
-		description = types.StringNull()
-	}
+	description := convert.EmptyAsNull(definition.Description, data.Description)
 
 	return &MetaobjectDefinitionResourceModel{
-		ID:                types.StringValue(definition.ID),
-		Name:              types.StringValue(definition.Name),
-		Type:              types.StringValue(definition.Type),
-		Description:       description,
-		DisplayNameKey:    types.StringPointerValue(definition.DisplayNameKey),
-		FieldDefinitions:  fieldDefinitionModels,
-		HasThumbnailField: types.BoolValue(definition.HasThumbnailField),
-		Access:            access,
+		ID:                           types.StringValue(definition.ID),
+		Name:                         types.StringValue(definition.Name),
+		Type:                         types.StringValue(definition.Type),
+		Description:                  description,
+		DisplayNameKey:               convertDisplayNameKeyToModel(definition.DisplayNameKey, data),
+		FieldDefinitions:             fieldDefinitionModels,
+		HasThumbnailField:            types.BoolValue(definition.HasThumbnailField),
+		AppOwned:                     types.BoolValue(shopify.IsAppReserved(definition.Type)),
+		Access:                       access,
+		Capabilities:                 capabilities,
+		ExternallyManagedValidations: types.BoolValue(data.ExternallyManagedValidations.ValueBool()),
 	}, nil
 }
 
-func convertAccessToModel(access *shopify.MetaobjectAccess) *MetaobjectDefinitionAccessModel {
-	return &MetaobjectDefinitionAccessModel{
-		Admin:      types.StringValue(access.Admin),
-		Storefront: types.StringValue(access.Storefront),
+func convertCapabilitiesToModel(capabilities *shopify.MetaobjectCapabilities, data *MetaobjectDefinitionResourceModel) *MetaobjectDefinitionCapabilitiesModel {
+	model := &MetaobjectDefinitionCapabilitiesModel{
+		Publishable:  types.BoolValue(false),
+		Translatable: types.BoolValue(false),
 	}
+	if capabilities == nil {
+		return model
+	}
+	if capabilities.Publishable != nil {
+		model.Publishable = types.BoolValue(capabilities.Publishable.Enabled)
+	}
+	if capabilities.Translatable != nil {
+		model.Translatable = types.BoolValue(capabilities.Translatable.Enabled)
+	}
+	if capabilities.Renderable != nil && capabilities.Renderable.Enabled {
+		model.Renderable = &MetaobjectDefinitionRenderableModel{
+			MetaTitleKey:       types.StringNull(),
+			MetaDescriptionKey: types.StringNull(),
+		}
+		if renderableData := capabilities.Renderable.Data; renderableData != nil {
+			model.Renderable.MetaTitleKey = convertShopifyKeyToFieldKey(renderableData.MetaTitleKey, data)
+			model.Renderable.MetaDescriptionKey = convertShopifyKeyToFieldKey(renderableData.MetaDescriptionKey, data)
+		}
+	}
+	if capabilities.OnlineStore != nil && capabilities.OnlineStore.Enabled {
+		model.OnlineStore = &MetaobjectDefinitionOnlineStoreModel{
+			URLHandle:          types.StringValue(""),
+			CanCreateRedirects: types.BoolValue(false),
+		}
+		if onlineStoreData := capabilities.OnlineStore.Data; onlineStoreData != nil {
+			model.OnlineStore.URLHandle = types.StringValue(onlineStoreData.URLHandle)
+			model.OnlineStore.CanCreateRedirects = types.BoolValue(onlineStoreData.CanCreateRedirects)
+		}
+	}
+	return model
+}
+
+// convertMetaobjectDefinitionChangesToUpdateInput returns the input updating only the attributes that differ between the plan and the state,
+// so that the other ones are left as they are in Shopify, e.g. the access changed outside of Terraform.
+// The display name key referencing a recreated field is left out, as it's sent along with the 2nd request once the field exists again.
+func convertMetaobjectDefinitionChangesToUpdateInput(ctx context.Context, plan, state *MetaobjectDefinitionResourceModel, recreatedKeys []string) (*shopify.MetaobjectDefinitionUpdateInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	input := &shopify.MetaobjectDefinitionUpdateInput{}
+	if !plan.Name.Equal(state.Name) {
+		input.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.Description.Equal(state.Description) {
+		// An empty description clears the removed one
+		description := plan.Description.ValueString()
+		input.Description = &description
+	}
+	displayNameKey := convertDisplayNameKeyToShopifyKey(plan)
+	if displayNameKey != nil && !reflect.DeepEqual(displayNameKey, convertDisplayNameKeyToShopifyKey(state)) && !slices.Contains(recreatedKeys, plan.DisplayNameKey.ValueString()) {
+		input.DisplayNameKey = displayNameKey
+	}
+	if !plan.Access.IsNull() && !plan.Access.IsUnknown() && !plan.Access.Equal(state.Access) {
+		var access MetaobjectDefinitionAccessModel
+		diags.Append(plan.Access.As(ctx, &access, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Access = access.ToShopifyModel()
+	}
+	return input, diags
+}
+
+// convertMetaobjectCapabilitiesToUpdateInput builds the capabilities to send on update.
+// Shopify keeps a capability enabled unless it's explicitly disabled, so a capability
+// enabled in the state but not in the plan is sent with enabled=false.
+func convertMetaobjectCapabilitiesToUpdateInput(ctx context.Context, state, plan types.Object, data *MetaobjectDefinitionResourceModel) (*shopify.MetaobjectCapabilities, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var stateModel, planModel MetaobjectDefinitionCapabilitiesModel
+	if !state.IsNull() && !state.IsUnknown() {
+		diags.Append(state.As(ctx, &stateModel, basetypes.ObjectAsOptions{})...)
+	}
+	if !plan.IsNull() && !plan.IsUnknown() {
+		diags.Append(plan.As(ctx, &planModel, basetypes.ObjectAsOptions{})...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	capabilityInput := func(state, plan types.Bool) *shopify.MetaobjectCapabilityStatus {
+		if !plan.ValueBool() && !state.ValueBool() {
+			return nil
+		}
+		return &shopify.MetaobjectCapabilityStatus{Enabled: plan.ValueBool()}
+	}
+	input := &shopify.MetaobjectCapabilities{
+		Publishable:  capabilityInput(stateModel.Publishable, planModel.Publishable),
+		Translatable: capabilityInput(stateModel.Translatable, planModel.Translatable),
+	}
+	if planModel.Renderable != nil {
+		if stateModel.Renderable == nil || !reflect.DeepEqual(planModel.Renderable, stateModel.Renderable) {
+			input.Renderable = planModel.Renderable.toShopifyModel(data)
+		}
+	} else if stateModel.Renderable != nil {
+		input.Renderable = &shopify.MetaobjectCapabilityRenderable{Enabled: false}
+	}
+	if planModel.OnlineStore != nil {
+		if stateModel.OnlineStore == nil || !reflect.DeepEqual(planModel.OnlineStore, stateModel.OnlineStore) {
+			input.OnlineStore = planModel.OnlineStore.toShopifyModel()
+		}
+	} else if stateModel.OnlineStore != nil {
+		input.OnlineStore = &shopify.MetaobjectCapabilityOnlineStore{Enabled: false}
+	}
+	if input.Publishable == nil && input.Translatable == nil && input.Renderable == nil && input.OnlineStore == nil {
+		return nil, diags
+	}
+	return input, diags
+}
+
+// convertMetaobjectCapabilitiesForAPIVersion adapts the capabilities input to the API version of the client.
+// The versions before the renderable and the online store capabilities don't accept them, so enabling them is an error,
+// and disabling them is left out as they can't have been enabled with them.
+func convertMetaobjectCapabilitiesForAPIVersion(capabilities *shopify.MetaobjectCapabilities, client *shopify.Client) (*shopify.MetaobjectCapabilities, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if capabilities == nil || (capabilities.Renderable == nil && capabilities.OnlineStore == nil) || client.SupportsAPIVersion(shopify.RenderableCapabilityAPIVersion) {
+		return capabilities, diags
+	}
+	if capabilities.Renderable != nil && capabilities.Renderable.Enabled {
+		diags.AddAttributeError(path.Root("capabilities").AtName("renderable"), "Unsupported capability",
+			fmt.Sprintf("The renderable capability requires the API version %s or later, but the provider uses %s.",
+				shopify.RenderableCapabilityAPIVersion, client.APIVersion()))
+	}
+	if capabilities.OnlineStore != nil && capabilities.OnlineStore.Enabled {
+		diags.AddAttributeError(path.Root("capabilities").AtName("online_store"), "Unsupported capability",
+			fmt.Sprintf("The online store capability requires the API version %s or later, but the provider uses %s.",
+				shopify.RenderableCapabilityAPIVersion, client.APIVersion()))
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	adapted := *capabilities
+	adapted.Renderable = nil
+	adapted.OnlineStore = nil
+	if adapted.Publishable == nil && adapted.Translatable == nil {
+		return nil, diags
+	}
+	return &adapted, diags
 }
 
 func convertMetaobjectFieldDefinitionToModel(definition *shopify.MetaobjectFieldDefinition, model *MetaobjectFieldDefinitionModel) *MetaobjectFieldDefinitionModel {
-	description := types.StringValue(definition.Description)
-	if definition.Description == "" && model != nil && model.Description.IsNull() {
-		description = types.StringNull()
+	// An empty description is null unless it's configured, also when the field has no model, e.g. when it has just been recreated
+	currentDescription := types.StringNull()
+	if model != nil {
+		currentDescription = model.Description
+	}
+	description := convert.EmptyAsNull(definition.Description, currentDescription)
+	key := types.StringValue(definition.Key)
+	previousKey := types.StringNull()
+	var validations []*MetafieldDefinitionValidationModel
+	if model != nil {
+		key = model.Key
+		previousKey = model.PreviousKey
+		validations = model.Validations
 	}
 	return &MetaobjectFieldDefinitionModel{
-		Key:         types.StringValue(definition.Key),
+		Key:         key,
+		PreviousKey: previousKey,
 		Name:        types.StringValue(definition.Name),
 		Description: description,
 		Type:        types.StringValue(definition.Type.Name),
+		Category:    types.StringValue(definition.Type.Category),
 		Required:    types.BoolValue(definition.Required),
-		Validations: convertValidationsToModels(definition.Validations),
+		Validations: convert.ValidationsToModels(definition.Validations, validations),
+	}
+}
+
+// convertMetaobjectFieldDefinitionModelsToCreateInputs never returns nil, as the field definitions are required
+// on creation, even if the definition is created without fields.
+func convertMetaobjectFieldDefinitionModelsToCreateInputs(models []*MetaobjectFieldDefinitionModel) []*shopify.MetaobjectFieldDefinitionCreateInput {
+	inputs := make([]*shopify.MetaobjectFieldDefinitionCreateInput, 0, len(models))
+	for _, model := range models {
+		inputs = append(inputs, convertMetaobjectFieldDefinitionModelToCreateInput(model))
 	}
+	return inputs
 }
 
 func convertMetaobjectFieldDefinitionModelToCreateInput(model *MetaobjectFieldDefinitionModel) *shopify.MetaobjectFieldDefinitionCreateInput {
 	return &shopify.MetaobjectFieldDefinitionCreateInput{
-		Key:         model.Key.ValueString(),
+		Key:         model.shopifyKey(),
 		Name:        model.Name.ValueStringPointer(),
 		Description: model.Description.ValueStringPointer(),
 		Type:        model.Type.ValueString(),
 		Required:    model.Required.ValueBool(),
-		Validations: convertValidationModelsToValidations(model.Validations),
+		Validations: convert.ValidationModelsToValidations(model.Validations),
+	}
+}
+
+// metaobjectFieldDefinitionsEqual returns whether the field definitions are the same regardless of the order of their validations,
+// which Shopify doesn't keep, so that reordering the validations doesn't update the field.
+func metaobjectFieldDefinitionsEqual(a, b *MetaobjectFieldDefinitionModel) bool {
+	sorted := func(fieldDef *MetaobjectFieldDefinitionModel) *MetaobjectFieldDefinitionModel {
+		clone := *fieldDef
+		clone.Validations = slices.Clone(fieldDef.Validations)
+		sort.SliceStable(clone.Validations, func(i, j int) bool {
+			return clone.Validations[i].Name.ValueString() < clone.Validations[j].Name.ValueString()
+		})
+		return &clone
+	}
+	return reflect.DeepEqual(sorted(a), sorted(b))
+}
+
+// findOldMetaobjectFieldDefinitionKey finds the field definition in the state that corresponds to the planned one,
+// either by its key or by its previous key when the key has been changed, or by its key in Shopify when the key has been changed back.
+func findOldMetaobjectFieldDefinitionKey(oldFieldDefinitionMap map[string]*MetaobjectFieldDefinitionModel, newFieldDef *MetaobjectFieldDefinitionModel) (string, bool) {
+	if _, ok := oldFieldDefinitionMap[newFieldDef.Key.ValueString()]; ok {
+		return newFieldDef.Key.ValueString(), true
+	}
+	for key, oldFieldDef := range oldFieldDefinitionMap {
+		if oldFieldDef.shopifyKey() == newFieldDef.shopifyKey() {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// convertDisplayNameKeyToShopifyKey returns the key in Shopify of the field referenced by display_name_key.
+func convertDisplayNameKeyToShopifyKey(data *MetaobjectDefinitionResourceModel) *string {
+	return convertFieldKeyToShopifyKey(data.DisplayNameKey, data)
+}
+
+// convertDisplayNameKeyToModel converts the display name key in Shopify back to the key used in the configuration.
+func convertDisplayNameKeyToModel(displayNameKey *string, data *MetaobjectDefinitionResourceModel) types.String {
+	return convertShopifyKeyToFieldKey(displayNameKey, data)
+}
+
+// convertFieldKeyToShopifyKey converts the key of a field definition used in the configuration to the key in Shopify.
+func convertFieldKeyToShopifyKey(key types.String, data *MetaobjectDefinitionResourceModel) *string {
+	if key.ValueString() == "" {
+		return nil
+	}
+	if fieldDefinition, ok := xslice.FindBy(data.FieldDefinitions, func(v *MetaobjectFieldDefinitionModel) bool {
+		return v.Key.Equal(key)
+	}); ok {
+		return utils.Ptr(fieldDefinition.shopifyKey())
+	}
+	return key.ValueStringPointer()
+}
+
+// convertShopifyKeyToFieldKey converts the key of a field definition in Shopify back to the key used in the configuration.
+func convertShopifyKeyToFieldKey(key *string, data *MetaobjectDefinitionResourceModel) types.String {
+	if key == nil {
+		return types.StringNull()
+	}
+	if fieldDefinition, ok := xslice.FindBy(data.FieldDefinitions, func(v *MetaobjectFieldDefinitionModel) bool {
+		return v.shopifyKey() == *key
+	}); ok {
+		return fieldDefinition.Key
 	}
+	return types.StringPointerValue(key)
 }
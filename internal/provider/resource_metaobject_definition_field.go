@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/provider/convert"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/pkg/xslice"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetaobjectDefinitionFieldResource{}
+var _ resource.ResourceWithImportState = &MetaobjectDefinitionFieldResource{}
+
+// MetaobjectDefinitionFieldResource defines the resource implementation.
+type MetaobjectDefinitionFieldResource struct {
+	client *shopify.Client
+}
+
+func NewMetaobjectDefinitionFieldResource() resource.Resource {
+	return &MetaobjectDefinitionFieldResource{}
+}
+
+// MetaobjectDefinitionFieldResourceModel describes the resource data model.
+type MetaobjectDefinitionFieldResourceModel struct {
+	ID             types.String                          `tfsdk:"id"`
+	MetaobjectType types.String                          `tfsdk:"metaobject_type"`
+	DefinitionID   types.String                          `tfsdk:"definition_id"`
+	Key            types.String                          `tfsdk:"key"`
+	Name           types.String                          `tfsdk:"name"`
+	Description    types.String                          `tfsdk:"description"`
+	Type           types.String                          `tfsdk:"type"`
+	Category       types.String                          `tfsdk:"category"`
+	Required       types.Bool                            `tfsdk:"required"`
+	Validations    []*MetafieldDefinitionValidationModel `tfsdk:"validations"`
+}
+
+func (r *MetaobjectDefinitionFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metaobject_definition_field"
+}
+
+func (r *MetaobjectDefinitionFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single field of a metaobject definition, leaving the other fields of the definition untouched. " +
+			"Useful to let several teams own the fields of a large definition. " +
+			"Don't manage the same definition with the `field_definitions` of `shopify_metaobject_definition` as well, " +
+			"since that resource removes the fields it doesn't know.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the field, in the format `<metaobject_type>:<key>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metaobject_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the metaobject definition the field belongs to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"definition_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the metaobject definition.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the field. Changing it deletes the field and its data and creates a new one.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A human-readable name for the field. This can be changed at any time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An administrative description of the field.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The metafield type applied to values of the field. Changing it deletes the field and its data and creates a new one.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "The category of the type of the field, e.g. `TEXT` or `REFERENCE`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Whether metaobjects require a saved value for the field.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"validations": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom validations that apply to values assigned to the field. Refer to the list of [supported validations](https://shopify.dev/docs/apps/build/custom-data/metafields/definitions/list-of-validation-options).",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name for the metafield definition validation.",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value for the metafield definition validation. The metaobject definitions of the `metaobject_definition_id` and `metaobject_definition_ids` validations can be referred to by type instead of GID.",
+							Required:            true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *MetaobjectDefinitionFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *MetaobjectDefinitionFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetaobjectDefinitionFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := r.client.GetMetaobjectDefinitionByType(ctx, data.MetaobjectType.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metaobject definition", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metaobject_type"), "Metaobject definition not found",
+			fmt.Sprintf("No metaobject definition has the type %q.", data.MetaobjectType.ValueString()))
+		return
+	}
+	if _, ok := findMetaobjectFieldDefinition(definition, data.Key.ValueString()); ok {
+		resp.Diagnostics.AddAttributeError(path.Root("key"), "Field already exists",
+			fmt.Sprintf("The metaobject definition %q already has the field %q. Import it to manage it with this resource.", data.MetaobjectType.ValueString(), data.Key.ValueString()))
+		return
+	}
+
+	// Shopify sets the name when it isn't configured
+	var name *string
+	if !data.Name.IsUnknown() {
+		name = data.Name.ValueStringPointer()
+	}
+	updatedDefinition, err := r.client.UpdateMetaobjectFieldDefinitions(ctx, definition.ID, []*shopify.MetaobjectFieldDefinitionOperationInput{
+		{
+			Create: &shopify.MetaobjectFieldDefinitionCreateInput{
+				Key:         data.Key.ValueString(),
+				Name:        name,
+				Description: data.Description.ValueStringPointer(),
+				Type:        data.Type.ValueString(),
+				Required:    data.Required.ValueBool(),
+				Validations: convert.ValidationModelsToValidations(data.Validations),
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create metaobject definition field", err))
+		return
+	}
+
+	keepMetaobjectFieldDefinitionReferences(ctx, r.client, updatedDefinition, []*MetaobjectFieldDefinitionModel{{Key: data.Key, Validations: data.Validations}})
+	createdData, diags := convertMetaobjectDefinitionToFieldResourceModel(updatedDefinition, data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created a metaobject definition field", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *MetaobjectDefinitionFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetaobjectDefinitionFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := r.client.GetMetaobjectDefinitionByType(ctx, data.MetaobjectType.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metaobject definition", err))
+		return
+	}
+	if definition == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if _, ok := findMetaobjectFieldDefinition(definition, data.Key.ValueString()); !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	keepMetaobjectFieldDefinitionReferences(ctx, r.client, definition, []*MetaobjectFieldDefinitionModel{{Key: data.Key, Validations: data.Validations}})
+	newData, diags := convertMetaobjectDefinitionToFieldResourceModel(definition, data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newData)...)
+}
+
+func (r *MetaobjectDefinitionFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MetaobjectDefinitionFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedDefinition, err := r.client.UpdateMetaobjectFieldDefinitions(ctx, data.DefinitionID.ValueString(), []*shopify.MetaobjectFieldDefinitionOperationInput{
+		{
+			Update: &shopify.MetaobjectFieldDefinitionUpdateInput{
+				Key:         data.Key.ValueString(),
+				Name:        data.Name.ValueStringPointer(),
+				Description: data.Description.ValueStringPointer(),
+				Required:    data.Required.ValueBool(),
+				Validations: convert.ValidationModelsToValidations(data.Validations),
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update metaobject definition field", err))
+		return
+	}
+
+	keepMetaobjectFieldDefinitionReferences(ctx, r.client, updatedDefinition, []*MetaobjectFieldDefinitionModel{{Key: data.Key, Validations: data.Validations}})
+	updatedData, diags := convertMetaobjectDefinitionToFieldResourceModel(updatedDefinition, data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
+}
+
+func (r *MetaobjectDefinitionFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetaobjectDefinitionFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateMetaobjectFieldDefinitions(ctx, data.DefinitionID.ValueString(), []*shopify.MetaobjectFieldDefinitionOperationInput{
+		{
+			Delete: &shopify.MetaobjectFieldDefinitionDeleteInput{
+				Key: data.Key.ValueString(),
+			},
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete metaobject definition field", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a metaobject definition field", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *MetaobjectDefinitionFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The type of an app-owned definition contains a colon itself, e.g. $app:author, while the key can't
+	i := strings.LastIndex(req.ID, ":")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: metaobject_type:key. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metaobject_type"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), req.ID[i+1:])...)
+}
+
+func findMetaobjectFieldDefinition(definition *shopify.MetaobjectDefinition, key string) (*shopify.MetaobjectFieldDefinition, bool) {
+	return xslice.FindBy(definition.FieldDefinitions, func(v *shopify.MetaobjectFieldDefinition) bool {
+		return v.Key == key
+	})
+}
+
+func convertMetaobjectDefinitionToFieldResourceModel(definition *shopify.MetaobjectDefinition, data MetaobjectDefinitionFieldResourceModel) (*MetaobjectDefinitionFieldResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fieldDefinition, ok := findMetaobjectFieldDefinition(definition, data.Key.ValueString())
+	if !ok {
+		diags.Append(diagFromClientError("Unable to read metaobject definition field", fmt.Errorf("the metaobject definition %q has no field %q", definition.Type, data.Key.ValueString())))
+		return nil, diags
+	}
+	field := convertMetaobjectFieldDefinitionToModel(fieldDefinition, &MetaobjectFieldDefinitionModel{
+		Key:         data.Key,
+		PreviousKey: types.StringNull(),
+		Description: data.Description,
+		Validations: data.Validations,
+	})
+	return &MetaobjectDefinitionFieldResourceModel{
+		ID:             types.StringValue(definition.Type + ":" + fieldDefinition.Key),
+		MetaobjectType: types.StringValue(definition.Type),
+		DefinitionID:   types.StringValue(definition.ID),
+		Key:            field.Key,
+		Name:           field.Name,
+		Description:    field.Description,
+		Type:           field.Type,
+		Category:       field.Category,
+		Required:       field.Required,
+		Validations:    field.Validations,
+	}, diags
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetaobjectDefinitionFieldResource(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the fields are added to the same definition concurrently
+			{
+				Config: testAccMetaobjectDefinitionFieldResourceConfig(metaobjectType, "Biography"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition_field.bio", "id", metaobjectType+":bio"),
+					resource.TestCheckResourceAttrPair("shopify_metaobject_definition_field.bio", "definition_id", "shopify_metaobject_definition.author", "id"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition_field.bio", "name", "Biography"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition_field.bio", "required", "false"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition_field.bio", "category", "TEXT"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition_field.website", "validations.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_metaobject_definition_field.bio",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetaobjectDefinitionFieldResourceConfig(metaobjectType, "Bio"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition_field.bio", "name", "Bio"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionFieldResourceConfig(metaobjectType, bioName string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "author" {
+  name = "Author"
+  type = %[1]q
+  field_definitions = [
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    }
+  ]
+
+  lifecycle {
+    ignore_changes = [field_definitions]
+  }
+}
+
+resource "shopify_metaobject_definition_field" "bio" {
+  metaobject_type = shopify_metaobject_definition.author.type
+  key             = "bio"
+  name            = %[2]q
+  type            = "multi_line_text_field"
+}
+
+resource "shopify_metaobject_definition_field" "website" {
+  metaobject_type = shopify_metaobject_definition.author.type
+  key             = "website"
+  name            = "Website"
+  type            = "url"
+  validations = [
+    {
+      name  = "allowed_domains"
+      value = "[\"example.com\"]"
+    }
+  ]
+}
+`, metaobjectType, bioName)
+}
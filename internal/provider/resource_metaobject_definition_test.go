@@ -1,10 +1,24 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
 )
 
 func TestAccMetaobjectDefinitionResource(t *testing.T) {
@@ -23,6 +37,7 @@ func TestAccMetaobjectDefinitionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.key", "name"),
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.name", "Name"),
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.type", "single_line_text_field"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.category", "TEXT"),
 					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.required", "true"),
 				),
 			},
@@ -31,6 +46,12 @@ func TestAccMetaobjectDefinitionResource(t *testing.T) {
 				ResourceName: "shopify_metaobject_definition.author",
 				ImportState:  true,
 			},
+			// ImportState by type testing
+			{
+				ResourceName:  "shopify_metaobject_definition.author",
+				ImportState:   true,
+				ImportStateId: metaobjectType,
+			},
 			//// Update and Read testing
 			{
 				Config: testAccMetaobjectDefinitionResourceUpdateConfig(metaobjectType),
@@ -105,3 +126,1180 @@ resource "shopify_metaobject_definition" "author" {
 }
 `, metaobjectType)
 }
+
+func TestAccMetaobjectDefinitionResource_emptyFieldDefinitions(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the definition without fields
+			{
+				Config: testAccMetaobjectDefinitionResourceStagedConfig(metaobjectType, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.staged", "field_definitions.#", "0"),
+				),
+			},
+			// Add the fields in a later stage
+			{
+				Config: testAccMetaobjectDefinitionResourceStagedConfig(metaobjectType, `
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.staged", "field_definitions.#", "1"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.staged", "field_definitions.0.key", "name"),
+				),
+			},
+			// Remove them again
+			{
+				Config: testAccMetaobjectDefinitionResourceStagedConfig(metaobjectType, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.staged", "field_definitions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionResourceStagedConfig(metaobjectType, fieldDefinitions string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "staged" {
+  name              = "Staged"
+  type              = %[1]q
+  field_definitions = [%[2]s]
+}
+`, metaobjectType, fieldDefinitions)
+}
+
+func TestConvertMetaobjectFieldDefinitionModelsToCreateInputs_empty(t *testing.T) {
+	inputs := convertMetaobjectFieldDefinitionModelsToCreateInputs([]*MetaobjectFieldDefinitionModel{})
+	b, err := json.Marshal(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The field definitions are required on creation, so no fields must be sent as an empty list, not null
+	if string(b) != "[]" {
+		t.Errorf("expected an empty list, got %s", b)
+	}
+}
+
+func TestAccMetaobjectDefinitionResource_invalidDisplayNameKey(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetaobjectDefinitionResourceInvalidDisplayNameKeyConfig(metaobjectType),
+				ExpectError: regexp.MustCompile(`Invalid display_name_key`),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionResourceInvalidDisplayNameKeyConfig(metaobjectType string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "author" {
+  name             = "Author"
+  type             = %[1]q
+  display_name_key = "full_name"
+  field_definitions = [
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    }
+  ]
+}
+`, metaobjectType)
+}
+
+func TestAccMetaobjectDefinitionResource_removeDisplayNameField(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourceRemoveDisplayNameFieldConfig(metaobjectType, `display_name_key = "name"`, `
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    },`),
+				Check: resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "display_name_key", "name"),
+			},
+			// Removing the field along with display_name_key leaves the display name key in Shopify dangling
+			{
+				Config:      testAccMetaobjectDefinitionResourceRemoveDisplayNameFieldConfig(metaobjectType, "", ""),
+				ExpectError: regexp.MustCompile(`Field referenced by display_name_key is removed`),
+			},
+			// Removing the field is fine once display_name_key refers to another field
+			{
+				Config: testAccMetaobjectDefinitionResourceRemoveDisplayNameFieldConfig(metaobjectType, `display_name_key = "bio"`, ""),
+				Check:  resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "display_name_key", "bio"),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionResourceRemoveDisplayNameFieldConfig(metaobjectType, displayNameKey, nameField string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "author" {
+  name = "Author"
+  type = %[1]q
+  %[2]s
+  field_definitions = [%[3]s
+    {
+      key  = "bio"
+      name = "Bio"
+      type = "multi_line_text_field"
+    }
+  ]
+}
+`, metaobjectType, displayNameKey, nameField)
+}
+
+func TestRemovedDisplayNameFieldKey(t *testing.T) {
+	state := &MetaobjectDefinitionResourceModel{
+		DisplayNameKey: types.StringValue("name"),
+		FieldDefinitions: []*MetaobjectFieldDefinitionModel{
+			{Key: types.StringValue("name"), PreviousKey: types.StringNull()},
+			{Key: types.StringValue("bio"), PreviousKey: types.StringNull()},
+		},
+	}
+	bio := &MetaobjectFieldDefinitionModel{Key: types.StringValue("bio"), PreviousKey: types.StringNull()}
+	tests := []struct {
+		name             string
+		displayNameKey   types.String
+		fieldDefinitions []*MetaobjectFieldDefinitionModel
+		want             bool
+	}{
+		{
+			name:             "field kept",
+			displayNameKey:   types.StringValue("name"),
+			fieldDefinitions: state.FieldDefinitions,
+			want:             false,
+		},
+		{
+			name:             "field removed along with display_name_key",
+			displayNameKey:   types.StringNull(),
+			fieldDefinitions: []*MetaobjectFieldDefinitionModel{bio},
+			want:             true,
+		},
+		{
+			name:             "display_name_key changed in the same plan",
+			displayNameKey:   types.StringValue("bio"),
+			fieldDefinitions: []*MetaobjectFieldDefinitionModel{bio},
+			want:             false,
+		},
+		{
+			name:           "field renamed",
+			displayNameKey: types.StringNull(),
+			fieldDefinitions: []*MetaobjectFieldDefinitionModel{
+				{Key: types.StringValue("full_name"), PreviousKey: types.StringValue("name")},
+				bio,
+			},
+			want: false,
+		},
+		{
+			name:             "unknown display_name_key",
+			displayNameKey:   types.StringUnknown(),
+			fieldDefinitions: []*MetaobjectFieldDefinitionModel{bio},
+			want:             false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &MetaobjectDefinitionResourceModel{DisplayNameKey: tt.displayNameKey, FieldDefinitions: tt.fieldDefinitions}
+			key, got := removedDisplayNameFieldKey(plan, state)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if got && key != "name" {
+				t.Errorf("unexpected key: %q", key)
+			}
+		})
+	}
+}
+
+func TestAccMetaobjectDefinitionResource_duplicateFieldKeys(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetaobjectDefinitionResourceDuplicateFieldKeysConfig(metaobjectType),
+				ExpectError: regexp.MustCompile(`Duplicate field definition key`),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionResourceDuplicateFieldKeysConfig(metaobjectType string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "author" {
+  name = "Author"
+  type = %[1]q
+  field_definitions = [
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    },
+    {
+      key  = "name"
+      name = "Full name"
+      type = "single_line_text_field"
+    }
+  ]
+}
+`, metaobjectType)
+}
+
+func TestValidateMetaobjectFieldDefinitionKeys(t *testing.T) {
+	diags := validateMetaobjectFieldDefinitionKeys([]*MetaobjectFieldDefinitionModel{
+		{Key: types.StringValue("name")},
+		{Key: types.StringValue("bio")},
+		{Key: types.StringUnknown()},
+		{Key: types.StringUnknown()},
+		{Key: types.StringValue("name")},
+	})
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %v", diags)
+	}
+	withPath, ok := diags[0].(interface{ Path() path.Path })
+	if !ok || !withPath.Path().Equal(path.Root("field_definitions").AtListIndex(4).AtName("key")) {
+		t.Errorf("unexpected diagnostic: %v", diags[0])
+	}
+
+	if diags := validateMetaobjectFieldDefinitionKeys([]*MetaobjectFieldDefinitionModel{
+		{Key: types.StringValue("name")},
+		{Key: types.StringValue("bio")},
+	}); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestAccMetaobjectDefinitionResource_unsetValidations(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourceValidationsConfig(metaobjectType, `
+      validations = [
+        {
+          name  = "max"
+          value = "5"
+        }
+      ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.validations.#", "1"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.validations.0.name", "max"),
+				),
+			},
+			{
+				Config: testAccMetaobjectDefinitionResourceValidationsConfig(metaobjectType, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.validations"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaobjectDefinitionResource_reorderValidations(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourceValidationsConfig(metaobjectType, `
+      validations = [
+        {
+          name  = "min"
+          value = "1"
+        },
+        {
+          name  = "max"
+          value = "5"
+        }
+      ]`),
+			},
+			// Reordering the validations leaves the field as it is, and the order read back is the configured one
+			{
+				Config: testAccMetaobjectDefinitionResourceValidationsConfig(metaobjectType, `
+      validations = [
+        {
+          name  = "max"
+          value = "5"
+        },
+        {
+          name  = "min"
+          value = "1"
+        }
+      ]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.validations.0.name", "max"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.validations.1.name", "min"),
+				),
+			},
+		},
+	})
+}
+
+func TestMetaobjectFieldDefinitionsEqual(t *testing.T) {
+	fieldDef := func(validations ...string) *MetaobjectFieldDefinitionModel {
+		models := make([]*MetafieldDefinitionValidationModel, 0, len(validations))
+		for _, name := range validations {
+			models = append(models, &MetafieldDefinitionValidationModel{Name: types.StringValue(name), Value: types.StringValue("1")})
+		}
+		return &MetaobjectFieldDefinitionModel{Key: types.StringValue("rating"), Type: types.StringValue("number_integer"), Validations: models}
+	}
+
+	if !metaobjectFieldDefinitionsEqual(fieldDef("min", "max"), fieldDef("max", "min")) {
+		t.Error("expected the field definitions with reordered validations to be equal")
+	}
+	if metaobjectFieldDefinitionsEqual(fieldDef("min", "max"), fieldDef("min")) {
+		t.Error("expected the field definitions with different validations not to be equal")
+	}
+	// The comparison must not reorder the validations of the models
+	a := fieldDef("min", "max")
+	metaobjectFieldDefinitionsEqual(a, fieldDef("max", "min"))
+	if a.Validations[0].Name.ValueString() != "min" {
+		t.Error("expected the validations to keep their order")
+	}
+}
+
+func testAccMetaobjectDefinitionResourceValidationsConfig(metaobjectType, validations string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "review" {
+  name = "Review"
+  type = %[1]q
+  field_definitions = [
+    {
+      key  = "rating"
+      name = "Rating"
+      type = "number_integer"%[2]s
+    }
+  ]
+}
+`, metaobjectType, validations)
+}
+
+func TestAccMetaobjectDefinitionResource_recreateFieldWithoutDescription(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourceFieldTypeConfig(metaobjectType, "single_line_text_field"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.description"),
+				),
+			},
+			// Changing the type recreates the field, which must keep its description null not to produce a diff
+			{
+				Config: testAccMetaobjectDefinitionResourceFieldTypeConfig(metaobjectType, "multi_line_text_field"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.type", "multi_line_text_field"),
+					resource.TestCheckNoResourceAttr("shopify_metaobject_definition.review", "field_definitions.0.description"),
+				),
+			},
+			{
+				Config: testAccMetaobjectDefinitionResourceFieldTypeConfig(metaobjectType, "multi_line_text_field"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccMetaobjectDefinitionResourceFieldTypeConfig(metaobjectType, fieldType string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "review" {
+  name = "Review"
+  type = %[1]q
+  field_definitions = [
+    {
+      key  = "body"
+      name = "Body"
+      type = %[2]q
+    }
+  ]
+}
+`, metaobjectType, fieldType)
+}
+
+func TestConvertMetaobjectFieldDefinitionToModel_description(t *testing.T) {
+	definition := &shopify.MetaobjectFieldDefinition{Key: "body", Name: "Body", Type: &shopify.MetafieldDefinitionType{Name: "multi_line_text_field"}}
+
+	tests := []struct {
+		name  string
+		model *MetaobjectFieldDefinitionModel
+		want  types.String
+	}{
+		{name: "no model", model: nil, want: types.StringNull()},
+		{name: "unset", model: &MetaobjectFieldDefinitionModel{Key: types.StringValue("body"), Description: types.StringNull()}, want: types.StringNull()},
+		{name: "empty", model: &MetaobjectFieldDefinitionModel{Key: types.StringValue("body"), Description: types.StringValue("")}, want: types.StringValue("")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertMetaobjectFieldDefinitionToModel(definition, tt.model).Description; !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAccMetaobjectDefinitionResource_capabilities(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, `
+  capabilities = {
+    translatable = true
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.translatable", "true"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.publishable", "false"),
+				),
+			},
+			{
+				Config: testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, `
+  capabilities = {
+    translatable = true
+    renderable = {
+      meta_title_key = "title"
+    }
+  }`),
+				ExpectError: regexp.MustCompile("Invalid meta_title_key"),
+			},
+			{
+				Config: testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, `
+  capabilities = {
+    translatable = true
+    renderable = {
+      meta_title_key = "name"
+    }
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.renderable.meta_title_key", "name"),
+					resource.TestCheckNoResourceAttr("shopify_metaobject_definition.author", "capabilities.renderable.meta_description_key"),
+				),
+			},
+			// Removing the capability disables it
+			{
+				Config: testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.translatable", "false"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.publishable", "false"),
+					resource.TestCheckNoResourceAttr("shopify_metaobject_definition.author", "capabilities.renderable.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaobjectDefinitionResource_onlineStore(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	urlHandle := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, fmt.Sprintf(`
+  capabilities = {
+    online_store = {
+      url_handle = %q
+    }
+  }`, urlHandle)),
+				ExpectError: regexp.MustCompile("Missing renderable capability"),
+			},
+			{
+				Config: testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, fmt.Sprintf(`
+  capabilities = {
+    renderable = {
+      meta_title_key = "name"
+    }
+    online_store = {
+      url_handle = %q
+    }
+  }`, urlHandle)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.online_store.url_handle", urlHandle),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "capabilities.online_store.can_create_redirects", "false"),
+				),
+			},
+			// Removing the capability disables it
+			{
+				Config: testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, `
+  capabilities = {
+    renderable = {
+      meta_title_key = "name"
+    }
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("shopify_metaobject_definition.author", "capabilities.online_store.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestConvertMetaobjectCapabilitiesToUpdateInput(t *testing.T) {
+	ctx := context.Background()
+	capabilities := func(publishable, translatable bool) types.Object {
+		return types.ObjectValueMust(metaobjectDefinitionCapabilitiesAttrTypes, map[string]attr.Value{
+			"publishable":  types.BoolValue(publishable),
+			"translatable": types.BoolValue(translatable),
+			"renderable":   types.ObjectNull(metaobjectDefinitionRenderableAttrTypes),
+			"online_store": types.ObjectNull(metaobjectDefinitionOnlineStoreAttrTypes),
+		})
+	}
+	data := &MetaobjectDefinitionResourceModel{}
+
+	// Enabling translatable
+	input, diags := convertMetaobjectCapabilitiesToUpdateInput(ctx, capabilities(false, false), capabilities(false, true), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input == nil || input.Translatable == nil || !input.Translatable.Enabled {
+		t.Fatalf("expected translatable to be enabled, got %+v", input)
+	}
+	if input.Publishable != nil {
+		t.Errorf("expected publishable not to be sent, got %+v", input.Publishable)
+	}
+
+	// Disabling translatable sends an explicit disable
+	input, diags = convertMetaobjectCapabilitiesToUpdateInput(ctx, capabilities(false, true), capabilities(false, false), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input == nil || input.Translatable == nil || input.Translatable.Enabled {
+		t.Fatalf("expected translatable to be disabled, got %+v", input)
+	}
+
+	// Nothing to send when no capability is or was enabled
+	input, diags = convertMetaobjectCapabilitiesToUpdateInput(ctx, capabilities(false, false), capabilities(false, false), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input != nil {
+		t.Errorf("expected no capabilities input, got %+v", input)
+	}
+}
+
+func TestConvertMetaobjectCapabilitiesToUpdateInput_renderable(t *testing.T) {
+	ctx := context.Background()
+	noCapabilities := types.ObjectValueMust(metaobjectDefinitionCapabilitiesAttrTypes, map[string]attr.Value{
+		"publishable":  types.BoolValue(false),
+		"translatable": types.BoolValue(false),
+		"renderable":   types.ObjectNull(metaobjectDefinitionRenderableAttrTypes),
+		"online_store": types.ObjectNull(metaobjectDefinitionOnlineStoreAttrTypes),
+	})
+	renderable := types.ObjectValueMust(metaobjectDefinitionCapabilitiesAttrTypes, map[string]attr.Value{
+		"publishable":  types.BoolValue(false),
+		"translatable": types.BoolValue(false),
+		"renderable": types.ObjectValueMust(metaobjectDefinitionRenderableAttrTypes, map[string]attr.Value{
+			"meta_title_key":       types.StringValue("title"),
+			"meta_description_key": types.StringNull(),
+		}),
+		"online_store": types.ObjectNull(metaobjectDefinitionOnlineStoreAttrTypes),
+	})
+	// The field is known as "name" in Shopify
+	data := &MetaobjectDefinitionResourceModel{
+		FieldDefinitions: []*MetaobjectFieldDefinitionModel{
+			{Key: types.StringValue("title"), PreviousKey: types.StringValue("name")},
+		},
+	}
+
+	input, diags := convertMetaobjectCapabilitiesToUpdateInput(ctx, noCapabilities, renderable, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input == nil || input.Renderable == nil || !input.Renderable.Enabled {
+		t.Fatalf("expected renderable to be enabled, got %+v", input)
+	}
+	if key := input.Renderable.Data.MetaTitleKey; key == nil || *key != "name" {
+		t.Errorf("expected the meta title key to be the key in Shopify, got %v", key)
+	}
+	if input.Renderable.Data.MetaDescriptionKey != nil {
+		t.Errorf("expected no meta description key, got %v", *input.Renderable.Data.MetaDescriptionKey)
+	}
+
+	// Unchanged, it isn't sent
+	input, diags = convertMetaobjectCapabilitiesToUpdateInput(ctx, renderable, renderable, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input != nil {
+		t.Errorf("expected no capabilities input, got %+v", input)
+	}
+
+	input, diags = convertMetaobjectCapabilitiesToUpdateInput(ctx, renderable, noCapabilities, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input == nil || input.Renderable == nil || input.Renderable.Enabled {
+		t.Fatalf("expected renderable to be disabled, got %+v", input)
+	}
+}
+
+func TestConvertMetaobjectCapabilitiesToUpdateInput_onlineStore(t *testing.T) {
+	ctx := context.Background()
+	capabilities := func(urlHandle string) types.Object {
+		onlineStore := types.ObjectNull(metaobjectDefinitionOnlineStoreAttrTypes)
+		if urlHandle != "" {
+			onlineStore = types.ObjectValueMust(metaobjectDefinitionOnlineStoreAttrTypes, map[string]attr.Value{
+				"url_handle":           types.StringValue(urlHandle),
+				"can_create_redirects": types.BoolValue(true),
+			})
+		}
+		return types.ObjectValueMust(metaobjectDefinitionCapabilitiesAttrTypes, map[string]attr.Value{
+			"publishable":  types.BoolValue(false),
+			"translatable": types.BoolValue(false),
+			"renderable":   types.ObjectNull(metaobjectDefinitionRenderableAttrTypes),
+			"online_store": onlineStore,
+		})
+	}
+	data := &MetaobjectDefinitionResourceModel{}
+
+	input, diags := convertMetaobjectCapabilitiesToUpdateInput(ctx, capabilities(""), capabilities("authors"), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input == nil || input.OnlineStore == nil || !input.OnlineStore.Enabled {
+		t.Fatalf("expected online store to be enabled, got %+v", input)
+	}
+	if input.OnlineStore.Data.URLHandle != "authors" || input.OnlineStore.Data.CreateRedirects == nil || !*input.OnlineStore.Data.CreateRedirects {
+		t.Errorf("unexpected online store data: %+v", input.OnlineStore.Data)
+	}
+
+	// Unchanged, it isn't sent
+	input, diags = convertMetaobjectCapabilitiesToUpdateInput(ctx, capabilities("authors"), capabilities("authors"), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input != nil {
+		t.Errorf("expected no capabilities input, got %+v", input)
+	}
+
+	input, diags = convertMetaobjectCapabilitiesToUpdateInput(ctx, capabilities("authors"), capabilities(""), data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input == nil || input.OnlineStore == nil || input.OnlineStore.Enabled {
+		t.Fatalf("expected online store to be disabled, got %+v", input)
+	}
+}
+
+func testAccMetaobjectDefinitionResourceCapabilitiesConfig(metaobjectType, capabilities string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "author" {
+  name = "Author"
+  type = %[1]q
+  field_definitions = [
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    }
+  ]
+%[2]s
+}
+`, metaobjectType, capabilities)
+}
+
+func TestAccMetaobjectDefinitionResource_previousKey(t *testing.T) {
+	metaobjectType := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetaobjectDefinitionResourcePreviousKeyConfig(metaobjectType, "bio", `
+      key  = "bio"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.key", "bio"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "display_name_key", "bio"),
+				),
+			},
+			// Changing the key with previous_key keeps the field
+			{
+				Config: testAccMetaobjectDefinitionResourcePreviousKeyConfig(metaobjectType, "biography", `
+      key          = "biography"
+      previous_key = "bio"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("shopify_metaobject_definition.author", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.key", "biography"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "field_definitions.0.previous_key", "bio"),
+					resource.TestCheckResourceAttr("shopify_metaobject_definition.author", "display_name_key", "biography"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindOldMetaobjectFieldDefinitionKey(t *testing.T) {
+	oldFieldDefinitionMap := map[string]*MetaobjectFieldDefinitionModel{
+		"bio":  {Key: types.StringValue("bio"), PreviousKey: types.StringNull()},
+		"name": {Key: types.StringValue("name"), PreviousKey: types.StringValue("full_name")},
+	}
+	tests := []struct {
+		name        string
+		key         string
+		previousKey types.String
+		want        string
+		wantOK      bool
+	}{
+		{name: "same key", key: "bio", previousKey: types.StringNull(), want: "bio", wantOK: true},
+		{name: "renamed key", key: "biography", previousKey: types.StringValue("bio"), want: "bio", wantOK: true},
+		{name: "renamed key of a renamed field", key: "display_name", previousKey: types.StringValue("full_name"), want: "name", wantOK: true},
+		{name: "key changed back", key: "full_name", previousKey: types.StringNull(), want: "name", wantOK: true},
+		{name: "new key", key: "age", previousKey: types.StringNull(), wantOK: false},
+		{name: "unknown previous key", key: "age", previousKey: types.StringValue("years"), wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := findOldMetaobjectFieldDefinitionKey(oldFieldDefinitionMap, &MetaobjectFieldDefinitionModel{
+				Key:         types.StringValue(tt.key),
+				PreviousKey: tt.previousKey,
+			})
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("got (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestValidatePreviousKeys(t *testing.T) {
+	fieldDef := func(key string, previousKey types.String, fieldType string) *MetaobjectFieldDefinitionModel {
+		return &MetaobjectFieldDefinitionModel{Key: types.StringValue(key), PreviousKey: previousKey, Type: types.StringValue(fieldType)}
+	}
+	state := &MetaobjectDefinitionResourceModel{
+		FieldDefinitions: []*MetaobjectFieldDefinitionModel{
+			fieldDef("biography", types.StringValue("bio"), "single_line_text_field"),
+			fieldDef("age", types.StringNull(), "number_integer"),
+		},
+	}
+	tests := []struct {
+		name    string
+		plan    *MetaobjectFieldDefinitionModel
+		wantErr bool
+	}{
+		{name: "previous key kept", plan: fieldDef("biography", types.StringValue("bio"), "single_line_text_field")},
+		{name: "previous key removed", plan: fieldDef("biography", types.StringNull(), "single_line_text_field"), wantErr: true},
+		{name: "previous key changed", plan: fieldDef("biography", types.StringValue("about"), "single_line_text_field"), wantErr: true},
+		{name: "previous key removed along with the type", plan: fieldDef("biography", types.StringNull(), "multi_line_text_field")},
+		{name: "key changed back", plan: fieldDef("bio", types.StringNull(), "single_line_text_field")},
+		{name: "previous key added without changing the key", plan: fieldDef("age", types.StringValue("years"), "number_integer"), wantErr: true},
+		{name: "unknown previous key", plan: fieldDef("biography", types.StringUnknown(), "single_line_text_field")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &MetaobjectDefinitionResourceModel{FieldDefinitions: []*MetaobjectFieldDefinitionModel{tt.plan}}
+			if diags := validatePreviousKeys(plan, state); diags.HasError() != tt.wantErr {
+				t.Errorf("got %v, want an error: %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeepFieldDefinitionCategories(t *testing.T) {
+	state := &MetaobjectDefinitionResourceModel{
+		FieldDefinitions: []*MetaobjectFieldDefinitionModel{
+			{Key: types.StringValue("bio"), PreviousKey: types.StringNull(), Type: types.StringValue("single_line_text_field"), Category: types.StringValue("TEXT")},
+			{Key: types.StringValue("age"), PreviousKey: types.StringNull(), Type: types.StringValue("single_line_text_field"), Category: types.StringValue("TEXT")},
+		},
+	}
+	plan := &MetaobjectDefinitionResourceModel{
+		FieldDefinitions: []*MetaobjectFieldDefinitionModel{
+			{Key: types.StringValue("biography"), PreviousKey: types.StringValue("bio"), Type: types.StringValue("single_line_text_field"), Category: types.StringUnknown()},
+			{Key: types.StringValue("age"), PreviousKey: types.StringNull(), Type: types.StringValue("number_integer"), Category: types.StringUnknown()},
+			{Key: types.StringValue("website"), PreviousKey: types.StringNull(), Type: types.StringValue("url"), Category: types.StringUnknown()},
+		},
+	}
+
+	if !keepFieldDefinitionCategories(plan, state) {
+		t.Fatal("got no category set, want one")
+	}
+	want := []types.String{types.StringValue("TEXT"), types.StringUnknown(), types.StringUnknown()}
+	for i, fieldDefinition := range plan.FieldDefinitions {
+		if !fieldDefinition.Category.Equal(want[i]) {
+			t.Errorf("field %s: got %s, want %s", fieldDefinition.Key, fieldDefinition.Category, want[i])
+		}
+	}
+	if keepFieldDefinitionCategories(plan, state) {
+		t.Error("got a category set again, want none")
+	}
+}
+
+func TestConvertMetaobjectFieldDefinitionToModel_validationsOrder(t *testing.T) {
+	definition := &shopify.MetaobjectFieldDefinition{
+		Key:  "rating",
+		Name: "Rating",
+		Type: &shopify.MetafieldDefinitionType{Name: "number_integer"},
+		Validations: []*shopify.MetafieldDefinitionValidation{
+			{Name: "max", Value: "5"},
+			{Name: "min", Value: "1"},
+		},
+	}
+	model := &MetaobjectFieldDefinitionModel{
+		Key:         types.StringValue("rating"),
+		PreviousKey: types.StringNull(),
+		Validations: []*MetafieldDefinitionValidationModel{
+			{Name: types.StringValue("min"), Value: types.StringValue("1")},
+			{Name: types.StringValue("max"), Value: types.StringValue("5")},
+		},
+	}
+
+	got := convertMetaobjectFieldDefinitionToModel(definition, model)
+	if !reflect.DeepEqual(got.Validations, model.Validations) {
+		t.Errorf("got %v, want %v", got.Validations, model.Validations)
+	}
+}
+
+func testAccMetaobjectDefinitionResourcePreviousKeyConfig(metaobjectType, displayNameKey, key string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "author" {
+  name             = "Author"
+  type             = %[1]q
+  display_name_key = %[3]q
+  field_definitions = [
+    {%[2]s
+      name = "Bio"
+      type = "single_line_text_field"
+    }
+  ]
+}
+`, metaobjectType, key, displayNameKey)
+}
+
+func TestConvertMetaobjectDefinitionToResourceModel_import(t *testing.T) {
+	ctx := context.Background()
+	definition := &shopify.MetaobjectDefinition{
+		ID:          "gid://shopify/MetaobjectDefinition/1",
+		Type:        "author",
+		Name:        "Author",
+		Description: "",
+		Access:      &shopify.MetaobjectAccess{Admin: "MERCHANT_READ_WRITE", Storefront: "PUBLIC_READ"},
+	}
+	// Enough fields for an unstable sort to reorder them
+	for i := 0; i < 20; i++ {
+		definition.FieldDefinitions = append(definition.FieldDefinitions, &shopify.MetaobjectFieldDefinition{
+			Key:         fmt.Sprintf("field_%02d", i),
+			Name:        fmt.Sprintf("Field %d", i),
+			Description: map[bool]string{true: "A field", false: ""}[i%2 == 0],
+			Type:        &shopify.MetafieldDefinitionType{Name: "single_line_text_field"},
+			Validations: []*shopify.MetafieldDefinitionValidation{{Name: "max", Value: "100"}},
+		})
+	}
+
+	// A fresh import has nothing but the ID in the state
+	imported, diags := convertMetaobjectDefinitionToResourceModel(ctx, definition, &MetaobjectDefinitionResourceModel{ID: types.StringValue(definition.ID)})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	for i, fieldDefinition := range imported.FieldDefinitions {
+		if want := fmt.Sprintf("field_%02d", i); fieldDefinition.Key.ValueString() != want {
+			t.Fatalf("expected the order of Shopify, got %s at %d", fieldDefinition.Key.ValueString(), i)
+		}
+		if fieldDefinition.Name.IsNull() {
+			t.Errorf("expected the name of %s to be set", fieldDefinition.Key.ValueString())
+		}
+		if wantNull := i%2 == 1; fieldDefinition.Description.IsNull() != wantNull {
+			t.Errorf("expected the description of %s to be null: %v, got %s", fieldDefinition.Key.ValueString(), wantNull, fieldDefinition.Description)
+		}
+	}
+	if !imported.Description.IsNull() {
+		t.Errorf("expected the empty description to be null, got %s", imported.Description)
+	}
+
+	// The configuration written from the imported state reads back to the same state, so the plan has no diff
+	refreshed, diags := convertMetaobjectDefinitionToResourceModel(ctx, definition, imported)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !reflect.DeepEqual(imported, refreshed) {
+		t.Errorf("expected no diff after the import, got\n%+v\nwant\n%+v", refreshed, imported)
+	}
+}
+
+func TestConvertMetaobjectDefinitionToResourceModel_fieldOrder(t *testing.T) {
+	definition := &shopify.MetaobjectDefinition{
+		ID:     "gid://shopify/MetaobjectDefinition/1",
+		Type:   "author",
+		Name:   "Author",
+		Access: &shopify.MetaobjectAccess{Admin: "MERCHANT_READ_WRITE", Storefront: "PUBLIC_READ"},
+	}
+	for _, key := range []string{"name", "bio", "avatar"} {
+		definition.FieldDefinitions = append(definition.FieldDefinitions, &shopify.MetaobjectFieldDefinition{
+			Key:  key,
+			Name: key,
+			Type: &shopify.MetafieldDefinitionType{Name: "single_line_text_field"},
+		})
+	}
+	// The fields unknown to the state, e.g. added outside of Terraform, follow the known ones in the order of Shopify
+	data := &MetaobjectDefinitionResourceModel{
+		ID:               types.StringValue(definition.ID),
+		FieldDefinitions: []*MetaobjectFieldDefinitionModel{{Key: types.StringValue("avatar")}},
+	}
+	model, diags := convertMetaobjectDefinitionToResourceModel(context.Background(), definition, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	var keys []string
+	for _, fieldDefinition := range model.FieldDefinitions {
+		keys = append(keys, fieldDefinition.Key.ValueString())
+	}
+	if want := []string{"avatar", "name", "bio"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("expected %v, got %v", want, keys)
+	}
+}
+
+func TestConvertMetaobjectDefinitionChangesToUpdateInput(t *testing.T) {
+	ctx := context.Background()
+	definition := &shopify.MetaobjectDefinition{
+		ID:             "gid://shopify/MetaobjectDefinition/1",
+		Type:           "author",
+		Name:           "Author",
+		DisplayNameKey: utils.Ptr("name"),
+		Access:         &shopify.MetaobjectAccess{Admin: "MERCHANT_READ_WRITE", Storefront: "PUBLIC_READ"},
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinition{
+			{Key: "name", Name: "Name", Type: &shopify.MetafieldDefinitionType{Name: "single_line_text_field"}},
+		},
+	}
+	state, diags := convertMetaobjectDefinitionToResourceModel(ctx, definition, &MetaobjectDefinitionResourceModel{ID: types.StringValue(definition.ID)})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// Only the name of a field changes, so the access, possibly managed by another team, isn't sent back
+	plan := *state
+	fieldDefinition := *state.FieldDefinitions[0]
+	fieldDefinition.Name = types.StringValue("Full name")
+	plan.FieldDefinitions = []*MetaobjectFieldDefinitionModel{&fieldDefinition}
+	input, diags := convertMetaobjectDefinitionChangesToUpdateInput(ctx, &plan, state, nil)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	b, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{`"access"`, `"name"`, `"description"`, `"displayNameKey"`} {
+		if strings.Contains(string(b), name) {
+			t.Errorf("expected %s not to be sent, got %s", name, b)
+		}
+	}
+
+	// The display name key referencing a recreated field is left to the 2nd request, even when it changes
+	plan.DisplayNameKey = types.StringValue("name")
+	state.DisplayNameKey = types.StringNull()
+	input, diags = convertMetaobjectDefinitionChangesToUpdateInput(ctx, &plan, state, []string{"name"})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input.DisplayNameKey != nil {
+		t.Errorf("expected the display name key not to be sent, got %q", *input.DisplayNameKey)
+	}
+	state.DisplayNameKey = plan.DisplayNameKey
+
+	// The changed access is sent
+	plan.Access, diags = (&MetaobjectDefinitionAccessModel{Admin: types.StringValue("MERCHANT_READ"), Storefront: types.StringValue("NONE")}).ToObject(ctx)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	input, diags = convertMetaobjectDefinitionChangesToUpdateInput(ctx, &plan, state, nil)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if input.Access == nil || input.Access.Admin != "MERCHANT_READ" || input.Name != nil {
+		t.Errorf("expected only the access to be sent, got %+v", input)
+	}
+}
+
+func TestMetaobjectDefinitionResource_updateDisplayNameFieldType(t *testing.T) {
+	ctx := context.Background()
+	var definitions []map[string]interface{}
+	r := &MetaobjectDefinitionResource{
+		client: newTestShopifyClient(t, func(w http.ResponseWriter, req *http.Request) {
+			var body struct {
+				Variables struct {
+					Definition map[string]interface{} `json:"definition"`
+				} `json:"variables"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			definitions = append(definitions, body.Variables.Definition)
+			_, _ = w.Write([]byte(`{"data":{"metaobjectDefinitionUpdate":{"metaobjectDefinition":{"id":"gid://shopify/MetaobjectDefinition/1","type":"author","name":"Author","displayNameKey":"name",` +
+				`"access":{"admin":"MERCHANT_READ_WRITE","storefront":"PUBLIC_READ"},` +
+				`"fieldDefinitions":[{"key":"name","name":"Name","type":{"name":"multi_line_text_field"}}]},"userErrors":[]}}}`))
+		}),
+	}
+	definition := &shopify.MetaobjectDefinition{
+		ID:             "gid://shopify/MetaobjectDefinition/1",
+		Type:           "author",
+		Name:           "Author",
+		DisplayNameKey: utils.Ptr("name"),
+		Access:         &shopify.MetaobjectAccess{Admin: "MERCHANT_READ_WRITE", Storefront: "PUBLIC_READ"},
+		FieldDefinitions: []*shopify.MetaobjectFieldDefinition{
+			{Key: "name", Name: "Name", Type: &shopify.MetafieldDefinitionType{Name: "single_line_text_field"}},
+		},
+	}
+	state, diags := convertMetaobjectDefinitionToResourceModel(ctx, definition, &MetaobjectDefinitionResourceModel{ID: types.StringValue(definition.ID)})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// The type of the field used as the display name changes, so the field is recreated under the same key
+	plan := *state
+	fieldDefinition := *state.FieldDefinitions[0]
+	fieldDefinition.Type = types.StringValue("multi_line_text_field")
+	plan.FieldDefinitions = []*MetaobjectFieldDefinitionModel{&fieldDefinition}
+	planValue := newTestResourcePlan(t, r, &plan)
+	stateValue := newTestResourcePlan(t, r, state)
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: stateValue.Schema, Raw: stateValue.Raw}}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: planValue, State: tfsdk.State{Schema: stateValue.Schema, Raw: stateValue.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if len(definitions) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(definitions))
+	}
+	// The 1st request deletes the field, so it can't be the display name yet
+	if key, ok := definitions[0]["displayNameKey"]; ok {
+		t.Errorf("expected no display name key in the 1st request, got %v", key)
+	}
+	if key := definitions[1]["displayNameKey"]; key != "name" {
+		t.Errorf("expected the display name key in the 2nd request, got %v", key)
+	}
+}
+
+func TestConvertMetaobjectCapabilitiesForAPIVersion(t *testing.T) {
+	current := shopify.NewClient(nil, shopify.Config{APIVersion: "2024-07"})
+	legacy := shopify.NewClient(nil, shopify.Config{APIVersion: "2023-10"})
+	enabled := &shopify.MetaobjectCapabilities{
+		Publishable: &shopify.MetaobjectCapabilityStatus{Enabled: true},
+		Renderable:  &shopify.MetaobjectCapabilityRenderable{Enabled: true},
+	}
+	disabled := &shopify.MetaobjectCapabilities{
+		Renderable: &shopify.MetaobjectCapabilityRenderable{Enabled: false},
+	}
+
+	if got, diags := convertMetaobjectCapabilitiesForAPIVersion(enabled, current); diags.HasError() || got != enabled {
+		t.Errorf("expected the capabilities to be kept as they are, got %+v, %v", got, diags)
+	}
+	if _, diags := convertMetaobjectCapabilitiesForAPIVersion(enabled, legacy); !diags.HasError() {
+		t.Error("expected enabling the renderable capability to fail before its API version")
+	}
+	if got, diags := convertMetaobjectCapabilitiesForAPIVersion(disabled, legacy); diags.HasError() || got != nil {
+		t.Errorf("expected disabling the renderable capability to be left out, got %+v, %v", got, diags)
+	}
+}
+
+func TestConvertMetaobjectFieldDefinitionChangesToOperations(t *testing.T) {
+	fieldDef := func(key, previousKey, fieldType string) *MetaobjectFieldDefinitionModel {
+		return &MetaobjectFieldDefinitionModel{
+			Key:         types.StringValue(key),
+			PreviousKey: types.StringValue(previousKey),
+			Name:        types.StringValue(key),
+			Description: types.StringNull(),
+			Type:        types.StringValue(fieldType),
+			Required:    types.BoolValue(false),
+		}
+	}
+	operations := func(ops []*shopify.MetaobjectFieldDefinitionOperationInput) []string {
+		var got []string
+		for _, op := range ops {
+			switch {
+			case op.Create != nil:
+				got = append(got, "create "+op.Create.Key)
+			case op.Update != nil:
+				got = append(got, "update "+op.Update.Key)
+			case op.Delete != nil:
+				got = append(got, "delete "+op.Delete.Key)
+			}
+		}
+		return got
+	}
+
+	tests := []struct {
+		name      string
+		state     []*MetaobjectFieldDefinitionModel
+		plan      []*MetaobjectFieldDefinitionModel
+		want1st   []string
+		want2nd   []string
+		recreated []string
+	}{
+		{
+			name:    "create, update and delete",
+			state:   []*MetaobjectFieldDefinitionModel{fieldDef("name", "", "single_line_text_field"), fieldDef("bio", "", "multi_line_text_field")},
+			plan:    []*MetaobjectFieldDefinitionModel{fieldDef("name", "", "single_line_text_field"), fieldDef("age", "", "number_integer")},
+			want1st: []string{"delete bio", "create age"},
+		},
+		{
+			name:      "type changed under another key",
+			state:     []*MetaobjectFieldDefinitionModel{fieldDef("name", "full_name", "single_line_text_field")},
+			plan:      []*MetaobjectFieldDefinitionModel{fieldDef("name", "", "multi_line_text_field")},
+			want1st:   []string{"delete full_name", "create name"},
+			recreated: []string{"name"},
+		},
+		{
+			name:    "key changed back to the previous key",
+			state:   []*MetaobjectFieldDefinitionModel{fieldDef("name", "full_name", "single_line_text_field")},
+			plan:    []*MetaobjectFieldDefinitionModel{fieldDef("full_name", "", "single_line_text_field")},
+			want1st: []string{"update full_name"},
+		},
+		{
+			name:      "type changed under the same key",
+			state:     []*MetaobjectFieldDefinitionModel{fieldDef("name", "", "single_line_text_field"), fieldDef("bio", "", "single_line_text_field")},
+			plan:      []*MetaobjectFieldDefinitionModel{fieldDef("name", "", "multi_line_text_field"), fieldDef("age", "", "number_integer")},
+			want1st:   []string{"delete name", "delete bio", "create age"},
+			want2nd:   []string{"create name"},
+			recreated: []string{"name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got1st, got2nd, recreated := convertMetaobjectFieldDefinitionChangesToOperations(tt.state, tt.plan, nil)
+			if got := operations(got1st); !reflect.DeepEqual(got, tt.want1st) {
+				t.Errorf("1st request = %v, want %v", got, tt.want1st)
+			}
+			if got := operations(got2nd); !reflect.DeepEqual(got, tt.want2nd) {
+				t.Errorf("2nd request = %v, want %v", got, tt.want2nd)
+			}
+			if !reflect.DeepEqual(recreated, tt.recreated) {
+				t.Errorf("recreated = %v, want %v", recreated, tt.recreated)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetaobjectEntrySetResource{}
+var _ resource.ResourceWithImportState = &MetaobjectEntrySetResource{}
+
+// MetaobjectEntrySetResource defines the resource implementation.
+type MetaobjectEntrySetResource struct {
+	client *shopify.Client
+}
+
+func NewMetaobjectEntrySetResource() resource.Resource {
+	return &MetaobjectEntrySetResource{}
+}
+
+// MetaobjectEntrySetResourceModel describes the resource data model.
+type MetaobjectEntrySetResourceModel struct {
+	ID      types.String                             `tfsdk:"id"`
+	Type    types.String                             `tfsdk:"type"`
+	Entries map[string]*MetaobjectEntrySetEntryModel `tfsdk:"entries"`
+}
+
+// MetaobjectEntrySetEntryModel describes a metaobject entry in the set, keyed by its handle.
+type MetaobjectEntrySetEntryModel struct {
+	ID     types.String            `tfsdk:"id"`
+	Fields map[string]types.String `tfsdk:"fields"`
+}
+
+func (r *MetaobjectEntrySetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metaobject_entry_set"
+}
+
+func (r *MetaobjectEntrySetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages many metaobject entries of a type as a unit, e.g. for a content migration. " +
+			"The entries are created, updated and deleted by handle; many entries are changed by a bulk operation, a few by a mutation per entry.\n\n" +
+			"The entries fail independently of each other. The handles of the failed entries are reported, and the entries that have been changed are kept in the state, " +
+			"so that the next apply only retries the failed ones. As for any resource, a set that fails on creation is replaced by the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the set, which is the type of the metaobject definition.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the metaobject definition of the entries.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"entries": schema.MapNestedAttribute{
+				MarkdownDescription: "The metaobject entries keyed by their handle. The entries of the type which aren't in the set are left as they are.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the metaobject entry.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"fields": schema.MapAttribute{
+							MarkdownDescription: "The values of the fields keyed by the field key, always strings, e.g. `jsonencode([...])` for list fields. " +
+								"The fields which aren't set are left as they are, and removing a field clears its value.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *MetaobjectEntrySetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *MetaobjectEntrySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetaobjectEntrySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdData := &MetaobjectEntrySetResourceModel{
+		ID:      data.Type,
+		Type:    data.Type,
+		Entries: make(map[string]*MetaobjectEntrySetEntryModel, len(data.Entries)),
+	}
+	resp.Diagnostics.Append(r.upsertEntries(ctx, &data, nil, createdData)...)
+	tflog.Trace(ctx, "created a metaobject entry set", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	// Save the entries created even on failure not to lose track of them
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *MetaobjectEntrySetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetaobjectEntrySetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaobjects, err := r.client.ListMetaobjectEntries(ctx, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read metaobject entries", err))
+		return
+	}
+
+	// On import there is no entry in the state yet, so take every entry of the type
+	importing := data.Entries == nil
+	entries := make(map[string]*MetaobjectEntrySetEntryModel, len(metaobjects))
+	for _, metaobject := range metaobjects {
+		entry, ok := data.Entries[metaobject.Handle]
+		if !ok && !importing {
+			continue
+		}
+		entries[metaobject.Handle] = convertMetaobjectToEntrySetEntryModel(metaobject, entry)
+	}
+	data.ID = data.Type
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetaobjectEntrySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MetaobjectEntrySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedData := &MetaobjectEntrySetResourceModel{
+		ID:      state.ID,
+		Type:    data.Type,
+		Entries: make(map[string]*MetaobjectEntrySetEntryModel, len(data.Entries)),
+	}
+	// Delete the removed entries first, then set the others
+	var removedHandles, removedIDs []string
+	for _, handle := range slices.Sorted(maps.Keys(state.Entries)) {
+		if _, ok := data.Entries[handle]; !ok {
+			removedHandles = append(removedHandles, handle)
+			removedIDs = append(removedIDs, state.Entries[handle].ID.ValueString())
+		}
+	}
+	errs := r.client.DeleteMetaobjects(ctx, removedIDs)
+	var failed []error
+	for i, handle := range removedHandles {
+		if err, ok := errs[removedIDs[i]]; ok {
+			// Keep the entries that haven't been deleted in the state
+			updatedData.Entries[handle] = state.Entries[handle]
+			failed = append(failed, fmt.Errorf("%s: %w", handle, err))
+		}
+	}
+	if len(failed) > 0 {
+		resp.Diagnostics.Append(diagFromClientError(fmt.Sprintf("Unable to delete %d metaobject entries", len(failed)), errors.Join(failed...)))
+	}
+
+	resp.Diagnostics.Append(r.upsertEntries(ctx, &data, state.Entries, updatedData)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
+}
+
+func (r *MetaobjectEntrySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetaobjectEntrySetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	handles := slices.Sorted(maps.Keys(data.Entries))
+	ids := make([]string, 0, len(handles))
+	for _, handle := range handles {
+		ids = append(ids, data.Entries[handle].ID.ValueString())
+	}
+	errs := r.client.DeleteMetaobjects(ctx, ids)
+	var failed []error
+	for i, handle := range handles {
+		if err, ok := errs[ids[i]]; ok {
+			failed = append(failed, fmt.Errorf("%s: %w", handle, err))
+		}
+	}
+	if len(failed) > 0 {
+		resp.Diagnostics.Append(diagFromClientError(fmt.Sprintf("Unable to delete %d metaobject entries", len(failed)), errors.Join(failed...)))
+		return
+	}
+	tflog.Trace(ctx, "deleted a metaobject entry set", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *MetaobjectEntrySetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), req.ID)...)
+}
+
+// upsertEntries creates or updates the planned entries which differ from the current ones, and puts the result in updatedData.
+// The entries which fail are kept as they are currently, and their handles are reported in a single error.
+func (r *MetaobjectEntrySetResource) upsertEntries(ctx context.Context, data *MetaobjectEntrySetResourceModel, current map[string]*MetaobjectEntrySetEntryModel, updatedData *MetaobjectEntrySetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	handles, inputs := diffMetaobjectEntrySetEntries(data.Entries, current)
+	for handle, entry := range current {
+		if _, ok := data.Entries[handle]; ok && !slices.Contains(handles, handle) {
+			updatedData.Entries[handle] = entry
+		}
+	}
+
+	var failed []error
+	for _, result := range r.client.UpsertMetaobjects(ctx, data.Type.ValueString(), handles, inputs) {
+		if result.Err == nil && result.Metaobject == nil {
+			result.Err = fmt.Errorf("no metaobject entry has been returned")
+		}
+		if result.Err != nil {
+			if entry, ok := current[result.Handle]; ok {
+				updatedData.Entries[result.Handle] = entry
+			}
+			failed = append(failed, fmt.Errorf("%s: %w", result.Handle, result.Err))
+			continue
+		}
+		updatedData.Entries[result.Handle] = convertMetaobjectToEntrySetEntryModel(result.Metaobject, data.Entries[result.Handle])
+	}
+	if len(failed) > 0 {
+		diags.Append(diagFromClientError(fmt.Sprintf("Unable to set %d of %d metaobject entries", len(failed), len(handles)), errors.Join(failed...)))
+	}
+	return diags
+}
+
+// diffMetaobjectEntrySetEntries returns the handles of the planned entries to upsert, in order, with their inputs.
+// The fields removed from an entry are cleared, and the entries which haven't changed aren't upserted.
+func diffMetaobjectEntrySetEntries(plan, current map[string]*MetaobjectEntrySetEntryModel) ([]string, []*shopify.MetaobjectUpsertInput) {
+	var handles []string
+	var inputs []*shopify.MetaobjectUpsertInput
+	for _, handle := range slices.Sorted(maps.Keys(plan)) {
+		entry := plan[handle]
+		currentEntry, ok := current[handle]
+		if ok && maps.EqualFunc(entry.Fields, currentEntry.Fields, func(a, b types.String) bool { return a.Equal(b) }) {
+			continue
+		}
+		input := &shopify.MetaobjectUpsertInput{Fields: []*shopify.MetaobjectFieldInput{}}
+		for _, key := range slices.Sorted(maps.Keys(entry.Fields)) {
+			input.Fields = append(input.Fields, &shopify.MetaobjectFieldInput{Key: key, Value: entry.Fields[key].ValueString()})
+		}
+		if ok {
+			for _, key := range slices.Sorted(maps.Keys(currentEntry.Fields)) {
+				if _, ok := entry.Fields[key]; !ok {
+					input.Fields = append(input.Fields, &shopify.MetaobjectFieldInput{Key: key, Value: ""})
+				}
+			}
+		}
+		handles = append(handles, handle)
+		inputs = append(inputs, input)
+	}
+	return handles, inputs
+}
+
+// convertMetaobjectToEntrySetEntryModel keeps the fields of the current entry only, as the other fields are left as they are,
+// or every field with a value when there is no current entry, e.g. on import.
+func convertMetaobjectToEntrySetEntryModel(metaobject *shopify.Metaobject, current *MetaobjectEntrySetEntryModel) *MetaobjectEntrySetEntryModel {
+	fields := map[string]types.String{}
+	for _, field := range metaobject.Fields {
+		if field.Value == nil {
+			continue
+		}
+		if current == nil {
+			fields[field.Key] = types.StringValue(*field.Value)
+			continue
+		}
+		if currentValue, ok := current.Fields[field.Key]; ok {
+			fields[field.Key] = convertMetafieldValueToModel(*field.Value, currentValue)
+		}
+	}
+	return &MetaobjectEntrySetEntryModel{
+		ID:     types.StringValue(metaobject.ID),
+		Fields: fields,
+	}
+}
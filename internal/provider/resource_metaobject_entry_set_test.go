@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccMetaobjectEntrySetResource(t *testing.T) {
+	metaobjectType := randResourceID(60)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMetaobjectEntrySetResourceConfig(metaobjectType, `
+    jane = { fields = { name = "Jane" } }
+    john = { fields = { name = "John" } }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_entry_set.test", "id", metaobjectType),
+					resource.TestCheckResourceAttr("shopify_metaobject_entry_set.test", "entries.%", "2"),
+					resource.TestCheckResourceAttrSet("shopify_metaobject_entry_set.test", "entries.jane.id"),
+					resource.TestCheckResourceAttr("shopify_metaobject_entry_set.test", "entries.john.fields.name", "John"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_metaobject_entry_set.test",
+				ImportState:       true,
+				ImportStateId:     metaobjectType,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetaobjectEntrySetResourceConfig(metaobjectType, `
+    jane = { fields = { name = "Jane Doe" } }
+    mary = { fields = { name = "Mary" } }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_metaobject_entry_set.test", "entries.%", "2"),
+					resource.TestCheckResourceAttr("shopify_metaobject_entry_set.test", "entries.jane.fields.name", "Jane Doe"),
+					resource.TestCheckResourceAttrSet("shopify_metaobject_entry_set.test", "entries.mary.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetaobjectEntrySetResourceConfig(metaobjectType, entries string) string {
+	return fmt.Sprintf(`
+resource "shopify_metaobject_definition" "test" {
+  name = "Terraform Test"
+  type = %[1]q
+  field_definitions = [
+    {
+      key  = "name"
+      name = "Name"
+      type = "single_line_text_field"
+    }
+  ]
+}
+
+resource "shopify_metaobject_entry_set" "test" {
+  type = shopify_metaobject_definition.test.type
+  entries = {
+%[2]s
+  }
+}
+`, metaobjectType, entries)
+}
+
+func TestDiffMetaobjectEntrySetEntries(t *testing.T) {
+	current := map[string]*MetaobjectEntrySetEntryModel{
+		"unchanged": {ID: types.StringValue("gid://shopify/Metaobject/1"), Fields: map[string]types.String{"name": types.StringValue("A")}},
+		"changed": {ID: types.StringValue("gid://shopify/Metaobject/2"), Fields: map[string]types.String{
+			"name": types.StringValue("B"),
+			"bio":  types.StringValue("Removed"),
+		}},
+	}
+	plan := map[string]*MetaobjectEntrySetEntryModel{
+		"unchanged": {Fields: map[string]types.String{"name": types.StringValue("A")}},
+		"changed":   {Fields: map[string]types.String{"name": types.StringValue("B2")}},
+		"added":     {Fields: map[string]types.String{"name": types.StringValue("C")}},
+	}
+
+	handles, inputs := diffMetaobjectEntrySetEntries(plan, current)
+	if want := []string{"added", "changed"}; !reflect.DeepEqual(handles, want) {
+		t.Errorf("handles = %v, want %v", handles, want)
+	}
+	want := []*shopify.MetaobjectUpsertInput{
+		{Fields: []*shopify.MetaobjectFieldInput{{Key: "name", Value: "C"}}},
+		// The removed field is cleared
+		{Fields: []*shopify.MetaobjectFieldInput{{Key: "name", Value: "B2"}, {Key: "bio", Value: ""}}},
+	}
+	if !reflect.DeepEqual(inputs, want) {
+		t.Errorf("unexpected inputs: %v", inputs)
+	}
+}
+
+func TestConvertMetaobjectToEntrySetEntryModel(t *testing.T) {
+	name, tags := "Jane", `["a","b"]`
+	metaobject := &shopify.Metaobject{
+		ID:     "gid://shopify/Metaobject/1",
+		Handle: "jane",
+		Fields: []*shopify.MetaobjectField{
+			{Key: "name", Value: &name},
+			{Key: "tags", Value: &tags},
+			{Key: "bio", Value: nil},
+		},
+	}
+
+	// Only the fields of the current entry are kept, with their current JSON formatting
+	current := &MetaobjectEntrySetEntryModel{Fields: map[string]types.String{"tags": types.StringValue(`["a", "b"]`)}}
+	got := convertMetaobjectToEntrySetEntryModel(metaobject, current)
+	if want := map[string]types.String{"tags": types.StringValue(`["a", "b"]`)}; !reflect.DeepEqual(got.Fields, want) {
+		t.Errorf("unexpected fields: %v", got.Fields)
+	}
+
+	// Every field with a value is taken on import
+	got = convertMetaobjectToEntrySetEntryModel(metaobject, nil)
+	if want := map[string]types.String{"name": types.StringValue(name), "tags": types.StringValue(tags)}; !reflect.DeepEqual(got.Fields, want) {
+		t.Errorf("unexpected fields: %v", got.Fields)
+	}
+	if got.ID.ValueString() != metaobject.ID {
+		t.Errorf("unexpected ID: %s", got.ID)
+	}
+}
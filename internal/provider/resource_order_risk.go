@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrderRiskResource{}
+var _ resource.ResourceWithImportState = &OrderRiskResource{}
+var _ resource.ResourceWithValidateConfig = &OrderRiskResource{}
+
+var orderRiskRecommendations = []string{
+	string(goshopify.OrderRecommendationAccept),
+	string(goshopify.OrderRecommendationInvestigate),
+	string(goshopify.OrderRecommendationCancel),
+}
+
+// OrderRiskResource defines the resource implementation.
+type OrderRiskResource struct {
+	client *shopify.Client
+}
+
+func NewOrderRiskResource() resource.Resource {
+	return &OrderRiskResource{}
+}
+
+// OrderRiskResourceModel describes the resource data model.
+type OrderRiskResourceModel struct {
+	ID             types.String  `tfsdk:"id"`
+	OrderID        types.String  `tfsdk:"order_id"`
+	Message        types.String  `tfsdk:"message"`
+	Recommendation types.String  `tfsdk:"recommendation"`
+	Score          types.Float64 `tfsdk:"score"`
+	Source         types.String  `tfsdk:"source"`
+}
+
+func (r *OrderRiskResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_order_risk"
+}
+
+func (r *OrderRiskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Annotates an order with a fraud risk assessment, shown on the order details page in the Shopify admin.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique numeric identifier for the order risk.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"order_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the order that the risk assessment is about. Both the numeric ID and `gid://shopify/Order/<id>` are accepted.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The message displayed to the merchant to indicate the results of the fraud check.",
+				Required:            true,
+			},
+			"recommendation": schema.StringAttribute{
+				MarkdownDescription: "The recommended action given to the merchant. Possible values are `accept`, `investigate` and `cancel`.",
+				Required:            true,
+			},
+			"score": schema.Float64Attribute{
+				MarkdownDescription: "The risk of the order being fraudulent, from 0.0 to 1.0.",
+				Required:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The source of the risk assessment, e.g. the name of the fraud tool. Set by Shopify when not configured.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrderRiskResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *OrderRiskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OrderRiskResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.OrderID.IsNull() && !data.OrderID.IsUnknown() {
+		if _, err := utils.ParseNumericID(data.OrderID.ValueString(), "Order"); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("order_id"), "Invalid order_id", err.Error())
+		}
+	}
+	if !data.Recommendation.IsNull() && !data.Recommendation.IsUnknown() && !slices.Contains(orderRiskRecommendations, data.Recommendation.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recommendation"),
+			"Invalid recommendation",
+			fmt.Sprintf("recommendation must be one of %s, got %q", strings.Join(orderRiskRecommendations, ", "), data.Recommendation.ValueString()),
+		)
+	}
+	if !data.Score.IsNull() && !data.Score.IsUnknown() && (data.Score.ValueFloat64() < 0 || data.Score.ValueFloat64() > 1) {
+		resp.Diagnostics.AddAttributeError(path.Root("score"), "Invalid score", fmt.Sprintf("score must be between 0.0 and 1.0, got %v", data.Score.ValueFloat64()))
+	}
+}
+
+func (r *OrderRiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrderRiskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	orderID, diags := parseOrderID(data.OrderID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdRisk, err := r.client.CreateOrderRisk(ctx, orderID, convertOrderRiskResourceModelToOrderRisk(data))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create order risk", err))
+		return
+	}
+	tflog.Trace(ctx, "created an order risk", map[string]interface{}{
+		"id": createdRisk.Id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertOrderRiskToResourceModel(createdRisk, data))...)
+}
+
+func (r *OrderRiskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrderRiskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	orderID, diags := parseOrderID(data.OrderID)
+	resp.Diagnostics.Append(diags...)
+	id, diags := parseOrderRiskID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	risk, err := r.client.GetOrderRisk(ctx, orderID, id)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read order risk", err))
+		return
+	}
+	if risk == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertOrderRiskToResourceModel(risk, data))...)
+}
+
+func (r *OrderRiskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrderRiskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	orderID, diags := parseOrderID(data.OrderID)
+	resp.Diagnostics.Append(diags...)
+	id, diags := parseOrderRiskID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	risk := convertOrderRiskResourceModelToOrderRisk(data)
+	risk.Id = id
+	updatedRisk, err := r.client.UpdateOrderRisk(ctx, orderID, risk)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update order risk", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertOrderRiskToResourceModel(updatedRisk, data))...)
+}
+
+func (r *OrderRiskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrderRiskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	orderID, diags := parseOrderID(data.OrderID)
+	resp.Diagnostics.Append(diags...)
+	id, diags := parseOrderRiskID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteOrderRisk(ctx, orderID, id); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete order risk", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted an order risk", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *OrderRiskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The order ID may be a GID, which contains colons itself
+	i := strings.LastIndex(req.ID, ":")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: order_id:risk_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("order_id"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID[i+1:])...)
+}
+
+// parseOrderID parses the numeric ID of the order.
+// The GraphQL global ID, e.g. gid://shopify/Order/123, is accepted as well.
+func parseOrderID(id types.String) (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed, err := utils.ParseNumericID(id.ValueString(), "Order")
+	if err != nil {
+		diags.AddAttributeError(path.Root("order_id"), "Failed to parse order_id", err.Error())
+		return 0, diags
+	}
+	return parsed, diags
+}
+
+// parseOrderRiskID parses the numeric ID of the order risk.
+func parseOrderRiskID(id types.String) (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed, err := utils.ParseNumericID(id.ValueString(), "OrderRisk")
+	if err != nil {
+		diags.AddError("Failed to parse ID", err.Error())
+		return 0, diags
+	}
+	return parsed, diags
+}
+
+func convertOrderRiskResourceModelToOrderRisk(data OrderRiskResourceModel) goshopify.OrderRisk {
+	return goshopify.OrderRisk{
+		Message:        data.Message.ValueString(),
+		Recommendation: goshopify.OrderRiskRecommendation(data.Recommendation.ValueString()),
+		Score:          strconv.FormatFloat(data.Score.ValueFloat64(), 'f', -1, 64),
+		Source:         data.Source.ValueString(),
+		// The risks that aren't displayed are hidden from the order details page
+		Display: true,
+	}
+}
+
+func convertOrderRiskToResourceModel(risk *goshopify.OrderRisk, data OrderRiskResourceModel) *OrderRiskResourceModel {
+	score := data.Score
+	if parsed, err := strconv.ParseFloat(risk.Score, 64); err == nil {
+		score = types.Float64Value(parsed)
+	}
+	return &OrderRiskResourceModel{
+		ID:             types.StringValue(strconv.FormatUint(risk.Id, 10)),
+		OrderID:        data.OrderID,
+		Message:        types.StringValue(risk.Message),
+		Recommendation: types.StringValue(string(risk.Recommendation)),
+		Score:          score,
+		Source:         types.StringValue(risk.Source),
+	}
+}
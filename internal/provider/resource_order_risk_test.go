@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrderRiskResource(t *testing.T) {
+	orderID := envOrSkip(t, "SHOPIFY_TEST_ORDER_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrderRiskResourceConfig(orderID, "investigate", 0.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_order_risk.test", "order_id", orderID),
+					resource.TestCheckResourceAttr("shopify_order_risk.test", "recommendation", "investigate"),
+					resource.TestCheckResourceAttr("shopify_order_risk.test", "score", "0.5"),
+					resource.TestCheckResourceAttr("shopify_order_risk.test", "source", "Terraform"),
+					resource.TestCheckResourceAttrSet("shopify_order_risk.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_order_risk.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["shopify_order_risk.test"]
+					return orderID + ":" + rs.Primary.ID, nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrderRiskResourceConfig(orderID, "cancel", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_order_risk.test", "recommendation", "cancel"),
+					resource.TestCheckResourceAttr("shopify_order_risk.test", "score", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrderRiskResourceConfig(orderID, recommendation string, score float64) string {
+	return fmt.Sprintf(`
+resource "shopify_order_risk" "test" {
+  order_id       = %[1]q
+  message        = "The billing address doesn't match the card"
+  recommendation = %[2]q
+  score          = %[3]v
+  source         = "Terraform"
+}
+`, orderID, recommendation, score)
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrderTagResource{}
+var _ resource.ResourceWithImportState = &OrderTagResource{}
+
+// OrderTagResource defines the resource implementation.
+type OrderTagResource struct {
+	client *shopify.Client
+}
+
+func NewOrderTagResource() resource.Resource {
+	return &OrderTagResource{}
+}
+
+// OrderTagResourceModel describes the resource data model.
+type OrderTagResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	OrderID   types.String `tfsdk:"order_id"`
+	Tags      types.Set    `tfsdk:"tags"`
+	AddedTags types.Set    `tfsdk:"added_tags"`
+	Note      types.String `tfsdk:"note"`
+}
+
+func (r *OrderTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_order_tag"
+}
+
+func (r *OrderTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages tags and the note of an existing order. The other tags of the order are left untouched, " +
+			"and destroying the resource only removes the tags it has added.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the order.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"order_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the order, e.g. `gid://shopify/Order/1234567890`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "The tags the order must have. A tag removed from the set is removed from the order only if it has been added by this resource.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"added_tags": schema.SetAttribute{
+				MarkdownDescription: "The tags added by this resource, i.e. the tags that the order didn't have before.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "The note of the order. The note is left as is when unset.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *OrderTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *OrderTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrderTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := r.client.GetOrder(ctx, data.OrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read order", err))
+		return
+	}
+	if order == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("order_id"), "Order not found", fmt.Sprintf("Order %s does not exist.", data.OrderID.ValueString()))
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	addedTags := differenceTags(tags, order.Tags)
+	if len(addedTags) > 0 {
+		if err := r.client.AddTags(ctx, order.ID, addedTags); err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to add order tags", err))
+			return
+		}
+	}
+	if !data.Note.IsNull() && data.Note.ValueString() != stringValueOrEmpty(order.Note) {
+		if _, err := r.client.UpdateOrder(ctx, &shopify.OrderInput{ID: order.ID, Note: data.Note.ValueStringPointer()}); err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update order note", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(order.ID)
+	data.AddedTags = convertTagsToSet(addedTags)
+	tflog.Trace(ctx, "created an order tag", map[string]interface{}{
+		"id": data.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrderTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := r.client.GetOrder(ctx, data.OrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read order", err))
+		return
+	}
+	if order == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newData, diags := convertOrderToOrderTagResourceModel(ctx, order, data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newData)...)
+}
+
+func (r *OrderTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OrderTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags, oldTags, addedTags []string
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &oldTags, false)...)
+	resp.Diagnostics.Append(state.AddedTags.ElementsAs(ctx, &addedTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := r.client.GetOrder(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read order", err))
+		return
+	}
+	if order == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("order_id"), "Order not found", fmt.Sprintf("Order %s does not exist.", state.ID.ValueString()))
+		return
+	}
+
+	// Only remove the tags that this resource has added, the others were there before
+	removedTags := slices.DeleteFunc(differenceTags(oldTags, tags), func(tag string) bool {
+		return !slices.Contains(addedTags, tag)
+	})
+	if len(removedTags) > 0 {
+		if err := r.client.RemoveTags(ctx, order.ID, removedTags); err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to remove order tags", err))
+			return
+		}
+		addedTags = differenceTags(addedTags, removedTags)
+	}
+	newTags := differenceTags(tags, order.Tags)
+	if len(newTags) > 0 {
+		if err := r.client.AddTags(ctx, order.ID, newTags); err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to add order tags", err))
+			return
+		}
+		addedTags = append(addedTags, differenceTags(newTags, addedTags)...)
+	}
+	if !data.Note.IsNull() && data.Note.ValueString() != stringValueOrEmpty(order.Note) {
+		if _, err := r.client.UpdateOrder(ctx, &shopify.OrderInput{ID: order.ID, Note: data.Note.ValueStringPointer()}); err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update order note", err))
+			return
+		}
+	}
+
+	data.ID = state.ID
+	data.AddedTags = convertTagsToSet(addedTags)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrderTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addedTags []string
+	resp.Diagnostics.Append(data.AddedTags.ElementsAs(ctx, &addedTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(addedTags) == 0 {
+		return
+	}
+	if err := r.client.RemoveTags(ctx, data.ID.ValueString(), addedTags); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to remove order tags", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted an order tag", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *OrderTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("order_id"), req.ID)...)
+}
+
+func convertOrderToOrderTagResourceModel(ctx context.Context, order *shopify.Order, data OrderTagResourceModel) (*OrderTagResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var tags, addedTags []string
+	if data.Tags.IsNull() {
+		// On import every tag of the order is managed, but none of them has been added by this resource
+		tags = order.Tags
+	} else {
+		diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		diags.Append(data.AddedTags.ElementsAs(ctx, &addedTags, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	// Tags removed outside of Terraform disappear from the state so that they are added again
+	isRemoved := func(tag string) bool {
+		return !slices.Contains(order.Tags, tag)
+	}
+	note := data.Note
+	if !note.IsNull() {
+		note = types.StringValue(stringValueOrEmpty(order.Note))
+	}
+	return &OrderTagResourceModel{
+		ID:        types.StringValue(order.ID),
+		OrderID:   data.OrderID,
+		Tags:      convertTagsToSet(slices.DeleteFunc(slices.Clone(tags), isRemoved)),
+		AddedTags: convertTagsToSet(slices.DeleteFunc(slices.Clone(addedTags), isRemoved)),
+		Note:      note,
+	}, diags
+}
+
+// differenceTags returns the tags in a that aren't in b.
+func differenceTags(a, b []string) []string {
+	tags := make([]string, 0, len(a))
+	for _, tag := range a {
+		if !slices.Contains(b, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func convertTagsToSet(tags []string) types.Set {
+	values := make([]attr.Value, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, types.StringValue(tag))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func stringValueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
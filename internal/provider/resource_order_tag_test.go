@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccOrderTagResource(t *testing.T) {
+	orderID := envOrSkip(t, "SHOPIFY_TEST_ORDER_ID")
+	tag := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrderTagResourceConfig(orderID, fmt.Sprintf("%q", tag), "Test note"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_order_tag.test", "id", orderID),
+					resource.TestCheckTypeSetElemAttr("shopify_order_tag.test", "tags.*", tag),
+					resource.TestCheckTypeSetElemAttr("shopify_order_tag.test", "added_tags.*", tag),
+					resource.TestCheckResourceAttr("shopify_order_tag.test", "note", "Test note"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrderTagResourceConfig(orderID, fmt.Sprintf("%q", tag+"_updated"), "Updated test note"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_order_tag.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("shopify_order_tag.test", "tags.*", tag+"_updated"),
+					resource.TestCheckResourceAttr("shopify_order_tag.test", "added_tags.#", "1"),
+					resource.TestCheckResourceAttr("shopify_order_tag.test", "note", "Updated test note"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrderTagResourceConfig(orderID, tags, note string) string {
+	return fmt.Sprintf(`
+resource "shopify_order_tag" "test" {
+  order_id = %[1]q
+  tags     = [%[2]s]
+  note     = %[3]q
+}
+`, orderID, tags, note)
+}
+
+func TestConvertOrderToOrderTagResourceModel(t *testing.T) {
+	ctx := context.Background()
+	order := &shopify.Order{ID: "gid://shopify/Order/1", Tags: []string{"existing", "added"}}
+
+	// Tags removed outside of Terraform are dropped so that they are added again
+	data := OrderTagResourceModel{
+		OrderID:   types.StringValue("gid://shopify/Order/1"),
+		Tags:      convertTagsToSet([]string{"existing", "added", "removed"}),
+		AddedTags: convertTagsToSet([]string{"added", "removed"}),
+		Note:      types.StringNull(),
+	}
+	got, diags := convertOrderToOrderTagResourceModel(ctx, order, data)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !got.Tags.Equal(convertTagsToSet([]string{"existing", "added"})) {
+		t.Errorf("unexpected tags: %s", got.Tags)
+	}
+	if !got.AddedTags.Equal(convertTagsToSet([]string{"added"})) {
+		t.Errorf("unexpected added tags: %s", got.AddedTags)
+	}
+	if !got.Note.IsNull() {
+		t.Errorf("expected the note not to be managed, got %s", got.Note)
+	}
+
+	// On import every tag is managed but none has been added
+	got, diags = convertOrderToOrderTagResourceModel(ctx, order, OrderTagResourceModel{
+		OrderID:   types.StringValue("gid://shopify/Order/1"),
+		Tags:      types.SetNull(types.StringType),
+		AddedTags: types.SetNull(types.StringType),
+		Note:      types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !got.Tags.Equal(convertTagsToSet([]string{"existing", "added"})) {
+		t.Errorf("unexpected tags: %s", got.Tags)
+	}
+	if !got.AddedTags.Equal(convertTagsToSet(nil)) {
+		t.Errorf("unexpected added tags: %s", got.AddedTags)
+	}
+}
@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ownerMetafieldResource{}
+var _ resource.ResourceWithImportState = &ownerMetafieldResource{}
+var _ resource.ResourceWithValidateConfig = &ownerMetafieldResource{}
+
+// metafieldOwner describes the owner of the metafields managed by an ownerMetafieldResource.
+type metafieldOwner struct {
+	// name is the name of the owner in the messages, e.g. customer. Empty for any owner.
+	name string
+	// gidType is the type of the GID of the owner, e.g. Customer. Empty for any owner, whose ID must be a GID.
+	gidType string
+	// attribute is the attribute of the ID of the owner, e.g. customer_id.
+	attribute string
+	// description is the description of the resource.
+	description string
+	// resolveID returns the GID of the owner when it's implied by the resource instead of configured, e.g. the shop.
+	// The attribute of the owner is then computed, and the import identifier is namespace.key.
+	resolveID func(ctx context.Context, client *shopify.Client) (string, error)
+}
+
+// subject returns the subject of the messages, e.g. customer metafield.
+func (o metafieldOwner) subject() string {
+	return strings.TrimSpace(o.name + " metafield")
+}
+
+// ownerMetafieldResource is the implementation shared by the resources managing a metafield of an owner given by ID,
+// e.g. shopify_customer_metafield, or implied, e.g. shopify_shop_metafield, which only differ by their owner. shopify_metafield manages a metafield of any owner.
+type ownerMetafieldResource struct {
+	client *shopify.Client
+	owner  metafieldOwner
+}
+
+// ownerMetafieldResourceModel describes the resource data model. The owner ID is read from and written to
+// the attribute of the owner, so the model has no tags.
+type ownerMetafieldResourceModel struct {
+	ID        types.String
+	OwnerID   types.String
+	Namespace types.String
+	Key       types.String
+	Type      types.String
+	Value     types.String
+}
+
+func (r *ownerMetafieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + strings.ReplaceAll(r.owner.subject(), " ", "_")
+}
+
+func (r *ownerMetafieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.owner.description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the metafield.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.owner.attribute: r.owner.attributeSchema(),
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "The container for a group of metafields that the metafield is associated with.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the metafield within its namespace.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of data that the metafield stores. Refer to the list of [supported types](https://shopify.dev/docs/apps/build/custom-data/metafields/list-of-data-types).",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The data stored in the metafield, always a string. JSON values, e.g. of `json` or `list.*` types, are compared semantically, so whitespace and key order don't produce a diff. " +
+					"Metaobjects referred to by `metaobject_reference` and `mixed_reference` values can be written as `<type>/<handle>` instead of GIDs.",
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *ownerMetafieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *ownerMetafieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ownerID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(r.owner.attribute), &ownerID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !ownerID.IsNull() && !ownerID.IsUnknown() {
+		if _, err := r.ownerGID(ownerID); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(r.owner.attribute), "Invalid "+r.owner.attribute, err.Error())
+		}
+	}
+}
+
+func (r *ownerMetafieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data, diags := r.get(ctx, req.Plan.GetAttribute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.resolveOwner(ctx, &data); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read "+r.owner.name, err))
+		return
+	}
+	metafield, err := r.setMetafield(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to set "+r.owner.subject(), err))
+		return
+	}
+
+	metafield.Value = keepMetafieldReferences(ctx, r.client, metafield, data.Value)
+	createdData := convertOwnerMetafieldToResourceModel(metafield, data)
+	tflog.Trace(ctx, "created a "+r.owner.subject(), map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, createdData)...)
+}
+
+func (r *ownerMetafieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data, diags := r.get(ctx, req.State.GetAttribute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The imported metafields of an implied owner don't have the ID of the owner yet
+	if err := r.resolveOwner(ctx, &data); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read "+r.owner.name, err))
+		return
+	}
+	ownerID, err := r.ownerGID(data.OwnerID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(r.owner.attribute), "Invalid "+r.owner.attribute, err.Error())
+		return
+	}
+	metafield, err := r.client.GetOwnerMetafield(ctx, ownerID, data.Namespace.ValueString(), data.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read "+r.owner.subject(), err))
+		return
+	}
+	if metafield == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	metafield.Value = keepMetafieldReferences(ctx, r.client, metafield, data.Value)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, convertOwnerMetafieldToResourceModel(metafield, data))...)
+}
+
+func (r *ownerMetafieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data, diags := r.get(ctx, req.Plan.GetAttribute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metafield, err := r.setMetafield(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to set "+r.owner.subject(), err))
+		return
+	}
+
+	metafield.Value = keepMetafieldReferences(ctx, r.client, metafield, data.Value)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, convertOwnerMetafieldToResourceModel(metafield, data))...)
+}
+
+func (r *ownerMetafieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data, diags := r.get(ctx, req.State.GetAttribute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownerID, err := r.ownerGID(data.OwnerID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(r.owner.attribute), "Invalid "+r.owner.attribute, err.Error())
+		return
+	}
+	err = r.client.DeleteMetafields(ctx, []*shopify.MetafieldIdentifierInput{{
+		OwnerID:   ownerID,
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	}})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete "+r.owner.subject(), err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a "+r.owner.subject(), map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *ownerMetafieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if r.owner.resolveID != nil {
+		namespace, key, ok := strings.Cut(req.ID, ".")
+		if !ok || namespace == "" || key == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: namespace.key. Got: %q", req.ID),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+		return
+	}
+
+	ownerID, namespace, key, ok := splitOwnerMetafieldID(req.ID, r.owner.gidType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s:namespace.key. Got: %q", r.owner.attribute, req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.owner.attribute), ownerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// get reads the model with the GetAttribute function of the plan or the state.
+func (r *ownerMetafieldResource) get(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics) (ownerMetafieldResourceModel, diag.Diagnostics) {
+	var data ownerMetafieldResourceModel
+	var diags diag.Diagnostics
+	diags.Append(getAttribute(ctx, path.Root("id"), &data.ID)...)
+	diags.Append(getAttribute(ctx, path.Root(r.owner.attribute), &data.OwnerID)...)
+	diags.Append(getAttribute(ctx, path.Root("namespace"), &data.Namespace)...)
+	diags.Append(getAttribute(ctx, path.Root("key"), &data.Key)...)
+	diags.Append(getAttribute(ctx, path.Root("type"), &data.Type)...)
+	diags.Append(getAttribute(ctx, path.Root("value"), &data.Value)...)
+	return data, diags
+}
+
+func (r *ownerMetafieldResource) set(ctx context.Context, state *tfsdk.State, data *ownerMetafieldResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), data.ID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.owner.attribute), data.OwnerID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("namespace"), data.Namespace)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("key"), data.Key)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("type"), data.Type)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("value"), data.Value)...)
+	return diags
+}
+
+// resolveOwner sets the ID of the owner implied by the resource, e.g. the shop, when it isn't known yet.
+func (r *ownerMetafieldResource) resolveOwner(ctx context.Context, data *ownerMetafieldResourceModel) error {
+	if r.owner.resolveID == nil || (!data.OwnerID.IsNull() && !data.OwnerID.IsUnknown()) {
+		return nil
+	}
+	ownerID, err := r.owner.resolveID(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	data.OwnerID = types.StringValue(ownerID)
+	return nil
+}
+
+// ownerGID returns the GID of the owner from its numeric ID or GID.
+func (r *ownerMetafieldResource) ownerGID(ownerID types.String) (string, error) {
+	if r.owner.gidType == "" {
+		return parseOwnerGID(ownerID.ValueString())
+	}
+	id, err := utils.ParseNumericID(ownerID.ValueString(), r.owner.gidType)
+	if err != nil {
+		return "", err
+	}
+	return utils.GIDPrefix(r.owner.gidType) + strconv.FormatUint(id, 10), nil
+}
+
+func (r *ownerMetafieldResource) setMetafield(ctx context.Context, data ownerMetafieldResourceModel) (*shopify.Metafield, error) {
+	ownerID, err := r.ownerGID(data.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	metafields, err := r.client.SetMetafields(ctx, []*shopify.MetafieldsSetInput{{
+		OwnerID:   ownerID,
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+		Type:      data.Type.ValueString(),
+		Value:     data.Value.ValueString(),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(metafields) == 0 {
+		return nil, fmt.Errorf("no metafield has been set")
+	}
+	return metafields[0], nil
+}
+
+// attributeSchema returns the schema of the attribute of the ID of the owner, computed for an implied owner.
+func (o metafieldOwner) attributeSchema() schema.StringAttribute {
+	if o.resolveID != nil {
+		return schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The ID of the %s that owns the metafield.", o.name),
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		}
+	}
+	return schema.StringAttribute{
+		MarkdownDescription: o.attributeDescription(),
+		Required:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+}
+
+// attributeDescription returns the description of the attribute of the ID of the owner.
+func (o metafieldOwner) attributeDescription() string {
+	if o.gidType == "" {
+		return "The GID of the owner of the metafield, e.g. `gid://shopify/Product/<id>`."
+	}
+	return fmt.Sprintf("The ID of the %s that owns the metafield. Both the numeric ID and `%s<id>` are accepted.", o.name, utils.GIDPrefix(o.gidType))
+}
+
+// parseOwnerGID checks that the ID of an owner of any type is a GID, e.g. gid://shopify/Product/123, and returns it.
+func parseOwnerGID(id string) (string, error) {
+	rest, ok := strings.CutPrefix(id, "gid://shopify/")
+	gidType, ownerID, found := strings.Cut(rest, "/")
+	if !ok || !found || gidType == "" || ownerID == "" {
+		return "", fmt.Errorf("expected a GID, e.g. gid://shopify/Product/<id>, got %q", id)
+	}
+	return id, nil
+}
+
+// splitOwnerMetafieldID splits the import identifier into the owner ID, the namespace and the key.
+// The owner ID may be a GID of the owner type, or of any type without gidType, which contains colons itself.
+func splitOwnerMetafieldID(id, gidType string) (ownerID, namespace, key string, ok bool) {
+	gidPrefix := utils.GIDPrefix(gidType)
+	if gidType == "" {
+		gidPrefix = "gid://"
+	}
+	prefix := ""
+	if strings.HasPrefix(id, gidPrefix) {
+		prefix = gidPrefix
+	}
+	ownerID, namespacedKey, ok := strings.Cut(strings.TrimPrefix(id, prefix), ":")
+	if !ok || ownerID == "" {
+		return "", "", "", false
+	}
+	namespace, key, ok = strings.Cut(namespacedKey, ".")
+	if !ok || namespace == "" || key == "" {
+		return "", "", "", false
+	}
+	return prefix + ownerID, namespace, key, true
+}
+
+func convertOwnerMetafieldToResourceModel(metafield *shopify.Metafield, data ownerMetafieldResourceModel) *ownerMetafieldResourceModel {
+	return &ownerMetafieldResourceModel{
+		ID:        types.StringValue(metafield.ID),
+		OwnerID:   data.OwnerID,
+		Namespace: types.StringValue(metafield.Namespace),
+		Key:       types.StringValue(metafield.Key),
+		Type:      types.StringValue(metafield.Type),
+		Value:     convertMetafieldValueToModel(metafield.Value, data.Value),
+	}
+}
+
+// convertMetafieldValueToModel keeps the current value when it's the same JSON value written differently,
+// as Shopify normalizes JSON values, not to produce unnecessary diffs.
+func convertMetafieldValueToModel(value string, current types.String) types.String {
+	if current.IsNull() || current.IsUnknown() || current.ValueString() == value {
+		return types.StringValue(value)
+	}
+	if equal, err := utils.JSONEqual(value, current.ValueString()); err == nil && equal {
+		return current
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccOwnerMetafieldResources runs the same steps on every resource sharing ownerMetafieldResource,
+// with the ID of an existing owner taken from the environment variable.
+func TestAccOwnerMetafieldResources(t *testing.T) {
+	for _, tt := range []struct {
+		newResource func() fwresource.Resource
+		ownerIDEnv  string
+	}{
+		{newResource: NewMetafieldResource, ownerIDEnv: "SHOPIFY_TEST_PRODUCT_ID"},
+		{newResource: NewCustomerMetafieldResource, ownerIDEnv: "SHOPIFY_TEST_CUSTOMER_ID"},
+		{newResource: NewBlogMetafieldResource, ownerIDEnv: "SHOPIFY_TEST_BLOG_ID"},
+		{newResource: NewArticleMetafieldResource, ownerIDEnv: "SHOPIFY_TEST_ARTICLE_ID"},
+	} {
+		owner := tt.newResource().(*ownerMetafieldResource).owner
+		resourceType := "shopify_" + strings.ReplaceAll(owner.subject(), " ", "_")
+		t.Run(resourceType, func(t *testing.T) {
+			ownerID := envOrSkip(t, tt.ownerIDEnv)
+			address := resourceType + ".test"
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					// Create and Read testing
+					{
+						Config: testAccOwnerMetafieldResourceConfig(resourceType, owner.attribute, ownerID, "single_line_text_field", `"Handmade"`),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(address, "id"),
+							resource.TestCheckResourceAttr(address, owner.attribute, ownerID),
+							resource.TestCheckResourceAttr(address, "value", "Handmade"),
+						),
+					},
+					// ImportState testing
+					{
+						ResourceName:                         address,
+						ImportState:                          true,
+						ImportStateId:                        ownerID + ":terraform_test.layout",
+						ImportStateVerify:                    true,
+						ImportStateVerifyIdentifierAttribute: "namespace",
+					},
+					// Update and Read testing with a JSON value
+					{
+						Config: testAccOwnerMetafieldResourceConfig(resourceType, owner.attribute, ownerID, "json", `jsonencode({ columns = 2, sidebar = true })`),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(address, "type", "json"),
+							resource.TestCheckResourceAttr(address, "value", `{"columns":2,"sidebar":true}`),
+						),
+					},
+					// The value is kept as written
+					{
+						Config: testAccOwnerMetafieldResourceConfig(resourceType, owner.attribute, ownerID, "json", `"{\"sidebar\": false, \"columns\": 3}"`),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(address, "value", `{"sidebar": false, "columns": 3}`),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccOwnerMetafieldResourceConfig(resourceType, attribute, ownerID, metafieldType, value string) string {
+	return fmt.Sprintf(`
+resource %[1]q "test" {
+  %[2]s = %[3]q
+  namespace = "terraform_test"
+  key       = "layout"
+  type      = %[4]q
+  value     = %[5]s
+}
+`, resourceType, attribute, ownerID, metafieldType, value)
+}
+func TestSplitOwnerMetafieldID(t *testing.T) {
+	tests := []struct {
+		id                      string
+		gidType                 string
+		ownerID, namespace, key string
+		ok                      bool
+	}{
+		{id: "123:custom.tier", gidType: "Customer", ownerID: "123", namespace: "custom", key: "tier", ok: true},
+		{id: "gid://shopify/Customer/123:custom.tier", gidType: "Customer", ownerID: "gid://shopify/Customer/123", namespace: "custom", key: "tier", ok: true},
+		{id: "123:$app:loyalty.tier", gidType: "Customer", ownerID: "123", namespace: "$app:loyalty", key: "tier", ok: true},
+		{id: "gid://shopify/Blog/123:custom.layout", gidType: "Blog", ownerID: "gid://shopify/Blog/123", namespace: "custom", key: "layout", ok: true},
+		{id: "gid://shopify/Product/123:custom.material", gidType: "", ownerID: "gid://shopify/Product/123", namespace: "custom", key: "material", ok: true},
+		{id: "123:custom", gidType: "Customer", ok: false},
+		{id: "custom.tier", gidType: "Customer", ok: false},
+		{id: ":custom.tier", gidType: "Customer", ok: false},
+		{id: "123:.tier", gidType: "Customer", ok: false},
+	}
+	for _, tt := range tests {
+		ownerID, namespace, key, ok := splitOwnerMetafieldID(tt.id, tt.gidType)
+		if ownerID != tt.ownerID || namespace != tt.namespace || key != tt.key || ok != tt.ok {
+			t.Errorf("splitOwnerMetafieldID(%q, %q) = %q, %q, %q, %v", tt.id, tt.gidType, ownerID, namespace, key, ok)
+		}
+	}
+}
+
+func TestParseOwnerGID(t *testing.T) {
+	for _, tt := range []struct {
+		id string
+		ok bool
+	}{
+		{id: "gid://shopify/Product/123", ok: true},
+		{id: "gid://shopify/ProductVariant/456", ok: true},
+		{id: "123", ok: false},
+		{id: "gid://shopify/Product", ok: false},
+		{id: "gid://shopify//123", ok: false},
+		{id: "gid://shopify/Product/", ok: false},
+	} {
+		_, err := parseOwnerGID(tt.id)
+		if (err == nil) != tt.ok {
+			t.Errorf("parseOwnerGID(%q) returned error %v", tt.id, err)
+		}
+	}
+}
+
+func TestConvertMetafieldValueToModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		current types.String
+		want    types.String
+	}{
+		{name: "null", value: `{"a":1}`, current: types.StringNull(), want: types.StringValue(`{"a":1}`)},
+		{name: "equal JSON", value: `{"a":1,"b":2}`, current: types.StringValue(`{ "b": 2, "a": 1 }`), want: types.StringValue(`{ "b": 2, "a": 1 }`)},
+		{name: "different JSON", value: `{"a":1}`, current: types.StringValue(`{"a":2}`), want: types.StringValue(`{"a":1}`)},
+		{name: "not JSON", value: "hello", current: types.StringValue("world"), want: types.StringValue("hello")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertMetafieldValueToModel(tt.value, tt.current); !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -43,6 +47,7 @@ type PageResourceModel struct {
 	TemplateSuffix types.String `tfsdk:"template_suffix"`
 	Published      types.Bool   `tfsdk:"published"`
 	PublishedAt    types.String `tfsdk:"published_at"`
+	APIVersion     types.String `tfsdk:"api_version"`
 }
 
 func (r *PageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,9 +94,14 @@ func (r *PageResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 			},
 			"published_at": schema.StringAttribute{
-				MarkdownDescription: "The date and time (ISO 8601 format) when the page was published.",
+				MarkdownDescription: "The date and time (RFC3339 format in UTC) when the page was published.",
 				Computed:            true,
 			},
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: "The Shopify API version used to manage the page, e.g. `2024-07`, or `" + LatestAPIVersion + "`. " +
+					"It takes precedence over the `api_version` of the provider, which is used when unset. Useful to keep the page on an older version during an API migration.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -118,13 +128,17 @@ func (r *PageResource) Create(ctx context.Context, req resource.CreateRequest, r
 		TemplateSuffix: data.TemplateSuffix.ValueString(),
 		Published:      utils.Ptr(data.Published.ValueBool()),
 	}
-	createdPage, err := r.client.Page().Create(ctx, page)
+	client, diags := r.clientFor(data.APIVersion)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	createdPage, err := client.CreatePage(ctx, page)
 	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to create a page", err.Error()))
+		resp.Diagnostics.Append(pageErrorDiagnostics("Unable to create page", err)...)
 		return
 	}
 
-	createdData := convertPageToResourceModel(createdPage)
+	createdData := convertPageToResourceModel(createdPage, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
 }
 
@@ -135,18 +149,26 @@ func (r *PageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+	id, diags := parsePageID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
-	page, err := r.client.Page().Get(ctx, id, nil)
+	client, diags := r.clientFor(data.APIVersion)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	page, err := client.GetPage(ctx, id)
 	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to get page", err.Error()))
+		resp.Diagnostics.Append(diagFromClientError("Unable to read page", err))
+		return
+	}
+	// Let the plan recreate a page deleted outside of Terraform
+	if page == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, convertPageToResourceModel(page))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertPageToResourceModel(page, data))...)
 }
 
 func (r *PageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -155,9 +177,8 @@ func (r *PageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+	id, diags := parsePageID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
 	page := goshopify.Page{
@@ -169,13 +190,17 @@ func (r *PageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		TemplateSuffix: data.TemplateSuffix.ValueString(),
 		Published:      utils.Ptr(data.Published.ValueBool()),
 	}
-	updatedPage, err := r.client.Page().Update(ctx, page)
+	client, diags := r.clientFor(data.APIVersion)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	updatedPage, err := client.UpdatePage(ctx, page)
 	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to update page", err.Error()))
+		resp.Diagnostics.Append(pageErrorDiagnostics("Unable to update page", err)...)
 		return
 	}
 
-	updatedData := convertPageToResourceModel(updatedPage)
+	updatedData := convertPageToResourceModel(updatedPage, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, updatedData)...)
 }
 
@@ -186,27 +211,92 @@ func (r *PageResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to parse ID", err.Error()))
+	id, diags := parsePageID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	client, diags := r.clientFor(data.APIVersion)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.Page().Delete(ctx, id); err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic("Failed to delete page", err.Error()))
+	if err := client.DeletePage(ctx, id); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete page", err))
 		return
 	}
 }
 
 func (r *PageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if _, err := utils.ParseNumericID(req.ID, "OnlineStorePage"); err == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Otherwise the identifier is the handle of the page
+	pages, err := r.client.ListAllPages(ctx, shopify.PageListFilter{Handle: req.ID})
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to list pages", err))
+		return
+	}
+	if len(pages) == 0 {
+		resp.Diagnostics.AddError("Page not found", fmt.Sprintf("No page has the handle %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatUint(pages[0].Id, 10))...)
 }
 
-func convertPageToResourceModel(page *goshopify.Page) *PageResourceModel {
-	var publishedAt *string
-	if page.PublishedAt != nil {
-		publishedAtStr := page.PublishedAt.String()
-		publishedAt = &publishedAtStr
+// clientFor returns the client of the API version overriding the provider one, or the provider client if it's unset.
+func (r *PageResource) clientFor(apiVersion types.String) (*shopify.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	client, err := r.client.ForAPIVersion(resolveAPIVersion(apiVersion.ValueString()))
+	if err != nil {
+		diags.AddAttributeError(path.Root("api_version"), "Unable to use API version", err.Error())
+		return nil, diags
 	}
+	return client, diags
+}
+
+// pageErrorDiagnostics returns the diagnostics of the error, attaching the messages of a rejected page
+// to the attributes they are about, e.g. "has already been taken" to handle.
+func pageErrorDiagnostics(summary string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var validationErr *shopify.ValidationError
+	if !errors.As(err, &validationErr) {
+		diags.Append(diagFromClientError(summary, err))
+		return diags
+	}
+
+	fields := make([]string, 0, len(validationErr.FieldErrors))
+	for field := range validationErr.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		for _, message := range validationErr.FieldErrors[field] {
+			// The attributes are named after the fields of the REST API
+			if slices.Contains([]string{"handle", "author", "title", "body_html", "template_suffix", "published"}, field) {
+				diags.AddAttributeError(path.Root(field), "Client Error",
+					fmt.Sprintf("[%s] %s, got error: %s %s", shopify.ErrorCodeValidation, summary, field, message))
+				continue
+			}
+			diags.Append(diagFromClientError(summary, &shopify.ValidationError{FieldErrors: map[string][]string{field: {message}}}))
+		}
+	}
+	return diags
+}
+
+// parsePageID parses the numeric ID of the page.
+// The GraphQL global ID, e.g. gid://shopify/OnlineStorePage/123, is accepted as well.
+func parsePageID(id types.String) (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed, err := utils.ParseNumericID(id.ValueString(), "OnlineStorePage")
+	if err != nil {
+		diags.AddError("Failed to parse ID", err.Error())
+		return 0, diags
+	}
+	return parsed, diags
+}
+
+func convertPageToResourceModel(page *goshopify.Page, data PageResourceModel) *PageResourceModel {
 	return &PageResourceModel{
 		ID:             types.StringValue(strconv.FormatUint(page.Id, 10)),
 		Handle:         types.StringValue(page.Handle),
@@ -214,7 +304,8 @@ func convertPageToResourceModel(page *goshopify.Page) *PageResourceModel {
 		Title:          types.StringValue(page.Title),
 		BodyHTML:       types.StringValue(page.BodyHTML),
 		TemplateSuffix: types.StringValue(page.TemplateSuffix),
-		Published:      types.BoolValue(publishedAt != nil),
-		PublishedAt:    types.StringPointerValue(publishedAt),
+		Published:      types.BoolValue(page.PublishedAt != nil),
+		PublishedAt:    convertTimeToModel(page.PublishedAt, data.PublishedAt),
+		APIVersion:     data.APIVersion,
 	}
 }
@@ -1,10 +1,19 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
 )
 
 func TestAccPageResource(t *testing.T) {
@@ -30,6 +39,12 @@ func TestAccPageResource(t *testing.T) {
 				ResourceName: "shopify_page.test",
 				ImportState:  true,
 			},
+			// ImportState by handle testing
+			{
+				ResourceName:  "shopify_page.test",
+				ImportState:   true,
+				ImportStateId: pageHandle,
+			},
 			//// Update and Read testing
 			{
 				Config: testAccPageResourceUpdateConfig(pageHandle),
@@ -46,6 +61,92 @@ func TestAccPageResource(t *testing.T) {
 	})
 }
 
+func TestAccPageResource_apiVersion(t *testing.T) {
+	pageHandle := randResourceID(64)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "shopify_page" "test" {
+  handle      = %[1]q
+  author      = "Author"
+  title       = "Test page"
+  body_html   = "<h1>Test page</h1>"
+  api_version = "2024-07"
+}
+`, pageHandle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_page.test", "handle", pageHandle),
+					resource.TestCheckResourceAttr("shopify_page.test", "api_version", "2024-07"),
+				),
+			},
+		},
+	})
+}
+
+func TestPageResource_readNotFound(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{name: "not found", handler: func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":"Not Found"}`))
+		}},
+		{name: "no page in the body", handler: func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &PageResource{client: newTestShopifyClient(t, tt.handler)}
+			plan := newTestResourcePlan(t, r, &PageResourceModel{
+				ID:    types.StringValue("1"),
+				Title: types.StringValue("About"),
+			})
+			state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+			resp := fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			// The page deleted outside of Terraform is removed from the state, so that the plan recreates it
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("expected the page to be removed from the state, got %s", resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestParsePageID(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    uint64
+		wantErr bool
+	}{
+		{id: "123456789", want: 123456789},
+		{id: "gid://shopify/OnlineStorePage/123456789", want: 123456789},
+		{id: "gid://shopify/Product/123456789", wantErr: true},
+		{id: "page", wantErr: true},
+		{id: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, diags := parsePageID(types.StringValue(tt.id))
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccPageResourceConfig(pageHandle string) string {
 	return fmt.Sprintf(`
 resource "shopify_page" "test" {
@@ -71,3 +172,31 @@ resource "shopify_page" "test" {
 }
 `, pageHandle)
 }
+
+func TestPageErrorDiagnostics(t *testing.T) {
+	diags := pageErrorDiagnostics("Unable to create page", &shopify.ValidationError{
+		FieldErrors: map[string][]string{
+			"handle": {"has already been taken"},
+			"base":   {"Page limit reached"},
+		},
+		Err: errors.New("handle: has already been taken"),
+	})
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diags)
+	}
+	if want := "[SHOPIFY_VALIDATION] Unable to create page, got error: base: Page limit reached"; diags[0].Detail() != want {
+		t.Errorf("got detail %q, want %q", diags[0].Detail(), want)
+	}
+	withPath, ok := diags[1].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("handle")) {
+		t.Errorf("expected the handle error to be attached to the handle attribute, got %v", diags[1])
+	}
+	if want := "[SHOPIFY_VALIDATION] Unable to create page, got error: handle has already been taken"; diags[1].Detail() != want {
+		t.Errorf("got detail %q, want %q", diags[1].Detail(), want)
+	}
+
+	diags = pageErrorDiagnostics("Unable to create page", errors.New("Not Found"))
+	if want := "[SHOPIFY_UNKNOWN] Unable to create page, got error: Not Found"; len(diags) != 1 || diags[0].Detail() != want {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
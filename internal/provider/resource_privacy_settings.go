@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PrivacySettingsResource{}
+var _ resource.ResourceWithImportState = &PrivacySettingsResource{}
+
+// PrivacySettingsResource defines the resource implementation.
+type PrivacySettingsResource struct {
+	client *shopify.Client
+}
+
+func NewPrivacySettingsResource() resource.Resource {
+	return &PrivacySettingsResource{}
+}
+
+// PrivacySettingsResourceModel describes the resource data model.
+type PrivacySettingsResourceModel struct {
+	ID                            types.String `tfsdk:"id"`
+	CookieBannerEnabled           types.Bool   `tfsdk:"cookie_banner_enabled"`
+	CookieBannerAutoManaged       types.Bool   `tfsdk:"cookie_banner_auto_managed"`
+	DataSaleOptOutPageAutoManaged types.Bool   `tfsdk:"data_sale_opt_out_page_auto_managed"`
+	PrivacyPolicyAutoManaged      types.Bool   `tfsdk:"privacy_policy_auto_managed"`
+}
+
+func (r *PrivacySettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privacy_settings"
+}
+
+func (r *PrivacySettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the customer privacy features of the shop: the cookie banner, the data sale opt-out page and the privacy policy. " +
+			"The settings always exist, so creating the resource adopts the current settings, and destroying it only removes it from the state. " +
+			"Settings that aren't configured are left unchanged.\n\n" +
+			"The Admin API can only disable the features, so setting an attribute to `false` disables its feature, " +
+			"while a disabled feature can only be enabled again in the Shopify admin. Requires the `write_privacy_settings` scope.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the shop.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cookie_banner_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cookie banner asking the visitors for their consent is shown on the online store.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cookie_banner_auto_managed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cookie banner is managed by Shopify.",
+				Computed:            true,
+			},
+			"data_sale_opt_out_page_auto_managed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the page letting the customers opt out of the sale of their data is managed by Shopify.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"privacy_policy_auto_managed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the privacy policy is managed by Shopify.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PrivacySettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *PrivacySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PrivacySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopt the current settings, disabling only the configured features
+	settings := r.apply(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdData := convertPrivacySettingsToResourceModel(settings)
+	tflog.Trace(ctx, "adopted the privacy settings", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *PrivacySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PrivacySettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetPrivacySettings(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read privacy settings", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertPrivacySettingsToResourceModel(settings))...)
+}
+
+func (r *PrivacySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PrivacySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := r.apply(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertPrivacySettingsToResourceModel(settings))...)
+}
+
+func (r *PrivacySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The privacy settings can't be deleted, so leave them as they are.
+	tflog.Trace(ctx, "removed the privacy settings from the state")
+}
+
+func (r *PrivacySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply disables the features configured as disabled and returns the resulting settings.
+func (r *PrivacySettingsResource) apply(ctx context.Context, data PrivacySettingsResourceModel, diags *diag.Diagnostics) *shopify.PrivacySettings {
+	settings, err := r.client.GetPrivacySettings(ctx)
+	if err != nil {
+		diags.Append(diagFromClientError("Unable to read privacy settings", err))
+		return nil
+	}
+	features, featureDiags := privacyFeaturesToDisable(data, settings)
+	if diags.Append(featureDiags...); diags.HasError() || len(features) == 0 {
+		return settings
+	}
+	if err := r.client.DisablePrivacyFeatures(ctx, features); err != nil {
+		diags.Append(diagFromClientError("Unable to disable privacy features", err))
+		return nil
+	}
+	settings, err = r.client.GetPrivacySettings(ctx)
+	if err != nil {
+		diags.Append(diagFromClientError("Unable to read privacy settings", err))
+		return nil
+	}
+	return settings
+}
+
+// privacyFeaturesToDisable returns the features configured as disabled that are enabled now.
+// The features configured as enabled must be enabled already, as the API can't enable them.
+func privacyFeaturesToDisable(data PrivacySettingsResourceModel, current *shopify.PrivacySettings) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var features []string
+	model := convertPrivacySettingsToResourceModel(current)
+	for _, setting := range []struct {
+		attribute string
+		feature   string
+		planned   types.Bool
+		current   types.Bool
+	}{
+		{"cookie_banner_enabled", shopify.PrivacyFeatureCookieBanner, data.CookieBannerEnabled, model.CookieBannerEnabled},
+		{"data_sale_opt_out_page_auto_managed", shopify.PrivacyFeatureDataSaleOptOutPage, data.DataSaleOptOutPageAutoManaged, model.DataSaleOptOutPageAutoManaged},
+		{"privacy_policy_auto_managed", shopify.PrivacyFeaturePrivacyPolicy, data.PrivacyPolicyAutoManaged, model.PrivacyPolicyAutoManaged},
+	} {
+		if setting.planned.IsNull() || setting.planned.IsUnknown() || setting.planned.Equal(setting.current) {
+			continue
+		}
+		if setting.planned.ValueBool() {
+			diags.AddAttributeError(path.Root(setting.attribute), fmt.Sprintf("Unable to enable %s", setting.attribute),
+				"The Admin API can only disable the privacy features. Enable it in the Shopify admin, under Settings > Customer privacy.")
+			continue
+		}
+		features = append(features, setting.feature)
+	}
+	return features, diags
+}
+
+func convertPrivacySettingsToResourceModel(settings *shopify.PrivacySettings) *PrivacySettingsResourceModel {
+	data := &PrivacySettingsResourceModel{
+		ID:                            types.StringValue(settings.ShopID),
+		CookieBannerEnabled:           types.BoolValue(false),
+		CookieBannerAutoManaged:       types.BoolValue(false),
+		DataSaleOptOutPageAutoManaged: types.BoolValue(false),
+		PrivacyPolicyAutoManaged:      types.BoolValue(false),
+	}
+	if settings.Banner != nil {
+		data.CookieBannerEnabled = types.BoolValue(settings.Banner.Enabled)
+		data.CookieBannerAutoManaged = types.BoolValue(settings.Banner.AutoManaged)
+	}
+	if settings.DataSaleOptOutPage != nil {
+		data.DataSaleOptOutPageAutoManaged = types.BoolValue(settings.DataSaleOptOutPage.AutoManaged)
+	}
+	if settings.PrivacyPolicy != nil {
+		data.PrivacyPolicyAutoManaged = types.BoolValue(settings.PrivacyPolicy.AutoManaged)
+	}
+	return data
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccPrivacySettingsResource(t *testing.T) {
+	// Disabled features can only be enabled again in the Shopify admin, so the test only adopts the current settings
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "shopify_privacy_settings" "test" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("shopify_privacy_settings.test", "id"),
+					resource.TestCheckResourceAttrSet("shopify_privacy_settings.test", "cookie_banner_enabled"),
+					resource.TestCheckResourceAttrSet("shopify_privacy_settings.test", "privacy_policy_auto_managed"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_privacy_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPrivacyFeaturesToDisable(t *testing.T) {
+	current := &shopify.PrivacySettings{Banner: &shopify.CookieBanner{Enabled: true}}
+
+	features, diags := privacyFeaturesToDisable(PrivacySettingsResourceModel{
+		CookieBannerEnabled:           types.BoolValue(false),
+		DataSaleOptOutPageAutoManaged: types.BoolValue(false),
+		PrivacyPolicyAutoManaged:      types.BoolNull(),
+	}, current)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	// The data sale opt-out page is disabled already
+	if len(features) != 1 || features[0] != shopify.PrivacyFeatureCookieBanner {
+		t.Errorf("expected only the cookie banner to be disabled, got %v", features)
+	}
+
+	_, diags = privacyFeaturesToDisable(PrivacySettingsResourceModel{
+		PrivacyPolicyAutoManaged: types.BoolValue(true),
+	}, current)
+	if !diags.HasError() {
+		t.Error("expected enabling a disabled feature to fail")
+	}
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+	"github.com/zero-clor/terraform-provider-shopify/pkg/xslice"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProductOptionResource{}
+var _ resource.ResourceWithImportState = &ProductOptionResource{}
+var _ resource.ResourceWithValidateConfig = &ProductOptionResource{}
+var _ resource.ResourceWithModifyPlan = &ProductOptionResource{}
+
+// ProductOptionResource defines the resource implementation.
+type ProductOptionResource struct {
+	client *shopify.Client
+}
+
+func NewProductOptionResource() resource.Resource {
+	return &ProductOptionResource{}
+}
+
+// ProductOptionResourceModel describes the resource data model.
+type ProductOptionResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ProductID types.String   `tfsdk:"product_id"`
+	Name      types.String   `tfsdk:"name"`
+	Values    []types.String `tfsdk:"values"`
+	Position  types.Int64    `tfsdk:"position"`
+}
+
+func (r *ProductOptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_option"
+}
+
+func (r *ProductOptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an option of a product, e.g. Size or Color, whose values make the variants of the product.\n\n" +
+			"Removing a value used by variants of the product affects those variants, so the plan warns about it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the product option.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the product, e.g. `gid://shopify/Product/1234567890`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the option, unique in the product, e.g. `Size`.",
+				Required:            true,
+			},
+			"values": schema.ListAttribute{
+				MarkdownDescription: "The values of the option, e.g. `[\"S\", \"M\", \"L\"]`. Values are matched by name, so renaming a value deletes it and adds a new one.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"position": schema.Int64Attribute{
+				MarkdownDescription: "The position of the option in the product, starting at 1. Defaults to the last position.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProductOptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *ProductOptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProductOptionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ProductID.IsNull() && !data.ProductID.IsUnknown() {
+		if prefix := utils.GIDPrefix("Product"); !strings.HasPrefix(data.ProductID.ValueString(), prefix) {
+			resp.Diagnostics.AddAttributeError(path.Root("product_id"), "Invalid product_id",
+				fmt.Sprintf("expected %s<id>, got %q", prefix, data.ProductID.ValueString()))
+		}
+	}
+	seen := map[string]bool{}
+	for i, value := range data.Values {
+		if value.IsUnknown() {
+			continue
+		}
+		if seen[value.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("values").AtListIndex(i), "Duplicate option value",
+				fmt.Sprintf("The value %q is listed more than once.", value.ValueString()))
+		}
+		seen[value.ValueString()] = true
+	}
+}
+
+func (r *ProductOptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is removed on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state ProductOptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	removed := removedProductOptionValues(plan.Values, state.Values)
+	if len(removed) == 0 {
+		return
+	}
+
+	option, err := r.client.GetProductOption(ctx, state.ProductID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read product option", err))
+		return
+	}
+	if option == nil {
+		return
+	}
+	var used []string
+	for _, value := range option.OptionValues {
+		if value.HasVariants && removed[value.Name] {
+			used = append(used, fmt.Sprintf("%q", value.Name))
+		}
+	}
+	if len(used) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("values"),
+			"Removing option values used by variants",
+			fmt.Sprintf("The values %s of the option %q are used by existing variants of the product, which are affected by removing them.",
+				strings.Join(used, ", "), state.Name.ValueString()),
+		)
+	}
+}
+
+func (r *ProductOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProductOptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &shopify.OptionCreateInput{
+		Name:     data.Name.ValueString(),
+		Position: data.Position.ValueInt64Pointer(),
+	}
+	for _, value := range data.Values {
+		input.Values = append(input.Values, &shopify.OptionValueCreateInput{Name: value.ValueString()})
+	}
+	option, err := r.client.CreateProductOption(ctx, data.ProductID.ValueString(), input)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create product option", err))
+		return
+	}
+	if option == nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create product option", errors.New("the option isn't in the product")))
+		return
+	}
+	tflog.Trace(ctx, "created a product option", map[string]interface{}{
+		"id": option.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertProductOptionToResourceModel(option, data))...)
+}
+
+func (r *ProductOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProductOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	option, err := r.client.GetProductOption(ctx, data.ProductID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read product option", err))
+		return
+	}
+	if option == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertProductOptionToResourceModel(option, data))...)
+}
+
+func (r *ProductOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ProductOptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Diff against the current values in Shopify, which knows the IDs of the values to delete
+	current, err := r.client.GetProductOption(ctx, data.ProductID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read product option", err))
+		return
+	}
+	if current == nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update product option", errors.New("the option doesn't exist")))
+		return
+	}
+	valuesToAdd, valuesToDelete := diffProductOptionValues(data.Values, current.OptionValues)
+	input := &shopify.OptionUpdateInput{
+		ID:       state.ID.ValueString(),
+		Name:     data.Name.ValueString(),
+		Position: data.Position.ValueInt64Pointer(),
+	}
+	option, err := r.client.UpdateProductOption(ctx, data.ProductID.ValueString(), input, valuesToAdd, valuesToDelete)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update product option", err))
+		return
+	}
+	if option == nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update product option", errors.New("the option isn't in the product")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertProductOptionToResourceModel(option, data))...)
+}
+
+func (r *ProductOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProductOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteProductOption(ctx, data.ProductID.ValueString(), data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete product option", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a product option", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *ProductOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Both IDs are GIDs, which contain colons themselves
+	i := strings.LastIndex(req.ID, ":gid://")
+	if i <= 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: product_id:option_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("product_id"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID[i+1:])...)
+}
+
+// removedProductOptionValues returns the values in the state which aren't planned anymore.
+func removedProductOptionValues(plan, state []types.String) map[string]bool {
+	removed := map[string]bool{}
+	for _, value := range state {
+		if _, ok := xslice.FindBy(plan, func(v types.String) bool { return v.Equal(value) }); !ok {
+			removed[value.ValueString()] = true
+		}
+	}
+	// The removed values can't be known until the plan is known
+	for _, value := range plan {
+		if value.IsUnknown() {
+			return nil
+		}
+	}
+	return removed
+}
+
+// diffProductOptionValues returns the planned values to add to the option and the IDs of the current values to delete.
+func diffProductOptionValues(values []types.String, current []*shopify.ProductOptionValue) ([]*shopify.OptionValueCreateInput, []string) {
+	var valuesToAdd []*shopify.OptionValueCreateInput
+	for _, value := range values {
+		if _, ok := xslice.FindBy(current, func(v *shopify.ProductOptionValue) bool { return v.Name == value.ValueString() }); !ok {
+			valuesToAdd = append(valuesToAdd, &shopify.OptionValueCreateInput{Name: value.ValueString()})
+		}
+	}
+	var valuesToDelete []string
+	for _, currentValue := range current {
+		if _, ok := xslice.FindBy(values, func(v types.String) bool { return v.ValueString() == currentValue.Name }); !ok {
+			valuesToDelete = append(valuesToDelete, currentValue.ID)
+		}
+	}
+	return valuesToAdd, valuesToDelete
+}
+
+func convertProductOptionToResourceModel(option *shopify.ProductOption, data ProductOptionResourceModel) *ProductOptionResourceModel {
+	values := make([]types.String, 0, len(option.OptionValues))
+	for _, value := range option.OptionValues {
+		values = append(values, types.StringValue(value.Name))
+	}
+	// Sort values by order in the original data not to produce unnecessary diffs, as Shopify appends the added values
+	order := func(value types.String) int {
+		for i, v := range data.Values {
+			if v.Equal(value) {
+				return i
+			}
+		}
+		return len(data.Values)
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return order(values[i]) < order(values[j])
+	})
+
+	return &ProductOptionResourceModel{
+		ID:        types.StringValue(option.ID),
+		ProductID: data.ProductID,
+		Name:      types.StringValue(option.Name),
+		Values:    values,
+		Position:  types.Int64Value(option.Position),
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccProductOptionResource(t *testing.T) {
+	productID := envOrSkip(t, "SHOPIFY_TEST_PRODUCT_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductOptionResourceConfig(productID, `["S", "M", "L"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("shopify_product_option.test", "id"),
+					resource.TestCheckResourceAttrSet("shopify_product_option.test", "position"),
+					resource.TestCheckResourceAttr("shopify_product_option.test", "values.#", "3"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_product_option.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProductOptionImportStateIdFunc("shopify_product_option.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProductOptionResourceConfig(productID, `["S", "L", "XL"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_product_option.test", "values.#", "3"),
+					resource.TestCheckResourceAttr("shopify_product_option.test", "values.1", "L"),
+					resource.TestCheckResourceAttr("shopify_product_option.test", "values.2", "XL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProductOptionResourceConfig(productID, values string) string {
+	return fmt.Sprintf(`
+resource "shopify_product_option" "test" {
+  product_id = %[1]q
+  name       = "Terraform Test Size"
+  values     = %[2]s
+}
+`, productID, values)
+}
+
+func testAccProductOptionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["product_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+func TestDiffProductOptionValues(t *testing.T) {
+	current := []*shopify.ProductOptionValue{
+		{ID: "gid://shopify/ProductOptionValue/1", Name: "S"},
+		{ID: "gid://shopify/ProductOptionValue/2", Name: "M", HasVariants: true},
+		{ID: "gid://shopify/ProductOptionValue/3", Name: "L"},
+	}
+	valuesToAdd, valuesToDelete := diffProductOptionValues(
+		[]types.String{types.StringValue("S"), types.StringValue("L"), types.StringValue("XL")},
+		current,
+	)
+	if want := []*shopify.OptionValueCreateInput{{Name: "XL"}}; !reflect.DeepEqual(valuesToAdd, want) {
+		t.Errorf("valuesToAdd = %v, want %v", valuesToAdd, want)
+	}
+	if want := []string{"gid://shopify/ProductOptionValue/2"}; !reflect.DeepEqual(valuesToDelete, want) {
+		t.Errorf("valuesToDelete = %v, want %v", valuesToDelete, want)
+	}
+}
+
+func TestRemovedProductOptionValues(t *testing.T) {
+	state := []types.String{types.StringValue("S"), types.StringValue("M"), types.StringValue("L")}
+	removed := removedProductOptionValues([]types.String{types.StringValue("S"), types.StringValue("XL")}, state)
+	if want := map[string]bool{"M": true, "L": true}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("removed = %v, want %v", removed, want)
+	}
+	if removed := removedProductOptionValues([]types.String{types.StringUnknown()}, state); removed != nil {
+		t.Errorf("removed = %v, want nil for an unknown plan", removed)
+	}
+}
+
+func TestConvertProductOptionToResourceModel(t *testing.T) {
+	option := &shopify.ProductOption{
+		ID:       "gid://shopify/ProductOption/1",
+		Name:     "Size",
+		Position: 1,
+		OptionValues: []*shopify.ProductOptionValue{
+			{Name: "M"}, {Name: "XL"}, {Name: "S"},
+		},
+	}
+	data := ProductOptionResourceModel{
+		ProductID: types.StringValue("gid://shopify/Product/1"),
+		Values:    []types.String{types.StringValue("S"), types.StringValue("M")},
+	}
+	got := convertProductOptionToResourceModel(option, data)
+	want := []types.String{types.StringValue("S"), types.StringValue("M"), types.StringValue("XL")}
+	if !reflect.DeepEqual(got.Values, want) {
+		t.Errorf("Values = %v, want %v", got.Values, want)
+	}
+}
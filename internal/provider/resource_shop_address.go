@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ShopAddressResource{}
+var _ resource.ResourceWithImportState = &ShopAddressResource{}
+
+// ShopAddressResource defines the resource implementation.
+type ShopAddressResource struct {
+	client *shopify.Client
+}
+
+func NewShopAddressResource() resource.Resource {
+	return &ShopAddressResource{}
+}
+
+// ShopAddressResourceModel describes the resource data model.
+type ShopAddressResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Address1     types.String `tfsdk:"address1"`
+	Address2     types.String `tfsdk:"address2"`
+	City         types.String `tfsdk:"city"`
+	Zip          types.String `tfsdk:"zip"`
+	Phone        types.String `tfsdk:"phone"`
+	Province     types.String `tfsdk:"province"`
+	ProvinceCode types.String `tfsdk:"province_code"`
+	Country      types.String `tfsdk:"country"`
+	CountryCode  types.String `tfsdk:"country_code"`
+}
+
+func (r *ShopAddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shop_address"
+}
+
+func (r *ShopAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The writable fields are adopted as they are unless they're configured
+	writable := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the billing address of the shop, i.e. its address as a business, shown on the invoices of Shopify and used to calculate taxes. " +
+			"The address always exists, so creating the resource adopts the current address, and destroying it only removes it from the state, " +
+			"leaving the address as it is. Fields that aren't configured are left unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the shop.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address1":      writable("The first line of the address, e.g. the street."),
+			"address2":      writable("The second line of the address, e.g. the suite. Set it to an empty string to clear it."),
+			"city":          writable("The city of the address."),
+			"zip":           writable("The postal code of the address."),
+			"phone":         writable("The phone number of the shop."),
+			"province_code": writable("The code of the province or the state of the address, e.g. `NY`, for the countries that have them."),
+			"country_code":  writable("The ISO 3166-1 alpha-2 code of the country of the address, e.g. `US`."),
+			"province": schema.StringAttribute{
+				MarkdownDescription: "The name of the province or the state of the address. It follows `province_code`.",
+				Computed:            true,
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "The name of the country of the address. It follows `country_code`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ShopAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *ShopAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ShopAddressResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopt the current address, changing only the configured fields
+	address, err := r.client.GetShopAddress(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read shop address", err))
+		return
+	}
+	if input := convertShopAddressResourceModelToInput(data, address); input != nil {
+		address, err = r.client.UpdateShopAddress(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update shop address", err))
+			return
+		}
+	}
+
+	createdData := convertShopAddressToResourceModel(address)
+	tflog.Trace(ctx, "adopted the shop address", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *ShopAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ShopAddressResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address, err := r.client.GetShopAddress(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read shop address", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertShopAddressToResourceModel(address))...)
+}
+
+func (r *ShopAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ShopAddressResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := &shopify.ShopAddress{
+		ShopID:        state.ID.ValueString(),
+		Address1:      state.Address1.ValueString(),
+		Address2:      state.Address2.ValueString(),
+		City:          state.City.ValueString(),
+		Zip:           state.Zip.ValueString(),
+		Phone:         state.Phone.ValueString(),
+		Province:      state.Province.ValueString(),
+		ProvinceCode:  state.ProvinceCode.ValueString(),
+		Country:       state.Country.ValueString(),
+		CountryCodeV2: state.CountryCode.ValueString(),
+	}
+	if input := convertShopAddressResourceModelToInput(data, address); input != nil {
+		var err error
+		address, err = r.client.UpdateShopAddress(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update shop address", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertShopAddressToResourceModel(address))...)
+}
+
+func (r *ShopAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The shop address can't be deleted, so leave it as it is.
+	tflog.Trace(ctx, "removed the shop address from the state")
+}
+
+func (r *ShopAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// convertShopAddressResourceModelToInput returns the input to change the configured fields
+// that differ from the current ones, or nil if there is nothing to change.
+// A changed country is sent along with the configured province, as the current province may not belong to the new country.
+func convertShopAddressResourceModelToInput(data ShopAddressResourceModel, current *shopify.ShopAddress) *shopify.ShopAddressInput {
+	changed := func(planned types.String, current string) *string {
+		if planned.IsNull() || planned.IsUnknown() || planned.ValueString() == current {
+			return nil
+		}
+		return planned.ValueStringPointer()
+	}
+	input := &shopify.ShopAddressInput{
+		Address1:     changed(data.Address1, current.Address1),
+		Address2:     changed(data.Address2, current.Address2),
+		City:         changed(data.City, current.City),
+		Zip:          changed(data.Zip, current.Zip),
+		Phone:        changed(data.Phone, current.Phone),
+		ProvinceCode: changed(data.ProvinceCode, current.ProvinceCode),
+		CountryCode:  changed(data.CountryCode, current.CountryCodeV2),
+	}
+	if input.CountryCode != nil && input.ProvinceCode == nil && !data.ProvinceCode.IsNull() && !data.ProvinceCode.IsUnknown() {
+		input.ProvinceCode = data.ProvinceCode.ValueStringPointer()
+	}
+	if *input == (shopify.ShopAddressInput{}) {
+		return nil
+	}
+	return input
+}
+
+func convertShopAddressToResourceModel(address *shopify.ShopAddress) *ShopAddressResourceModel {
+	return &ShopAddressResourceModel{
+		ID:           types.StringValue(address.ShopID),
+		Address1:     types.StringValue(address.Address1),
+		Address2:     types.StringValue(address.Address2),
+		City:         types.StringValue(address.City),
+		Zip:          types.StringValue(address.Zip),
+		Phone:        types.StringValue(address.Phone),
+		Province:     types.StringValue(address.Province),
+		ProvinceCode: types.StringValue(address.ProvinceCode),
+		Country:      types.StringValue(address.Country),
+		CountryCode:  types.StringValue(address.CountryCodeV2),
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccShopAddressResource(t *testing.T) {
+	// The test changes the address of the shop, so it only runs when explicitly enabled
+	envOrSkip(t, "SHOPIFY_TEST_SHOP_ADDRESS")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccShopAddressResourceConfig("Suite 100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_shop_address.test", "address2", "Suite 100"),
+					resource.TestCheckResourceAttr("shopify_shop_address.test", "province", "New York"),
+					resource.TestCheckResourceAttr("shopify_shop_address.test", "country", "United States"),
+					resource.TestCheckResourceAttrSet("shopify_shop_address.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccShopAddressResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_shop_address.test", "address2", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccShopAddressResourceConfig(address2 string) string {
+	return fmt.Sprintf(`
+resource "shopify_shop_address" "test" {
+  address1      = "1 Main Street"
+  address2      = %[1]q
+  city          = "New York"
+  zip           = "10001"
+  province_code = "NY"
+  country_code  = "US"
+}
+`, address2)
+}
+
+func TestConvertShopAddressResourceModelToInput(t *testing.T) {
+	current := &shopify.ShopAddress{Address1: "1 Main Street", Address2: "Suite 100", City: "New York", ProvinceCode: "NY", CountryCodeV2: "US"}
+
+	input := convertShopAddressResourceModelToInput(ShopAddressResourceModel{
+		Address1: types.StringValue("1 Main Street"),
+		Address2: types.StringValue(""),
+		City:     types.StringNull(),
+		Zip:      types.StringUnknown(),
+	}, current)
+	if input == nil || input.Address2 == nil || *input.Address2 != "" || input.Address1 != nil || input.City != nil || input.Zip != nil {
+		t.Errorf("expected only address2 to be cleared, got %+v", input)
+	}
+
+	// The configured province is sent along with the changed country
+	input = convertShopAddressResourceModelToInput(ShopAddressResourceModel{
+		ProvinceCode: types.StringValue("NY"),
+		CountryCode:  types.StringValue("CA"),
+	}, current)
+	if input == nil || input.CountryCode == nil || *input.CountryCode != "CA" || input.ProvinceCode == nil || *input.ProvinceCode != "NY" {
+		t.Errorf("expected the country and the province to be sent, got %+v", input)
+	}
+
+	input = convertShopAddressResourceModelToInput(ShopAddressResourceModel{
+		City:        types.StringValue("New York"),
+		CountryCode: types.StringValue("US"),
+	}, current)
+	if input != nil {
+		t.Errorf("expected no change, got %+v", input)
+	}
+}
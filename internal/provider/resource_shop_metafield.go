@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func NewShopMetafieldResource() resource.Resource {
+	return &ownerMetafieldResource{owner: metafieldOwner{
+		name:        "shop",
+		gidType:     "Shop",
+		attribute:   "owner_id",
+		description: "Manages a metafield of the shop, e.g. a store-wide setting used by the theme.",
+		resolveID:   resolveShopGID,
+	}}
+}
+
+// resolveShopGID returns the GID of the shop, which owns the shop metafields.
+func resolveShopGID(ctx context.Context, client *shopify.Client) (string, error) {
+	shop, err := client.GetShop(ctx)
+	if err != nil {
+		return "", err
+	}
+	return shop.ID, nil
+}
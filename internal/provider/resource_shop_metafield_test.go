@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccShopMetafieldResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "shopify_shop_metafield" "test" {
+  namespace = "terraform_test"
+  key       = "settings"
+  type      = "json"
+  value     = jsonencode({ enabled = true, label = "Sale" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("shopify_shop_metafield.test", "id"),
+					resource.TestCheckResourceAttrSet("shopify_shop_metafield.test", "owner_id"),
+					resource.TestCheckResourceAttr("shopify_shop_metafield.test", "value", `{"enabled":true,"label":"Sale"}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "shopify_shop_metafield.test",
+				ImportState:                          true,
+				ImportStateId:                        "terraform_test.settings",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "namespace",
+			},
+			// Update and Read testing
+			{
+				Config: `
+resource "shopify_shop_metafield" "test" {
+  namespace = "terraform_test"
+  key       = "settings"
+  type      = "json"
+  value     = "{\"label\": \"Sale\", \"enabled\": false}"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_shop_metafield.test", "value", "{\"label\": \"Sale\", \"enabled\": false}"),
+				),
+			},
+		},
+	})
+}
+
+func TestShopMetafieldResource_resolveOwner(t *testing.T) {
+	var requests int
+	r := NewShopMetafieldResource().(*ownerMetafieldResource)
+	r.client = newTestShopifyClient(t, func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"data":{"shop":{"id":"gid://shopify/Shop/1","name":"Test","myshopifyDomain":"test.myshopify.com"}}}`))
+	})
+	ctx := context.Background()
+
+	// The owner of an imported metafield is unknown until it's read
+	data := ownerMetafieldResourceModel{OwnerID: types.StringNull()}
+	if err := r.resolveOwner(ctx, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data.OwnerID.ValueString() != "gid://shopify/Shop/1" {
+		t.Errorf("unexpected owner id: %s", data.OwnerID)
+	}
+
+	// The resolved value is kept without querying the shop again
+	if err := r.resolveOwner(ctx, &data); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ShopSettingsResource{}
+var _ resource.ResourceWithImportState = &ShopSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &ShopSettingsResource{}
+
+// ShopSettingsResource defines the resource implementation.
+type ShopSettingsResource struct {
+	client *shopify.Client
+}
+
+func NewShopSettingsResource() resource.Resource {
+	return &ShopSettingsResource{}
+}
+
+// ShopSettingsResourceModel describes the resource data model.
+type ShopSettingsResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	MoneyFormat             types.String `tfsdk:"money_format"`
+	MoneyWithCurrencyFormat types.String `tfsdk:"money_with_currency_format"`
+	WeightUnit              types.String `tfsdk:"weight_unit"`
+	Timezone                types.String `tfsdk:"timezone"`
+	CustomerEmail           types.String `tfsdk:"customer_email"`
+}
+
+func (r *ShopSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shop_settings"
+}
+
+func (r *ShopSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the general settings of the shop. The settings always exist, so creating the resource adopts the current settings, " +
+			"and destroying it only removes it from the state, leaving the settings as they are. Settings that aren't configured are left unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the shop.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"money_format": schema.StringAttribute{
+				MarkdownDescription: "The format of prices without the currency, e.g. `${{amount}}`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"money_with_currency_format": schema.StringAttribute{
+				MarkdownDescription: "The format of prices with the currency, e.g. `${{amount}} USD`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"weight_unit": schema.StringAttribute{
+				MarkdownDescription: "The default unit of weight of the products, one of `GRAMS`, `KILOGRAMS`, `OUNCES` or `POUNDS`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The IANA timezone of the shop, e.g. `America/New_York`. It can only be changed in the Shopify admin.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_email": schema.StringAttribute{
+				MarkdownDescription: "The email address customers are sent emails from and can reply to.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ShopSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *ShopSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ShopSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.WeightUnit.IsNull() && !data.WeightUnit.IsUnknown() && !slices.Contains(shopify.ShopWeightUnits, data.WeightUnit.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("weight_unit"), "Invalid weight_unit",
+			fmt.Sprintf("expected one of %s, got %q", strings.Join(shopify.ShopWeightUnits, ", "), data.WeightUnit.ValueString()))
+	}
+}
+
+func (r *ShopSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ShopSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopt the current settings, changing only the configured ones
+	settings, err := r.client.GetShopSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read shop settings", err))
+		return
+	}
+	if input := convertShopSettingsResourceModelToInput(data, settings); input != nil {
+		settings, err = r.client.UpdateShopSettings(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update shop settings", err))
+			return
+		}
+	}
+
+	createdData := convertShopSettingsToResourceModel(settings)
+	tflog.Trace(ctx, "adopted the shop settings", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *ShopSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ShopSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetShopSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read shop settings", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertShopSettingsToResourceModel(settings))...)
+}
+
+func (r *ShopSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ShopSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := &shopify.ShopSettings{
+		ShopID:       state.ID.ValueString(),
+		WeightUnit:   state.WeightUnit.ValueString(),
+		IanaTimezone: state.Timezone.ValueString(),
+		ContactEmail: state.CustomerEmail.ValueString(),
+	}
+	settings.CurrencyFormats.MoneyFormat = state.MoneyFormat.ValueString()
+	settings.CurrencyFormats.MoneyWithCurrencyFormat = state.MoneyWithCurrencyFormat.ValueString()
+	if input := convertShopSettingsResourceModelToInput(data, settings); input != nil {
+		var err error
+		settings, err = r.client.UpdateShopSettings(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update shop settings", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertShopSettingsToResourceModel(settings))...)
+}
+
+func (r *ShopSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The shop settings can't be deleted, so leave them as they are.
+	tflog.Trace(ctx, "removed the shop settings from the state")
+}
+
+func (r *ShopSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// convertShopSettingsResourceModelToInput returns the input to change the configured settings
+// that differ from the current ones, or nil if there is nothing to change.
+// The timezone isn't writable through the API, so it's never part of the input.
+func convertShopSettingsResourceModelToInput(data ShopSettingsResourceModel, current *shopify.ShopSettings) *shopify.ShopSettingsInput {
+	changed := func(planned types.String, current string) *string {
+		if planned.IsNull() || planned.IsUnknown() || planned.ValueString() == current {
+			return nil
+		}
+		return planned.ValueStringPointer()
+	}
+	input := &shopify.ShopSettingsInput{
+		MoneyFormat:             changed(data.MoneyFormat, current.CurrencyFormats.MoneyFormat),
+		MoneyWithCurrencyFormat: changed(data.MoneyWithCurrencyFormat, current.CurrencyFormats.MoneyWithCurrencyFormat),
+		WeightUnit:              changed(data.WeightUnit, current.WeightUnit),
+		ContactEmail:            changed(data.CustomerEmail, current.ContactEmail),
+	}
+	if input.MoneyFormat == nil && input.MoneyWithCurrencyFormat == nil && input.WeightUnit == nil && input.ContactEmail == nil {
+		return nil
+	}
+	return input
+}
+
+func convertShopSettingsToResourceModel(settings *shopify.ShopSettings) *ShopSettingsResourceModel {
+	return &ShopSettingsResourceModel{
+		ID:                      types.StringValue(settings.ShopID),
+		MoneyFormat:             types.StringValue(settings.CurrencyFormats.MoneyFormat),
+		MoneyWithCurrencyFormat: types.StringValue(settings.CurrencyFormats.MoneyWithCurrencyFormat),
+		WeightUnit:              types.StringValue(settings.WeightUnit),
+		Timezone:                types.StringValue(settings.IanaTimezone),
+		CustomerEmail:           types.StringValue(settings.ContactEmail),
+	}
+}
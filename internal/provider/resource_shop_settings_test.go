@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccShopSettingsResource(t *testing.T) {
+	// The test changes the settings of the shop, so it only runs when explicitly enabled
+	envOrSkip(t, "SHOPIFY_TEST_SHOP_SETTINGS")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccShopSettingsResourceConfig("KILOGRAMS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_shop_settings.test", "weight_unit", "KILOGRAMS"),
+					resource.TestCheckResourceAttrSet("shopify_shop_settings.test", "money_format"),
+					resource.TestCheckResourceAttrSet("shopify_shop_settings.test", "timezone"),
+					resource.TestCheckResourceAttrSet("shopify_shop_settings.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccShopSettingsResourceConfig("POUNDS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_shop_settings.test", "weight_unit", "POUNDS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShopSettingsResource_invalidWeightUnit(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccShopSettingsResourceConfig("STONES"),
+				ExpectError: regexp.MustCompile(`Invalid weight_unit`),
+			},
+		},
+	})
+}
+
+func testAccShopSettingsResourceConfig(weightUnit string) string {
+	return fmt.Sprintf(`
+resource "shopify_shop_settings" "test" {
+  weight_unit = %[1]q
+}
+`, weightUnit)
+}
+
+func TestConvertShopSettingsResourceModelToInput(t *testing.T) {
+	current := &shopify.ShopSettings{WeightUnit: "KILOGRAMS", ContactEmail: "support@example.com"}
+	current.CurrencyFormats.MoneyFormat = "${{amount}}"
+
+	input := convertShopSettingsResourceModelToInput(ShopSettingsResourceModel{
+		MoneyFormat:             types.StringValue("${{amount}}"),
+		MoneyWithCurrencyFormat: types.StringUnknown(),
+		WeightUnit:              types.StringValue("POUNDS"),
+		Timezone:                types.StringValue("Asia/Tokyo"),
+		CustomerEmail:           types.StringNull(),
+	}, current)
+	if input == nil || input.MoneyFormat != nil || input.MoneyWithCurrencyFormat != nil || input.WeightUnit == nil || *input.WeightUnit != "POUNDS" || input.ContactEmail != nil {
+		t.Errorf("expected only weight_unit to change, got %+v", input)
+	}
+
+	input = convertShopSettingsResourceModelToInput(ShopSettingsResourceModel{
+		MoneyFormat:             types.StringNull(),
+		MoneyWithCurrencyFormat: types.StringNull(),
+		WeightUnit:              types.StringValue("KILOGRAMS"),
+		CustomerEmail:           types.StringValue("support@example.com"),
+	}, current)
+	if input != nil {
+		t.Errorf("expected no change, got %+v", input)
+	}
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SmartCollectionResource{}
+var _ resource.ResourceWithImportState = &SmartCollectionResource{}
+var _ resource.ResourceWithValidateConfig = &SmartCollectionResource{}
+
+var smartCollectionSortOrders = []string{
+	"alpha-asc",
+	"alpha-desc",
+	"best-selling",
+	"created",
+	"created-desc",
+	"manual",
+	"price-asc",
+	"price-desc",
+}
+
+var smartCollectionRuleRelations = []string{
+	"equals",
+	"not_equals",
+	"greater_than",
+	"less_than",
+	"starts_with",
+	"ends_with",
+	"contains",
+	"not_contains",
+	"is_set",
+	"is_not_set",
+}
+
+// SmartCollectionResource defines the resource implementation.
+type SmartCollectionResource struct {
+	client *shopify.Client
+}
+
+func NewSmartCollectionResource() resource.Resource {
+	return &SmartCollectionResource{}
+}
+
+// SmartCollectionResourceModel describes the resource data model.
+type SmartCollectionResourceModel struct {
+	ID          types.String                `tfsdk:"id"`
+	Title       types.String                `tfsdk:"title"`
+	Handle      types.String                `tfsdk:"handle"`
+	SortOrder   types.String                `tfsdk:"sort_order"`
+	Disjunctive types.Bool                  `tfsdk:"disjunctive"`
+	Rules       []*SmartCollectionRuleModel `tfsdk:"rules"`
+}
+
+// SmartCollectionRuleModel describes a rule of the smart collection.
+type SmartCollectionRuleModel struct {
+	Column    types.String `tfsdk:"column"`
+	Relation  types.String `tfsdk:"relation"`
+	Condition types.String `tfsdk:"condition"`
+}
+
+func (r *SmartCollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smart_collection"
+}
+
+func (r *SmartCollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A smart collection, whose products are selected automatically by its rules. " +
+			"The other fields of the collection, e.g. its description, image and publication, are left as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique numeric identifier for the smart collection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The name of the smart collection.",
+				Required:            true,
+			},
+			"handle": schema.StringAttribute{
+				MarkdownDescription: "A unique, human-readable string for the collection, used in its URL. Generated from the title when not configured.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sort_order": schema.StringAttribute{
+				MarkdownDescription: "The order of the products in the collection. Possible values are `alpha-asc`, `alpha-desc`, `best-selling`, `created`, " +
+					"`created-desc`, `manual`, `price-asc` and `price-desc`. Set by Shopify when not configured.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disjunctive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the products must match any of the rules, rather than all of them. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The rules selecting the products of the collection.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"column": schema.StringAttribute{
+							MarkdownDescription: "The property of the products the rule applies to, e.g. `title`, `type`, `vendor`, `tag` or `variant_price`.",
+							Required:            true,
+						},
+						"relation": schema.StringAttribute{
+							MarkdownDescription: "The relation between the column and the condition. Possible values are `equals`, `not_equals`, `greater_than`, " +
+								"`less_than`, `starts_with`, `ends_with`, `contains`, `not_contains`, `is_set` and `is_not_set`.",
+							Required: true,
+						},
+						"condition": schema.StringAttribute{
+							MarkdownDescription: "The value the column is compared with.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SmartCollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *SmartCollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SmartCollectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SortOrder.IsNull() && !data.SortOrder.IsUnknown() && !slices.Contains(smartCollectionSortOrders, data.SortOrder.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sort_order"),
+			"Invalid sort_order",
+			fmt.Sprintf("sort_order must be one of %s, got %q", strings.Join(smartCollectionSortOrders, ", "), data.SortOrder.ValueString()),
+		)
+	}
+	for i, rule := range data.Rules {
+		if rule == nil || rule.Relation.IsNull() || rule.Relation.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(smartCollectionRuleRelations, rule.Relation.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i).AtName("relation"),
+				"Invalid relation",
+				fmt.Sprintf("relation must be one of %s, got %q", strings.Join(smartCollectionRuleRelations, ", "), rule.Relation.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *SmartCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SmartCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := goshopify.SmartCollection{
+		// The collection is sent with every field, so keep the default of Shopify, which publishes the new collections
+		Published: true,
+	}
+	applySmartCollectionResourceModel(&collection, data)
+	createdCollection, err := r.client.CreateSmartCollection(ctx, collection)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create smart collection", err))
+		return
+	}
+	tflog.Trace(ctx, "created a smart collection", map[string]interface{}{
+		"id": createdCollection.Id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertSmartCollectionToResourceModel(createdCollection, data))...)
+}
+
+func (r *SmartCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SmartCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diags := parseSmartCollectionID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection, err := r.client.GetSmartCollection(ctx, id)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read smart collection", err))
+		return
+	}
+	if collection == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertSmartCollectionToResourceModel(collection, data))...)
+}
+
+func (r *SmartCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SmartCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diags := parseSmartCollectionID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The collection is sent with every field, so start from the collection read not to reset the fields which aren't managed
+	collection, err := r.client.GetSmartCollection(ctx, id)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read smart collection", err))
+		return
+	}
+	if collection == nil {
+		resp.Diagnostics.AddError("Smart Collection Not Found", fmt.Sprintf("The smart collection %d has been deleted.", id))
+		return
+	}
+	applySmartCollectionResourceModel(collection, data)
+	updatedCollection, err := r.client.UpdateSmartCollection(ctx, *collection)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update smart collection", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertSmartCollectionToResourceModel(updatedCollection, data))...)
+}
+
+func (r *SmartCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SmartCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diags := parseSmartCollectionID(data.ID)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSmartCollection(ctx, id); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete smart collection", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a smart collection", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *SmartCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// parseSmartCollectionID parses the numeric ID of the smart collection.
+// The GraphQL global ID, e.g. gid://shopify/Collection/123, is accepted as well.
+func parseSmartCollectionID(id types.String) (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed, err := utils.ParseNumericID(id.ValueString(), "Collection")
+	if err != nil {
+		diags.AddError("Failed to parse ID", err.Error())
+		return 0, diags
+	}
+	return parsed, diags
+}
+
+// applySmartCollectionResourceModel sets the managed fields of the collection to the planned ones.
+func applySmartCollectionResourceModel(collection *goshopify.SmartCollection, data SmartCollectionResourceModel) {
+	collection.Title = data.Title.ValueString()
+	if !data.Handle.IsUnknown() {
+		collection.Handle = data.Handle.ValueString()
+	}
+	if !data.SortOrder.IsUnknown() {
+		collection.SortOrder = data.SortOrder.ValueString()
+	}
+	collection.Disjunctive = data.Disjunctive.ValueBool()
+	collection.Rules = make([]goshopify.Rule, len(data.Rules))
+	for i, rule := range data.Rules {
+		collection.Rules[i] = goshopify.Rule{
+			Column:    rule.Column.ValueString(),
+			Relation:  rule.Relation.ValueString(),
+			Condition: rule.Condition.ValueString(),
+		}
+	}
+}
+
+func convertSmartCollectionToResourceModel(collection *goshopify.SmartCollection, data SmartCollectionResourceModel) *SmartCollectionResourceModel {
+	return &SmartCollectionResourceModel{
+		ID:          types.StringValue(strconv.FormatUint(collection.Id, 10)),
+		Title:       types.StringValue(collection.Title),
+		Handle:      types.StringValue(collection.Handle),
+		SortOrder:   types.StringValue(collection.SortOrder),
+		Disjunctive: types.BoolValue(collection.Disjunctive),
+		Rules:       sortSmartCollectionRules(collection.Rules, data.Rules),
+	}
+}
+
+// sortSmartCollectionRules returns the rules in the order of the current ones not to produce unnecessary diffs,
+// as Shopify may return them in another order. Each current rule matches one equal rule,
+// and the unknown rules go last in the order of Shopify, so a fresh import keeps the order of the collection in Shopify.
+func sortSmartCollectionRules(rules []goshopify.Rule, current []*SmartCollectionRuleModel) []*SmartCollectionRuleModel {
+	ruleOrderMap := make(map[goshopify.Rule][]int, len(current))
+	for i, rule := range current {
+		key := goshopify.Rule{Column: rule.Column.ValueString(), Relation: rule.Relation.ValueString(), Condition: rule.Condition.ValueString()}
+		ruleOrderMap[key] = append(ruleOrderMap[key], i)
+	}
+	orders := make([]int, len(rules))
+	for i, rule := range rules {
+		orders[i] = len(current)
+		if indexes := ruleOrderMap[rule]; len(indexes) > 0 {
+			orders[i] = indexes[0]
+			ruleOrderMap[rule] = indexes[1:]
+		}
+	}
+
+	indexes := make([]int, len(rules))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return orders[indexes[i]] < orders[indexes[j]]
+	})
+	models := make([]*SmartCollectionRuleModel, len(rules))
+	for i, index := range indexes {
+		models[i] = &SmartCollectionRuleModel{
+			Column:    types.StringValue(rules[index].Column),
+			Relation:  types.StringValue(rules[index].Relation),
+			Condition: types.StringValue(rules[index].Condition),
+		}
+	}
+	return models
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSmartCollectionResource(t *testing.T) {
+	title := randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSmartCollectionResourceConfig(title, false, `
+    { column = "tag", relation = "equals", condition = "terraform" },
+    { column = "variant_price", relation = "less_than", condition = "50" },`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_smart_collection.test", "title", title),
+					resource.TestCheckResourceAttr("shopify_smart_collection.test", "disjunctive", "false"),
+					resource.TestCheckResourceAttr("shopify_smart_collection.test", "rules.#", "2"),
+					resource.TestCheckResourceAttr("shopify_smart_collection.test", "rules.0.column", "tag"),
+					resource.TestCheckResourceAttrSet("shopify_smart_collection.test", "handle"),
+					resource.TestCheckResourceAttrSet("shopify_smart_collection.test", "sort_order"),
+					resource.TestCheckResourceAttrSet("shopify_smart_collection.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_smart_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Reordering the rules keeps them in the configured order
+			{
+				Config: testAccSmartCollectionResourceConfig(title, true, `
+    { column = "variant_price", relation = "less_than", condition = "50" },
+    { column = "tag", relation = "equals", condition = "terraform" },`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_smart_collection.test", "disjunctive", "true"),
+					resource.TestCheckResourceAttr("shopify_smart_collection.test", "rules.0.column", "variant_price"),
+					resource.TestCheckResourceAttr("shopify_smart_collection.test", "rules.1.column", "tag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSmartCollectionResourceConfig(title string, disjunctive bool, rules string) string {
+	return fmt.Sprintf(`
+resource "shopify_smart_collection" "test" {
+  title       = %[1]q
+  disjunctive = %[2]t
+  rules = [%[3]s
+  ]
+}
+`, title, disjunctive, rules)
+}
+
+func TestSortSmartCollectionRules(t *testing.T) {
+	rule := func(column, condition string) *SmartCollectionRuleModel {
+		return &SmartCollectionRuleModel{Column: types.StringValue(column), Relation: types.StringValue("equals"), Condition: types.StringValue(condition)}
+	}
+	rules := []goshopify.Rule{
+		{Column: "tag", Relation: "equals", Condition: "a"},
+		{Column: "vendor", Relation: "equals", Condition: "b"},
+		{Column: "tag", Relation: "equals", Condition: "a"},
+		{Column: "type", Relation: "equals", Condition: "c"},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		current []*SmartCollectionRuleModel
+		want    []*SmartCollectionRuleModel
+	}{
+		{
+			name:    "import",
+			current: nil,
+			want:    []*SmartCollectionRuleModel{rule("tag", "a"), rule("vendor", "b"), rule("tag", "a"), rule("type", "c")},
+		},
+		{
+			name:    "configured order",
+			current: []*SmartCollectionRuleModel{rule("type", "c"), rule("tag", "a"), rule("vendor", "b"), rule("tag", "a")},
+			want:    []*SmartCollectionRuleModel{rule("type", "c"), rule("tag", "a"), rule("vendor", "b"), rule("tag", "a")},
+		},
+		{
+			name:    "unknown rules last",
+			current: []*SmartCollectionRuleModel{rule("vendor", "b"), rule("tag", "a")},
+			want:    []*SmartCollectionRuleModel{rule("vendor", "b"), rule("tag", "a"), rule("tag", "a"), rule("type", "c")},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sortSmartCollectionRules(rules, tt.current)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
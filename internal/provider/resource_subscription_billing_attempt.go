@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubscriptionBillingAttemptResource{}
+var _ resource.ResourceWithImportState = &SubscriptionBillingAttemptResource{}
+
+// SubscriptionBillingAttemptResource defines the resource implementation.
+type SubscriptionBillingAttemptResource struct {
+	client *shopify.Client
+}
+
+func NewSubscriptionBillingAttemptResource() resource.Resource {
+	return &SubscriptionBillingAttemptResource{}
+}
+
+// SubscriptionBillingAttemptResourceModel describes the resource data model.
+type SubscriptionBillingAttemptResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	SubscriptionContractID types.String `tfsdk:"subscription_contract_id"`
+	IdempotencyKey         types.String `tfsdk:"idempotency_key"`
+	Status                 types.String `tfsdk:"status"`
+	OrderID                types.String `tfsdk:"order_id"`
+	ErrorCode              types.String `tfsdk:"error_code"`
+	ErrorMessage           types.String `tfsdk:"error_message"`
+}
+
+func (r *SubscriptionBillingAttemptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_billing_attempt"
+}
+
+func (r *SubscriptionBillingAttemptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers a billing attempt of a subscription contract, e.g. to test the billing of subscriptions. " +
+			"A billing attempt can't be undone, so changing any argument creates a new attempt and destroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the billing attempt.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_contract_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subscription contract to bill.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"idempotency_key": schema.StringAttribute{
+				MarkdownDescription: "A unique key for the billing attempt. Shopify returns the existing attempt instead of billing the contract again for the same key.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the billing attempt. Possible values are `PENDING`, `SUCCEEDED` and `FAILED`.",
+				Computed:            true,
+			},
+			"order_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the order created by the billing attempt once it succeeded.",
+				Computed:            true,
+			},
+			"error_code": schema.StringAttribute{
+				MarkdownDescription: "The error code of the billing attempt once it failed.",
+				Computed:            true,
+			},
+			"error_message": schema.StringAttribute{
+				MarkdownDescription: "The error message of the billing attempt once it failed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SubscriptionBillingAttemptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *SubscriptionBillingAttemptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubscriptionBillingAttemptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := shopify.SubscriptionBillingAttemptInput{
+		IdempotencyKey: data.IdempotencyKey.ValueString(),
+	}
+	billingAttempt, err := r.client.CreateSubscriptionBillingAttempt(ctx, data.SubscriptionContractID.ValueString(), &input)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create subscription billing attempt", err))
+		return
+	}
+
+	createdData := convertSubscriptionBillingAttemptToResourceModel(billingAttempt, data)
+	tflog.Trace(ctx, "created a subscription billing attempt", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *SubscriptionBillingAttemptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubscriptionBillingAttemptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	billingAttempt, err := r.client.GetSubscriptionBillingAttempt(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read subscription billing attempt", err))
+		return
+	}
+	if billingAttempt == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertSubscriptionBillingAttemptToResourceModel(billingAttempt, data))...)
+}
+
+func (r *SubscriptionBillingAttemptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so there is nothing to update.
+	var data SubscriptionBillingAttemptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionBillingAttemptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubscriptionBillingAttemptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A billing attempt can't be deleted, so it is only removed from the state.
+	tflog.Trace(ctx, "removed a subscription billing attempt from the state", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *SubscriptionBillingAttemptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertSubscriptionBillingAttemptToResourceModel(billingAttempt *shopify.SubscriptionBillingAttempt, data SubscriptionBillingAttemptResourceModel) *SubscriptionBillingAttemptResourceModel {
+	subscriptionContractID := data.SubscriptionContractID
+	if billingAttempt.SubscriptionContract != nil {
+		subscriptionContractID = types.StringValue(billingAttempt.SubscriptionContract.ID)
+	}
+	orderID := types.StringNull()
+	if billingAttempt.Order != nil {
+		orderID = types.StringValue(billingAttempt.Order.ID)
+	}
+	return &SubscriptionBillingAttemptResourceModel{
+		ID:                     types.StringValue(billingAttempt.ID),
+		SubscriptionContractID: subscriptionContractID,
+		IdempotencyKey:         types.StringValue(billingAttempt.IdempotencyKey),
+		Status:                 types.StringValue(billingAttempt.Status()),
+		OrderID:                orderID,
+		ErrorCode:              types.StringPointerValue(billingAttempt.ErrorCode),
+		ErrorMessage:           types.StringPointerValue(billingAttempt.ErrorMessage),
+	}
+}
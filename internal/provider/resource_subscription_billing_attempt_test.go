@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscriptionBillingAttemptResource(t *testing.T) {
+	idempotencyKey := randResourceID(32)
+	subscriptionContractID := envOrSkip(t, "SHOPIFY_TEST_SUBSCRIPTION_CONTRACT_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubscriptionBillingAttemptResourceConfig(subscriptionContractID, idempotencyKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("shopify_subscription_billing_attempt.test", "id"),
+					resource.TestCheckResourceAttr("shopify_subscription_billing_attempt.test", "subscription_contract_id", subscriptionContractID),
+					resource.TestCheckResourceAttr("shopify_subscription_billing_attempt.test", "idempotency_key", idempotencyKey),
+					resource.TestMatchResourceAttr("shopify_subscription_billing_attempt.test", "status", regexp.MustCompile(`^(PENDING|SUCCEEDED|FAILED)$`)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_subscription_billing_attempt.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The attempt may have been processed since it was created
+				ImportStateVerifyIgnore: []string{"status", "order_id", "error_code", "error_message"},
+			},
+		},
+	})
+}
+
+func testAccSubscriptionBillingAttemptResourceConfig(subscriptionContractID, idempotencyKey string) string {
+	return fmt.Sprintf(`
+resource "shopify_subscription_billing_attempt" "test" {
+  subscription_contract_id = %[1]q
+  idempotency_key          = %[2]q
+}
+`, subscriptionContractID, idempotencyKey)
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TaxSettingResource{}
+var _ resource.ResourceWithImportState = &TaxSettingResource{}
+
+// TaxSettingResource defines the resource implementation.
+type TaxSettingResource struct {
+	client *shopify.Client
+}
+
+func NewTaxSettingResource() resource.Resource {
+	return &TaxSettingResource{}
+}
+
+// TaxSettingResourceModel describes the resource data model.
+type TaxSettingResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	TaxesIncluded types.Bool   `tfsdk:"taxes_included"`
+	TaxShipping   types.Bool   `tfsdk:"tax_shipping"`
+	CountyTaxes   types.Bool   `tfsdk:"county_taxes"`
+}
+
+func (r *TaxSettingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax_setting"
+}
+
+func (r *TaxSettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the tax settings of the shop. The settings always exist, so creating the resource adopts the current settings, " +
+			"and destroying it only removes it from the state, leaving the settings as they are. Settings that aren't configured are left unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the shop.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"taxes_included": schema.BoolAttribute{
+				MarkdownDescription: "Whether taxes are included in the product prices.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tax_shipping": schema.BoolAttribute{
+				MarkdownDescription: "Whether taxes are charged on shipping.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"county_taxes": schema.BoolAttribute{
+				MarkdownDescription: "Whether county taxes are applied, for shops in the United States.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TaxSettingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *TaxSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TaxSettingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopt the current settings, changing only the configured ones
+	setting, err := r.client.GetShopTaxSetting(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read tax settings", err))
+		return
+	}
+	if input := convertTaxSettingResourceModelToInput(data, setting); input != nil {
+		setting, err = r.client.UpdateShopTaxSetting(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update tax settings", err))
+			return
+		}
+	}
+
+	createdData := convertTaxSettingToResourceModel(setting)
+	tflog.Trace(ctx, "adopted the tax settings", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *TaxSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TaxSettingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := r.client.GetShopTaxSetting(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read tax settings", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertTaxSettingToResourceModel(setting))...)
+}
+
+func (r *TaxSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TaxSettingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting := &shopify.ShopTaxSetting{
+		ShopID:        state.ID.ValueString(),
+		TaxesIncluded: state.TaxesIncluded.ValueBool(),
+		TaxShipping:   state.TaxShipping.ValueBool(),
+		CountyTaxes:   state.CountyTaxes.ValueBool(),
+	}
+	if input := convertTaxSettingResourceModelToInput(data, setting); input != nil {
+		var err error
+		setting, err = r.client.UpdateShopTaxSetting(ctx, input)
+		if err != nil {
+			resp.Diagnostics.Append(diagFromClientError("Unable to update tax settings", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertTaxSettingToResourceModel(setting))...)
+}
+
+func (r *TaxSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The tax settings can't be deleted, so leave them as they are.
+	tflog.Trace(ctx, "removed the tax settings from the state")
+}
+
+func (r *TaxSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// convertTaxSettingResourceModelToInput returns the input to change the configured settings
+// that differ from the current ones, or nil if there is nothing to change.
+func convertTaxSettingResourceModelToInput(data TaxSettingResourceModel, current *shopify.ShopTaxSetting) *shopify.ShopTaxSettingInput {
+	changed := func(planned types.Bool, current bool) *bool {
+		if planned.IsNull() || planned.IsUnknown() || planned.ValueBool() == current {
+			return nil
+		}
+		return planned.ValueBoolPointer()
+	}
+	input := &shopify.ShopTaxSettingInput{
+		TaxesIncluded: changed(data.TaxesIncluded, current.TaxesIncluded),
+		TaxShipping:   changed(data.TaxShipping, current.TaxShipping),
+		CountyTaxes:   changed(data.CountyTaxes, current.CountyTaxes),
+	}
+	if input.TaxesIncluded == nil && input.TaxShipping == nil && input.CountyTaxes == nil {
+		return nil
+	}
+	return input
+}
+
+func convertTaxSettingToResourceModel(setting *shopify.ShopTaxSetting) *TaxSettingResourceModel {
+	return &TaxSettingResourceModel{
+		ID:            types.StringValue(setting.ShopID),
+		TaxesIncluded: types.BoolValue(setting.TaxesIncluded),
+		TaxShipping:   types.BoolValue(setting.TaxShipping),
+		CountyTaxes:   types.BoolValue(setting.CountyTaxes),
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccTaxSettingResource(t *testing.T) {
+	// The test changes the tax settings of the shop, so it only runs when explicitly enabled
+	envOrSkip(t, "SHOPIFY_TEST_TAX_SETTING")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTaxSettingResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_tax_setting.test", "tax_shipping", "true"),
+					resource.TestCheckResourceAttrSet("shopify_tax_setting.test", "taxes_included"),
+					resource.TestCheckResourceAttrSet("shopify_tax_setting.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTaxSettingResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_tax_setting.test", "tax_shipping", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaxSettingResourceConfig(taxShipping bool) string {
+	return fmt.Sprintf(`
+resource "shopify_tax_setting" "test" {
+  tax_shipping = %[1]t
+}
+`, taxShipping)
+}
+
+func TestConvertTaxSettingResourceModelToInput(t *testing.T) {
+	current := &shopify.ShopTaxSetting{TaxesIncluded: true, TaxShipping: false}
+
+	input := convertTaxSettingResourceModelToInput(TaxSettingResourceModel{
+		TaxesIncluded: types.BoolValue(true),
+		TaxShipping:   types.BoolValue(true),
+		CountyTaxes:   types.BoolUnknown(),
+	}, current)
+	if input == nil || input.TaxesIncluded != nil || input.TaxShipping == nil || !*input.TaxShipping || input.CountyTaxes != nil {
+		t.Errorf("expected only tax_shipping to change, got %+v", input)
+	}
+
+	input = convertTaxSettingResourceModelToInput(TaxSettingResourceModel{
+		TaxesIncluded: types.BoolValue(true),
+		TaxShipping:   types.BoolNull(),
+		CountyTaxes:   types.BoolNull(),
+	}, current)
+	if input != nil {
+		t.Errorf("expected no change, got %+v", input)
+	}
+}
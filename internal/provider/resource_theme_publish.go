@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ThemePublishResource{}
+var _ resource.ResourceWithImportState = &ThemePublishResource{}
+var _ resource.ResourceWithValidateConfig = &ThemePublishResource{}
+
+// ThemePublishResource defines the resource implementation.
+type ThemePublishResource struct {
+	client *shopify.Client
+}
+
+func NewThemePublishResource() resource.Resource {
+	return &ThemePublishResource{}
+}
+
+// ThemePublishResourceModel describes the resource data model.
+type ThemePublishResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	ThemeID types.String `tfsdk:"theme_id"`
+	Name    types.String `tfsdk:"name"`
+	Role    types.String `tfsdk:"role"`
+}
+
+func (r *ThemePublishResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_theme_publish"
+}
+
+func (r *ThemePublishResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Publishes a theme of the online store, i.e. makes it the main theme, which unpublishes the previous main theme. " +
+			"Publishing another theme outside of Terraform is detected as drift and the theme is published again. " +
+			"Destroying the resource only removes it from the state, leaving the theme published, as the shop always has a main theme.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the published theme, same as `theme_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"theme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the theme to publish, e.g. `gid://shopify/OnlineStoreTheme/123`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the theme.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The current role of the theme. It's `MAIN` while the theme is published, " +
+					"any other role means another theme has been published since, and the theme is published again on apply.",
+				Computed: true,
+				Default:  stringdefault.StaticString(shopify.ThemeRoleMain),
+			},
+		},
+	}
+}
+
+func (r *ThemePublishResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *ThemePublishResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ThemePublishResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ThemeID.IsNull() && !data.ThemeID.IsUnknown() {
+		if prefix := utils.GIDPrefix("OnlineStoreTheme"); !strings.HasPrefix(data.ThemeID.ValueString(), prefix) {
+			resp.Diagnostics.AddAttributeError(path.Root("theme_id"), "Invalid theme_id",
+				fmt.Sprintf("expected %s<id>, got %q", prefix, data.ThemeID.ValueString()))
+		}
+	}
+}
+
+func (r *ThemePublishResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ThemePublishResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	theme, err := r.client.PublishTheme(ctx, data.ThemeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to publish theme", err))
+		return
+	}
+
+	createdData := convertPublishedThemeToThemePublishResourceModel(theme)
+	tflog.Trace(ctx, "published a theme", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *ThemePublishResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ThemePublishResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	theme, err := r.client.GetTheme(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read theme", err))
+		return
+	}
+	if theme == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertThemeToThemePublishResourceModel(theme))...)
+}
+
+func (r *ThemePublishResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ThemePublishResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The only change in place is the role, drifted from MAIN, so publish the theme again
+	theme, err := r.client.PublishTheme(ctx, data.ThemeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to publish theme", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertPublishedThemeToThemePublishResourceModel(theme))...)
+}
+
+func (r *ThemePublishResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The shop always has a main theme, so leave the theme published.
+	tflog.Trace(ctx, "removed the theme publish from the state")
+}
+
+func (r *ThemePublishResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertThemeToThemePublishResourceModel(theme *shopify.Theme) *ThemePublishResourceModel {
+	return &ThemePublishResourceModel{
+		ID:      types.StringValue(theme.ID),
+		ThemeID: types.StringValue(theme.ID),
+		Name:    types.StringValue(theme.Name),
+		Role:    types.StringValue(theme.Role),
+	}
+}
+
+// convertPublishedThemeToThemePublishResourceModel converts the theme returned by the publish,
+// whose role can still lag behind while the publish is processed. The next read detects if it didn't happen.
+func convertPublishedThemeToThemePublishResourceModel(theme *shopify.Theme) *ThemePublishResourceModel {
+	data := convertThemeToThemePublishResourceModel(theme)
+	data.Role = types.StringValue(shopify.ThemeRoleMain)
+	return data
+}
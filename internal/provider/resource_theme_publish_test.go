@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccThemePublishResource(t *testing.T) {
+	// The test publishes the theme, which changes the storefront of the shop, so it only runs when explicitly enabled
+	themeID := envOrSkip(t, "SHOPIFY_TEST_THEME_ID")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccThemePublishResourceConfig(themeID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_theme_publish.test", "id", themeID),
+					resource.TestCheckResourceAttr("shopify_theme_publish.test", "role", "MAIN"),
+					resource.TestCheckResourceAttrSet("shopify_theme_publish.test", "name"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_theme_publish.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccThemePublishResource_invalidThemeID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccThemePublishResourceConfig("gid://shopify/Product/1"),
+				ExpectError: regexp.MustCompile(`Invalid theme_id`),
+			},
+		},
+	})
+}
+
+func testAccThemePublishResourceConfig(themeID string) string {
+	return fmt.Sprintf(`
+resource "shopify_theme_publish" "test" {
+  theme_id = %[1]q
+}
+`, themeID)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &URLRedirectResource{}
+var _ resource.ResourceWithImportState = &URLRedirectResource{}
+var _ resource.ResourceWithValidateConfig = &URLRedirectResource{}
+
+// URLRedirectResource defines the resource implementation.
+type URLRedirectResource struct {
+	client *shopify.Client
+}
+
+func NewURLRedirectResource() resource.Resource {
+	return &URLRedirectResource{}
+}
+
+// URLRedirectResourceModel describes the resource data model.
+type URLRedirectResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Path   types.String `tfsdk:"path"`
+	Target types.String `tfsdk:"target"`
+}
+
+func (r *URLRedirectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_redirect"
+}
+
+func (r *URLRedirectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A URL redirect of the online store, which redirects the visitors of an old path with a 301 status, e.g. after migrating a store.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the URL redirect.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The old path to redirect from, starting with `/`, e.g. `/products/old-name`. Changing it updates the redirect in place.",
+				Required:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The path or the absolute URL to redirect to, e.g. `/products/new-name` or `https://example.com`.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *URLRedirectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Path.IsNull() && !data.Path.IsUnknown() && !strings.HasPrefix(data.Path.ValueString(), "/") {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid path", fmt.Sprintf("path must start with /, got %q", data.Path.ValueString()))
+	}
+}
+
+func (r *URLRedirectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *URLRedirectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redirect, err := r.client.CreateURLRedirect(ctx, convertURLRedirectResourceModelToInput(data))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to create URL redirect", err))
+		return
+	}
+	createdData := convertURLRedirectToResourceModel(redirect)
+	tflog.Trace(ctx, "created a URL redirect", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *URLRedirectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redirect, err := r.client.GetURLRedirect(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read URL redirect", err))
+		return
+	}
+	// Let the plan recreate a redirect deleted outside of Terraform
+	if redirect == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertURLRedirectToResourceModel(redirect))...)
+}
+
+func (r *URLRedirectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redirect, err := r.client.UpdateURLRedirect(ctx, data.ID.ValueString(), convertURLRedirectResourceModelToInput(data))
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to update URL redirect", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertURLRedirectToResourceModel(redirect))...)
+}
+
+func (r *URLRedirectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data URLRedirectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteURLRedirect(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete URL redirect", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a URL redirect", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *URLRedirectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func convertURLRedirectResourceModelToInput(data URLRedirectResourceModel) *shopify.URLRedirectInput {
+	return &shopify.URLRedirectInput{
+		Path:   data.Path.ValueString(),
+		Target: data.Target.ValueString(),
+	}
+}
+
+func convertURLRedirectToResourceModel(redirect *shopify.URLRedirect) *URLRedirectResourceModel {
+	return &URLRedirectResourceModel{
+		ID:     types.StringValue(redirect.ID),
+		Path:   types.StringValue(redirect.Path),
+		Target: types.StringValue(redirect.Target),
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccURLRedirectResource(t *testing.T) {
+	oldPath := "/pages/" + randResourceID(32)
+	newPath := "/pages/" + randResourceID(32)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccURLRedirectResourceConfig(oldPath, "/collections/all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_url_redirect.test", "path", oldPath),
+					resource.TestCheckResourceAttr("shopify_url_redirect.test", "target", "/collections/all"),
+					resource.TestCheckResourceAttrSet("shopify_url_redirect.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_url_redirect.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the path updates the redirect in place
+			{
+				Config: testAccURLRedirectResourceConfig(newPath, "/"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("shopify_url_redirect.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_url_redirect.test", "path", newPath),
+					resource.TestCheckResourceAttr("shopify_url_redirect.test", "target", "/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccURLRedirectResourceConfig(path, target string) string {
+	return fmt.Sprintf(`
+resource "shopify_url_redirect" "test" {
+  path   = %[1]q
+  target = %[2]q
+}
+`, path, target)
+}
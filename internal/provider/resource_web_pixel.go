@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WebPixelResource{}
+var _ resource.ResourceWithImportState = &WebPixelResource{}
+var _ resource.ResourceWithValidateConfig = &WebPixelResource{}
+
+// WebPixelResource defines the resource implementation.
+type WebPixelResource struct {
+	client *shopify.Client
+}
+
+func NewWebPixelResource() resource.Resource {
+	return &WebPixelResource{}
+}
+
+// WebPixelResourceModel describes the resource data model.
+type WebPixelResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Settings types.String `tfsdk:"settings"`
+}
+
+func (r *WebPixelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_pixel"
+}
+
+func (r *WebPixelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the web pixel of the app, which subscribes to customer events on the storefront. The app must have a web pixel extension deployed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique ID of the web pixel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"settings": schema.StringAttribute{
+				MarkdownDescription: "The settings of the web pixel as a JSON object, e.g. `jsonencode({ accountID = \"234\" })`. The settings must match the fields defined in the web pixel extension's configuration. Differences in whitespace or key order don't produce a diff.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *WebPixelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WebPixelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Settings.IsNull() || data.Settings.IsUnknown() {
+		return
+	}
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Settings.ValueString()), &settings); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("settings"), "Invalid settings", fmt.Sprintf("settings must be a JSON object, got error: %s", err))
+	}
+}
+
+func (r *WebPixelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.client, _ = req.ProviderData.(*shopify.Client)
+}
+
+func (r *WebPixelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebPixelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webPixel, err := r.client.CreateWebPixel(ctx, &shopify.WebPixelInput{Settings: data.Settings.ValueString()})
+	if err != nil {
+		resp.Diagnostics.Append(webPixelErrorDiagnostics("Unable to create web pixel", err)...)
+		return
+	}
+
+	createdData := convertWebPixelToResourceModel(webPixel, data)
+	tflog.Trace(ctx, "created a web pixel", map[string]interface{}{
+		"id": createdData.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdData)...)
+}
+
+func (r *WebPixelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebPixelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webPixel, err := r.client.GetWebPixel(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to read web pixel", err))
+		return
+	}
+	if webPixel == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertWebPixelToResourceModel(webPixel, data))...)
+}
+
+func (r *WebPixelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WebPixelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webPixel, err := r.client.UpdateWebPixel(ctx, data.ID.ValueString(), &shopify.WebPixelInput{Settings: data.Settings.ValueString()})
+	if err != nil {
+		resp.Diagnostics.Append(webPixelErrorDiagnostics("Unable to update web pixel", err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertWebPixelToResourceModel(webPixel, data))...)
+}
+
+func (r *WebPixelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WebPixelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteWebPixel(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.Append(diagFromClientError("Unable to delete web pixel", err))
+		return
+	}
+	tflog.Trace(ctx, "deleted a web pixel", map[string]interface{}{
+		"id": data.ID,
+	})
+}
+
+func (r *WebPixelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// webPixelErrorDiagnostics reports the user errors about the settings, e.g. a missing or invalid
+// setting of the web pixel extension, on the settings attribute.
+func webPixelErrorDiagnostics(summary string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var userErrs *shopify.UserErrorsError
+	if !errors.As(err, &userErrs) {
+		diags.Append(diagFromClientError(summary, err))
+		return diags
+	}
+	for _, userError := range userErrs.UserErrors {
+		if slices.Contains(userError.Field, "settings") {
+			diags.AddAttributeError(path.Root("settings"), "Invalid settings", userError.Message)
+			continue
+		}
+		diags.Append(diagFromClientError(summary, shopify.UserErrors{userError}.Error()))
+	}
+	return diags
+}
+
+func convertWebPixelToResourceModel(webPixel *shopify.WebPixel, data WebPixelResourceModel) *WebPixelResourceModel {
+	// Keep the configured settings if they are semantically equal not to produce unnecessary diffs
+	settings := types.StringValue(string(webPixel.Settings))
+	if equal, err := utils.JSONEqual(string(webPixel.Settings), data.Settings.ValueString()); err == nil && equal {
+		settings = data.Settings
+	}
+	return &WebPixelResourceModel{
+		ID:       types.StringValue(webPixel.ID),
+		Settings: settings,
+	}
+}
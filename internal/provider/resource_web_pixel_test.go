@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zero-clor/terraform-provider-shopify/internal/shopify"
+)
+
+func TestAccWebPixelResource(t *testing.T) {
+	// The app must have a web pixel extension with an accountID setting deployed
+	envOrSkip(t, "SHOPIFY_TEST_WEB_PIXEL")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebPixelResourceConfig("234"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_web_pixel.test", "settings", `{"accountID":"234"}`),
+					resource.TestCheckResourceAttrSet("shopify_web_pixel.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "shopify_web_pixel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebPixelResourceConfig("567"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("shopify_web_pixel.test", "settings", `{"accountID":"567"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebPixelResourceConfig(accountID string) string {
+	return fmt.Sprintf(`
+resource "shopify_web_pixel" "test" {
+  settings = jsonencode({
+    accountID = %[1]q
+  })
+}
+`, accountID)
+}
+
+func TestConvertWebPixelToResourceModel(t *testing.T) {
+	data := WebPixelResourceModel{Settings: types.StringValue(`{"b": 2, "a": "1"}`)}
+
+	got := convertWebPixelToResourceModel(&shopify.WebPixel{ID: "gid://shopify/WebPixel/1", Settings: `{"a":"1","b":2}`}, data)
+	if !got.Settings.Equal(data.Settings) {
+		t.Errorf("expected the configured settings to be kept, got %s", got.Settings)
+	}
+
+	got = convertWebPixelToResourceModel(&shopify.WebPixel{ID: "gid://shopify/WebPixel/1", Settings: `{"a":"2","b":2}`}, data)
+	if got.Settings.ValueString() != `{"a":"2","b":2}` {
+		t.Errorf("expected the remote settings, got %s", got.Settings)
+	}
+}
+
+func TestWebPixelErrorDiagnostics(t *testing.T) {
+	err := shopify.UserErrors{
+		{Field: []string{"webPixel", "settings"}, Message: "accountID is required"},
+		{Message: "Something went wrong"},
+	}.Error()
+
+	diags := webPixelErrorDiagnostics("Unable to create web pixel", err)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diags)
+	}
+	withPath, ok := diags[0].(interface{ Path() path.Path })
+	if !ok || !withPath.Path().Equal(path.Root("settings")) {
+		t.Errorf("expected an error on settings, got %v", diags[0])
+	}
+	if diags[0].Detail() != "accountID is required" {
+		t.Errorf("unexpected detail: %s", diags[0].Detail())
+	}
+}
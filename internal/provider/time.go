@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeLayouts are the layouts accepted for timestamps, tried in order.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	time.DateTime,
+	time.DateOnly,
+}
+
+// formatTime formats the time as canonical RFC3339 in UTC.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseTime parses a timestamp leniently, accepting RFC3339 with any offset as well as a few common variants.
+// Timestamps without an offset are in UTC.
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected a RFC3339 timestamp, e.g. 2006-01-02T15:04:05Z, got %q", s)
+}
+
+// convertTimeToModel converts the time to a timestamp attribute value. The current value is kept
+// when it's the same instant written differently, e.g. with another offset, not to produce unnecessary diffs.
+func convertTimeToModel(t *time.Time, current types.String) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	if !current.IsNull() && !current.IsUnknown() {
+		if currentTime, err := parseTime(current.ValueString()); err == nil && currentTime.Equal(*t) {
+			return current
+		}
+	}
+	return types.StringValue(formatTime(*t))
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseTime(t *testing.T) {
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []string{
+		"2024-01-02T03:04:05Z",
+		"2024-01-02T12:04:05+09:00",
+		"2024-01-01T22:04:05-0500",
+		"2024-01-02 03:04:05 +0000 UTC",
+		"2024-01-02 03:04:05",
+	}
+	for _, s := range tests {
+		got, err := parseTime(s)
+		if err != nil {
+			t.Errorf("parseTime(%q) failed: %s", s, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("parseTime(%q) = %s, want %s", s, got, want)
+		}
+	}
+	if _, err := parseTime("yesterday"); err == nil {
+		t.Error("expected an error for an invalid timestamp")
+	}
+}
+
+func TestConvertTimeToModel(t *testing.T) {
+	// A timestamp written with an offset round-trips as is
+	written := types.StringValue("2024-01-02T12:04:05+09:00")
+	parsed, err := parseTime(written.ValueString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := convertTimeToModel(&parsed, written); !got.Equal(written) {
+		t.Errorf("expected %s to round-trip, got %s", written, got)
+	}
+
+	// Otherwise the timestamp is canonical RFC3339 in UTC
+	remote := time.Date(2024, 1, 2, 12, 4, 5, 0, time.FixedZone("JST", 9*60*60))
+	if got := convertTimeToModel(&remote, types.StringNull()); got.ValueString() != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected timestamp: %s", got)
+	}
+	if got := convertTimeToModel(&remote, types.StringValue("2024-01-01T00:00:00Z")); got.ValueString() != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected timestamp: %s", got)
+	}
+	if got := convertTimeToModel(nil, written); !got.IsNull() {
+		t.Errorf("expected null, got %s", got)
+	}
+}
@@ -0,0 +1,130 @@
+package shopify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// mutationScopes are the access scopes the mutations require, by name of the mutation.
+// The mutations whose scope depends on their input, e.g. metafieldsSet on the owner type, aren't listed.
+var mutationScopes = map[string]string{
+	"deliveryProfileCreate":            "write_shipping",
+	"deliveryProfileRemove":            "write_shipping",
+	"deliveryProfileUpdate":            "write_shipping",
+	"discountAutomaticAppCreate":       "write_discounts",
+	"discountAutomaticAppUpdate":       "write_discounts",
+	"discountAutomaticDelete":          "write_discounts",
+	"discountCodeRedeemCodeBulkDelete": "write_discounts",
+	"discountRedeemCodeBulkAdd":        "write_discounts",
+	"fileUpdate":                       "write_files",
+	"fulfillmentConstraintRuleCreate":  "write_fulfillment_constraint_rules",
+	"fulfillmentConstraintRuleDelete":  "write_fulfillment_constraint_rules",
+	"fulfillmentOrderHold":             "write_merchant_managed_fulfillment_orders",
+	"fulfillmentOrderReleaseHold":      "write_merchant_managed_fulfillment_orders",
+	"menuCreate":                       "write_online_store_navigation",
+	"menuDelete":                       "write_online_store_navigation",
+	"menuUpdate":                       "write_online_store_navigation",
+	"metaobjectBulkDelete":             "write_metaobjects",
+	"metaobjectDefinitionCreate":       "write_metaobject_definitions",
+	"metaobjectDefinitionDelete":       "write_metaobject_definitions",
+	"metaobjectDefinitionUpdate":       "write_metaobject_definitions",
+	"metaobjectDelete":                 "write_metaobjects",
+	"metaobjectUpsert":                 "write_metaobjects",
+	"orderUpdate":                      "write_orders",
+	"privacyFeaturesDisable":           "write_privacy_settings",
+	"productOptionUpdate":              "write_products",
+	"productOptionsCreate":             "write_products",
+	"productOptionsDelete":             "write_products",
+	"publishablePublish":               "write_publications",
+	"publishableUnpublish":             "write_publications",
+	"subscriptionBillingAttemptCreate": "write_own_subscription_contracts",
+	"themePublish":                     "write_themes",
+	"webPixelCreate":                   "write_pixels",
+	"webPixelDelete":                   "write_pixels",
+	"webPixelUpdate":                   "write_pixels",
+}
+
+// restScopes are the access scopes the REST writes require, by REST resource as recorded, e.g. page.
+var restScopes = map[string]string{
+	"customerAddress": "write_customers",
+	"orderRisk":       "write_orders",
+	"page":            "write_content",
+	"smartCollection": "write_products",
+}
+
+var (
+	// accessDeniedPattern matches the GraphQL error of a field the access token has no scope for,
+	// e.g. "Access denied for metaobjectDefinitionCreate field. Required access: ...".
+	accessDeniedPattern = regexp.MustCompile(`Access denied for (\w+) field`)
+	// mutationFieldPattern matches the first field of a mutation, i.e. the name of the mutation.
+	mutationFieldPattern = regexp.MustCompile(`mutation\b[^{]*\{\s*(\w+)`)
+)
+
+// AccessDeniedError is the error of a mutation the access token lacks the access scope for.
+// Use errors.As to get the required scope.
+type AccessDeniedError struct {
+	// Mutation is the name of the mutation, e.g. metaobjectDefinitionCreate,
+	// or the REST resource followed by the action for the REST writes, e.g. pageCreate.
+	Mutation string
+	// Scope is the access scope the mutation requires, empty if unknown.
+	Scope string
+	Err   error
+}
+
+func (e *AccessDeniedError) Error() string {
+	if e.Scope == "" {
+		return fmt.Sprintf("access denied for %s, the access token lacks the required scope: %s", e.Mutation, e.Err)
+	}
+	return fmt.Sprintf("access denied for %s, it requires %s scope. Grant the scope to the app of the access token and reinstall it", e.Mutation, e.Scope)
+}
+
+func (e *AccessDeniedError) Unwrap() error {
+	return e.Err
+}
+
+// wrapAccessDeniedError returns an AccessDeniedError for the errors of the mutation denied for lack of scope,
+// either with 403 Forbidden or with a GraphQL error, the error itself otherwise.
+func wrapAccessDeniedError(query string, err error) error {
+	var respErr goshopify.ResponseError
+	if !errors.As(err, &respErr) {
+		return err
+	}
+	mutation := ""
+	for _, message := range append([]string{respErr.Message}, respErr.Errors...) {
+		if m := accessDeniedPattern.FindStringSubmatch(message); m != nil {
+			mutation = m[1]
+			break
+		}
+	}
+	if mutation == "" {
+		if respErr.Status != http.StatusForbidden {
+			return err
+		}
+		if m := mutationFieldPattern.FindStringSubmatch(query); m != nil {
+			mutation = m[1]
+		} else {
+			mutation = "the mutation"
+		}
+	}
+	return &AccessDeniedError{Mutation: mutation, Scope: mutationScopes[mutation], Err: err}
+}
+
+// wrapRESTAccessDeniedError returns an AccessDeniedError for the REST write rejected with 403 Forbidden,
+// the error itself otherwise. The resource and the action are the ones recorded, e.g. page and Create.
+func wrapRESTAccessDeniedError(resource, action string, err error) error {
+	var respErr goshopify.ResponseError
+	if !errors.As(err, &respErr) || respErr.Status != http.StatusForbidden {
+		return err
+	}
+	return &AccessDeniedError{Mutation: resource + action, Scope: restScopes[resource], Err: err}
+}
+
+// IsAccessDenied returns whether the mutation has been rejected because the access token lacks its scope.
+func IsAccessDenied(err error) bool {
+	var accessDeniedErr *AccessDeniedError
+	return errors.As(err, &accessDeniedErr)
+}
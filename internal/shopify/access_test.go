@@ -0,0 +1,89 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+func TestMutate_accessDenied(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Access denied for metaobjectDefinitionCreate field. Required access: ` + "`write_metaobject_definitions`" + ` access scope.","extensions":{"code":"ACCESS_DENIED"}}]}`))
+	})
+
+	_, err := client.CreateMetaobjectDefinition(context.Background(), &MetaobjectDefinitionCreateInput{Type: "author"})
+	var accessDeniedErr *AccessDeniedError
+	if !errors.As(err, &accessDeniedErr) {
+		t.Fatalf("expected an access denied error, got %v", err)
+	}
+	if accessDeniedErr.Mutation != "metaobjectDefinitionCreate" || accessDeniedErr.Scope != "write_metaobject_definitions" {
+		t.Errorf("unexpected mutation or scope: %+v", accessDeniedErr)
+	}
+	if !strings.Contains(err.Error(), "requires write_metaobject_definitions scope") {
+		t.Errorf("expected the error to name the scope, got %q", err)
+	}
+}
+
+func TestMutate_forbidden(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":"Forbidden"}`))
+	})
+
+	err := client.DeleteWebPixel(context.Background(), "gid://shopify/WebPixel/1")
+	if !IsAccessDenied(err) {
+		t.Fatalf("expected an access denied error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "webPixelDelete, it requires write_pixels scope") {
+		t.Errorf("expected the error to name the mutation and the scope, got %q", err)
+	}
+}
+
+func TestRESTWrite_forbidden(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":"[API] This action requires merchant approval for write_content scope."}`))
+	})
+
+	_, err := client.CreatePage(context.Background(), goshopify.Page{Title: "About"})
+	var accessDeniedErr *AccessDeniedError
+	if !errors.As(err, &accessDeniedErr) {
+		t.Fatalf("expected an access denied error, got %v", err)
+	}
+	if accessDeniedErr.Mutation != "pageCreate" || accessDeniedErr.Scope != "write_content" {
+		t.Errorf("unexpected mutation or scope: %+v", accessDeniedErr)
+	}
+	if !strings.Contains(err.Error(), "pageCreate, it requires write_content scope") {
+		t.Errorf("expected the error to name the write and the scope, got %q", err)
+	}
+}
+
+func TestMutate_accessDeniedUnknownScope(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Access denied for metafieldsSet field. Required access: API client to have access to the namespace and the resource type associated with the metafield owner."}]}`))
+	})
+
+	_, err := client.SetMetafields(context.Background(), []*MetafieldsSetInput{{}})
+	if !IsAccessDenied(err) {
+		t.Fatalf("expected an access denied error, got %v", err)
+	}
+	// Without a scope in the table, the message of Shopify tells which access is required
+	if !strings.Contains(err.Error(), "Required access") {
+		t.Errorf("expected the error to keep the message of Shopify, got %q", err)
+	}
+}
+
+func TestMutate_otherError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Field 'foo' doesn't exist on type 'Mutation'"}]}`))
+	})
+
+	err := client.DeleteWebPixel(context.Background(), "gid://shopify/WebPixel/1")
+	if err == nil || IsAccessDenied(err) {
+		t.Errorf("expected an error other than access denied, got %v", err)
+	}
+}
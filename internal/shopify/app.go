@@ -0,0 +1,55 @@
+package shopify
+
+import (
+	"context"
+	"strings"
+)
+
+// appReservedPrefix is the prefix of the namespaces and the metaobject types reserved to an app, followed by the ID of the app.
+// Shopify resolves the $app prefix to it, e.g. $app:author to app--1234--author.
+const appReservedPrefix = "app--"
+
+// ReservingAppID returns the numeric ID of the app the namespace or the metaobject type is reserved to,
+// or an empty string if it isn't reserved to any app.
+func ReservingAppID(namespaceOrType string) string {
+	rest, ok := strings.CutPrefix(namespaceOrType, appReservedPrefix)
+	if !ok {
+		return ""
+	}
+	appID, _, _ := strings.Cut(rest, "--")
+	return appID
+}
+
+// IsAppReserved returns whether the namespace or the metaobject type is reserved to an app,
+// either to the current one with the $app prefix or to the app of the ID.
+func IsAppReserved(namespaceOrType string) bool {
+	return strings.HasPrefix(namespaceOrType, "$app") || ReservingAppID(namespaceOrType) != ""
+}
+
+type GetCurrentAppResponse struct {
+	CurrentAppInstallation struct {
+		App struct {
+			ID string `json:"id"`
+		} `json:"app"`
+	} `json:"currentAppInstallation"`
+}
+
+// GetCurrentAppID returns the numeric ID of the app the client authenticates as.
+func (c *Client) GetCurrentAppID(ctx context.Context) (string, error) {
+	query := `
+query currentApp {
+  currentAppInstallation {
+    app {
+      id
+    }
+  }
+}
+`
+
+	var gqlResp GetCurrentAppResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(gqlResp.CurrentAppInstallation.App.ID, "gid://shopify/App/"), nil
+}
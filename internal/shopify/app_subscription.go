@@ -0,0 +1,208 @@
+package shopify
+
+import (
+	"context"
+)
+
+const (
+	AppSubscriptionStatusActive    = "ACTIVE"
+	AppSubscriptionStatusCancelled = "CANCELLED"
+	AppSubscriptionStatusDeclined  = "DECLINED"
+	AppSubscriptionStatusExpired   = "EXPIRED"
+	AppSubscriptionStatusFrozen    = "FROZEN"
+	AppSubscriptionStatusPending   = "PENDING"
+)
+
+type AppSubscription struct {
+	ID        string                     `json:"id"`
+	Name      string                     `json:"name"`
+	Status    string                     `json:"status"`
+	Test      bool                       `json:"test"`
+	ReturnURL string                     `json:"returnUrl"`
+	LineItems []*AppSubscriptionLineItem `json:"lineItems"`
+}
+
+// IsFinished returns whether the subscription can no longer be charged,
+// i.e. it has been cancelled, declined by the merchant or has expired.
+func (s *AppSubscription) IsFinished() bool {
+	switch s.Status {
+	case AppSubscriptionStatusCancelled, AppSubscriptionStatusDeclined, AppSubscriptionStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+type AppSubscriptionLineItem struct {
+	ID   string `json:"id"`
+	Plan struct {
+		PricingDetails *AppPricingDetails `json:"pricingDetails"`
+	} `json:"plan"`
+}
+
+// AppPricingDetails is either the recurring pricing or the usage pricing of a line item.
+type AppPricingDetails struct {
+	Typename string `json:"__typename"`
+	// Set for AppRecurringPricing
+	Price    *Money `json:"price"`
+	Interval string `json:"interval"`
+	// Set for AppUsagePricing
+	Terms        string `json:"terms"`
+	CappedAmount *Money `json:"cappedAmount"`
+}
+
+type Money struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currencyCode"`
+}
+
+type AppSubscriptionLineItemInput struct {
+	Plan *AppPlanInput `json:"plan"`
+}
+
+type AppPlanInput struct {
+	AppRecurringPricingDetails *AppRecurringPricingInput `json:"appRecurringPricingDetails,omitempty"`
+	AppUsagePricingDetails     *AppUsagePricingInput     `json:"appUsagePricingDetails,omitempty"`
+}
+
+type AppRecurringPricingInput struct {
+	Price    *Money `json:"price"`
+	Interval string `json:"interval,omitempty"`
+}
+
+type AppUsagePricingInput struct {
+	Terms        string `json:"terms"`
+	CappedAmount *Money `json:"cappedAmount"`
+}
+
+type AppSubscriptionCreateInput struct {
+	Name      string                          `json:"name"`
+	LineItems []*AppSubscriptionLineItemInput `json:"lineItems"`
+	Test      bool                            `json:"test"`
+	ReturnURL string                          `json:"returnUrl"`
+}
+
+const appSubscriptionFields = `
+id
+name
+status
+test
+returnUrl
+lineItems {
+  id
+  plan {
+    pricingDetails {
+      __typename
+      ... on AppRecurringPricing {
+        price {
+          amount
+          currencyCode
+        }
+        interval
+      }
+      ... on AppUsagePricing {
+        terms
+        cappedAmount {
+          amount
+          currencyCode
+        }
+      }
+    }
+  }
+}
+`
+
+type GetAppSubscriptionResponse struct {
+	Node *AppSubscription `json:"node"`
+}
+
+// GetAppSubscription returns the app subscription, or nil if it doesn't exist.
+func (c *Client) GetAppSubscription(ctx context.Context, id string) (*AppSubscription, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query appSubscription($id: ID!) {
+  node(id: $id) {
+    ... on AppSubscription {` + appSubscriptionFields + `
+    }
+  }
+}
+`
+
+	var gqlResp GetAppSubscriptionResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Node, nil
+}
+
+type CreateAppSubscriptionResponse struct {
+	AppSubscriptionCreate struct {
+		AppSubscription *AppSubscription `json:"appSubscription"`
+		ConfirmationURL string           `json:"confirmationUrl"`
+		UserErrors      UserErrors       `json:"userErrors"`
+	} `json:"appSubscriptionCreate"`
+}
+
+// CreateAppSubscription creates the app subscription. It returns the URL where the merchant approves the subscription along with it.
+func (c *Client) CreateAppSubscription(ctx context.Context, input *AppSubscriptionCreateInput) (*AppSubscription, string, error) {
+	variables := map[string]interface{}{
+		"name":      input.Name,
+		"lineItems": input.LineItems,
+		"test":      input.Test,
+		"returnUrl": input.ReturnURL,
+	}
+	query := `
+mutation appSubscriptionCreate($name: String!, $lineItems: [AppSubscriptionLineItemInput!]!, $test: Boolean, $returnUrl: URL!) {
+  appSubscriptionCreate(name: $name, lineItems: $lineItems, test: $test, returnUrl: $returnUrl) {
+    appSubscription {` + appSubscriptionFields + `
+    }
+    confirmationUrl
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp CreateAppSubscriptionResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := gqlResp.AppSubscriptionCreate.UserErrors.Error(); err != nil {
+		return nil, "", err
+	}
+	return gqlResp.AppSubscriptionCreate.AppSubscription, gqlResp.AppSubscriptionCreate.ConfirmationURL, nil
+}
+
+type CancelAppSubscriptionResponse struct {
+	AppSubscriptionCancel struct {
+		AppSubscription *AppSubscription `json:"appSubscription"`
+		UserErrors      UserErrors       `json:"userErrors"`
+	} `json:"appSubscriptionCancel"`
+}
+
+func (c *Client) CancelAppSubscription(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation appSubscriptionCancel($id: ID!) {
+  appSubscriptionCancel(id: $id) {
+    appSubscription {
+      id
+      status
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp CancelAppSubscriptionResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.AppSubscriptionCancel.UserErrors.Error()
+}
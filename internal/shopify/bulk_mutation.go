@@ -0,0 +1,304 @@
+package shopify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"time"
+)
+
+// bulkOperationPollInterval is the interval between the checks of the status of a bulk operation.
+const bulkOperationPollInterval = time.Second
+
+type StagedUploadInput struct {
+	Resource   string `json:"resource"`
+	Filename   string `json:"filename"`
+	MimeType   string `json:"mimeType"`
+	HttpMethod string `json:"httpMethod"`
+}
+
+type StagedUploadParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type StagedTarget struct {
+	URL         string                   `json:"url"`
+	ResourceURL string                   `json:"resourceUrl"`
+	Parameters  []*StagedUploadParameter `json:"parameters"`
+}
+
+type CreateStagedUploadsResponse struct {
+	StagedUploadsCreate struct {
+		StagedTargets []*StagedTarget `json:"stagedTargets"`
+		UserErrors    UserErrors      `json:"userErrors"`
+	} `json:"stagedUploadsCreate"`
+}
+
+type BulkOperation struct {
+	ID        string  `json:"id"`
+	Status    string  `json:"status"`
+	ErrorCode *string `json:"errorCode"`
+	// ObjectCount is the number of objects processed so far, an unsigned 64-bit integer encoded as a string.
+	ObjectCount string `json:"objectCount,omitempty"`
+	// URL is the URL of the JSONL results, nil until the operation has completed, or if there are no results.
+	URL *string `json:"url"`
+	// PartialDataURL is the URL of the results of a failed operation, nil if there are none.
+	PartialDataURL *string `json:"partialDataUrl"`
+}
+
+// IsFinished returns whether the bulk operation has stopped running, successfully or not.
+func (o *BulkOperation) IsFinished() bool {
+	switch o.Status {
+	case "COMPLETED", "FAILED", "CANCELED", "EXPIRED":
+		return true
+	}
+	return false
+}
+
+type RunBulkMutationResponse struct {
+	BulkOperationRunMutation struct {
+		BulkOperation *BulkOperation `json:"bulkOperation"`
+		UserErrors    UserErrors     `json:"userErrors"`
+	} `json:"bulkOperationRunMutation"`
+}
+
+// BulkMutationResult is the result of the mutation run with a line of variables by a bulk operation.
+type BulkMutationResult struct {
+	// Data is the response of the mutation, keyed by the name of the mutation.
+	Data json.RawMessage `json:"data"`
+	// Errors are the errors of the request, e.g. an invalid variable, nil if it has run.
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	// LineNumber is the index of the variables of the mutation, starting at 0.
+	LineNumber int `json:"__lineNumber"`
+}
+
+// Err returns the errors of the request, nil if it has run. The user errors of the mutation are in the data.
+func (r *BulkMutationResult) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	messages := make([]error, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		messages = append(messages, errors.New(e.Message))
+	}
+	return errors.Join(messages...)
+}
+
+// RunBulkMutation runs the mutation once for each of the variables in a bulk operation, and waits for it to finish.
+// The variables are uploaded as a JSONL file, so that there can be many more than in a single request.
+// It returns the results by index of the variables. The results of the variables missing from the map,
+// e.g. because the operation has failed midway, are unknown.
+func (c *Client) RunBulkMutation(ctx context.Context, mutation string, variables []map[string]interface{}) (map[int]*BulkMutationResult, error) {
+	var jsonl bytes.Buffer
+	for _, v := range variables {
+		line, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		jsonl.Write(line)
+		jsonl.WriteByte('\n')
+	}
+	stagedUploadPath, err := c.stageBulkMutationVariables(ctx, jsonl.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+mutation bulkOperationRunMutation($mutation: String!, $stagedUploadPath: String!) {
+  bulkOperationRunMutation(mutation: $mutation, stagedUploadPath: $stagedUploadPath) {
+    bulkOperation {
+      id
+      status
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+	var gqlResp RunBulkMutationResponse
+	err = c.mutate(ctx, query, map[string]interface{}{"mutation": mutation, "stagedUploadPath": stagedUploadPath}, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.BulkOperationRunMutation.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	operation := gqlResp.BulkOperationRunMutation.BulkOperation
+	if operation == nil {
+		return nil, fmt.Errorf("no bulk operation has been started")
+	}
+
+	operation, err = c.waitForBulkOperation(ctx, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	resultsURL := operation.URL
+	if operation.Status != "COMPLETED" {
+		resultsURL = operation.PartialDataURL
+	}
+	results := make(map[int]*BulkMutationResult, len(variables))
+	if resultsURL != nil {
+		for line, err := range c.FetchBulkResults(ctx, *resultsURL) {
+			if err != nil {
+				return results, err
+			}
+			var result BulkMutationResult
+			if err := json.Unmarshal(line, &result); err != nil {
+				return results, err
+			}
+			results[result.LineNumber] = &result
+		}
+	}
+	if operation.Status != "COMPLETED" {
+		errorCode := "unknown"
+		if operation.ErrorCode != nil {
+			errorCode = *operation.ErrorCode
+		}
+		return results, fmt.Errorf("bulk operation %s is %s, error code: %s", operation.ID, operation.Status, errorCode)
+	}
+	return results, nil
+}
+
+// waitForBulkOperation polls the bulk operation until it has finished, and returns it as it has finished.
+func (c *Client) waitForBulkOperation(ctx context.Context, operation *BulkOperation) (*BulkOperation, error) {
+	err := pollUntil(ctx, bulkOperationPollInterval, func(ctx context.Context) (bool, error) {
+		if operation.IsFinished() {
+			return true, nil
+		}
+		id := operation.ID
+		var err error
+		operation, err = c.GetBulkOperation(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if operation == nil {
+			return false, fmt.Errorf("bulk operation %s not found", id)
+		}
+		return operation.IsFinished(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+type GetBulkOperationResponse struct {
+	Node *BulkOperation `json:"node"`
+}
+
+// GetBulkOperation returns the bulk operation, or nil if it doesn't exist.
+func (c *Client) GetBulkOperation(ctx context.Context, id string) (*BulkOperation, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query bulkOperation($id: ID!) {
+  node(id: $id) {
+    ... on BulkOperation {
+      id
+      status
+      errorCode
+      objectCount
+      url
+      partialDataUrl
+    }
+  }
+}
+`
+
+	var gqlResp GetBulkOperationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Node, nil
+}
+
+// stageBulkMutationVariables uploads the JSONL variables of a bulk mutation and returns the path to run the mutation with.
+func (c *Client) stageBulkMutationVariables(ctx context.Context, jsonl []byte) (string, error) {
+	input := []*StagedUploadInput{{
+		Resource:   "BULK_MUTATION_VARIABLES",
+		Filename:   "bulk_op_vars",
+		MimeType:   "text/jsonl",
+		HttpMethod: "POST",
+	}}
+	query := `
+mutation stagedUploadsCreate($input: [StagedUploadInput!]!) {
+  stagedUploadsCreate(input: $input) {
+    stagedTargets {
+      url
+      resourceUrl
+      parameters {
+        name
+        value
+      }
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+	var gqlResp CreateStagedUploadsResponse
+	err := c.mutate(ctx, query, map[string]interface{}{"input": input}, &gqlResp)
+	if err != nil {
+		return "", err
+	}
+	if err := gqlResp.StagedUploadsCreate.UserErrors.Error(); err != nil {
+		return "", err
+	}
+	if len(gqlResp.StagedUploadsCreate.StagedTargets) == 0 {
+		return "", fmt.Errorf("no staged upload target has been created")
+	}
+	target := gqlResp.StagedUploadsCreate.StagedTargets[0]
+
+	// The form parameters must precede the file
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	stagedUploadPath := ""
+	for _, parameter := range target.Parameters {
+		if parameter.Name == "key" {
+			stagedUploadPath = parameter.Value
+		}
+		if err := form.WriteField(parameter.Name, parameter.Value); err != nil {
+			return "", err
+		}
+	}
+	file, err := form.CreateFormFile("file", input[0].Filename)
+	if err != nil {
+		return "", err
+	}
+	if _, err := file.Write(jsonl); err != nil {
+		return "", err
+	}
+	if err := form.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, &body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	// The URL is signed, so the request must not carry the access token of the shop
+	resp, err := c.shopifyClient.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("unable to upload the bulk mutation variables: %s", resp.Status)
+	}
+	if stagedUploadPath == "" {
+		return "", fmt.Errorf("the staged upload target has no key")
+	}
+	return stagedUploadPath, nil
+}
@@ -0,0 +1,114 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// bulkMutationHandler serves the requests of a bulk mutation, answering the results of each line of variables with result.
+func bulkMutationHandler(t *testing.T, status string, result func(line int, variables string) string) http.HandlerFunc {
+	var uploaded []string
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Host {
+		case "upload.test":
+			if r.Header.Get("X-Shopify-Access-Token") != "" {
+				t.Error("expected the access token not to be sent to the upload target")
+			}
+			if r.FormValue("key") != "tmp/bulk_op_vars" {
+				t.Errorf("unexpected key: %q", r.FormValue("key"))
+			}
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Fatal(err)
+			}
+			content, _ := io.ReadAll(file)
+			uploaded = strings.Split(strings.TrimSpace(string(content)), "\n")
+			w.WriteHeader(http.StatusCreated)
+			return
+		case "results.test":
+			for i, variables := range uploaded {
+				_, _ = w.Write([]byte(result(i, variables) + "\n"))
+			}
+			return
+		}
+
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case strings.Contains(body.Query, "stagedUploadsCreate"):
+			_, _ = w.Write([]byte(`{"data":{"stagedUploadsCreate":{"stagedTargets":[{"url":"https://upload.test/","resourceUrl":"https://upload.test/tmp/bulk_op_vars","parameters":[{"name":"key","value":"tmp/bulk_op_vars"},{"name":"policy","value":"signed"}]}],"userErrors":[]}}}`))
+		case strings.Contains(body.Query, "bulkOperationRunMutation"):
+			if body.Variables["stagedUploadPath"] != "tmp/bulk_op_vars" {
+				t.Errorf("unexpected staged upload path: %v", body.Variables["stagedUploadPath"])
+			}
+			_, _ = w.Write([]byte(`{"data":{"bulkOperationRunMutation":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","status":"CREATED"},"userErrors":[]}}}`))
+		case strings.Contains(body.Query, "bulkOperation("):
+			if status == "COMPLETED" {
+				_, _ = w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"COMPLETED","url":"https://results.test/results.jsonl"}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"` + status + `","errorCode":"INTERNAL_SERVER_ERROR","partialDataUrl":"https://results.test/results.jsonl"}}}`))
+		default:
+			t.Errorf("unexpected query: %s", body.Query)
+		}
+	}
+}
+
+func TestRunBulkMutation(t *testing.T) {
+	client := newTestClient(t, bulkMutationHandler(t, "COMPLETED", func(line int, variables string) string {
+		// The variables are the node itself
+		return fmt.Sprintf(`{"data":{"tagsAdd":{"node":%s}},"__lineNumber":%d}`, variables, line)
+	}))
+
+	results, err := client.RunBulkMutation(context.Background(), "mutation tagsAdd($id: ID!) { tagsAdd(id: $id, tags: [\"a\"]) { node { id } } }", []map[string]interface{}{
+		{"id": "gid://shopify/Product/1"},
+		{"id": "gid://shopify/Product/2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("unexpected results: %v", results)
+	}
+	for i, id := range []string{"gid://shopify/Product/1", "gid://shopify/Product/2"} {
+		if results[i].Err() != nil || !strings.Contains(string(results[i].Data), id) {
+			t.Errorf("unexpected result %d: %s", i, results[i].Data)
+		}
+	}
+}
+
+func TestRunBulkMutation_failed(t *testing.T) {
+	client := newTestClient(t, bulkMutationHandler(t, "FAILED", func(line int, variables string) string {
+		if line == 0 {
+			return `{"data":{"tagsAdd":{"node":{"id":"gid://shopify/Product/1"}}},"__lineNumber":0}`
+		}
+		return `{"errors":[{"message":"Internal error"}],"__lineNumber":1}`
+	}))
+
+	results, err := client.RunBulkMutation(context.Background(), "mutation tagsAdd($id: ID!) { tagsAdd(id: $id, tags: [\"a\"]) { node { id } } }", []map[string]interface{}{
+		{"id": "gid://shopify/Product/1"},
+		{"id": "gid://shopify/Product/2"},
+		{"id": "gid://shopify/Product/3"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "INTERNAL_SERVER_ERROR") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// The results of the partial data are returned along with the error
+	if results[0] == nil || results[0].Err() != nil {
+		t.Errorf("unexpected result 0: %+v", results[0])
+	}
+	if results[1] == nil || results[1].Err() == nil || results[1].Err().Error() != "Internal error" {
+		t.Errorf("unexpected result 1: %+v", results[1])
+	}
+	if _, ok := results[2]; ok {
+		t.Errorf("expected no result for the line which hasn't run: %+v", results[2])
+	}
+}
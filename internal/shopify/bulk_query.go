@@ -0,0 +1,112 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type RunBulkQueryResponse struct {
+	BulkOperationRunQuery struct {
+		BulkOperation *BulkOperation `json:"bulkOperation"`
+		UserErrors    UserErrors     `json:"userErrors"`
+	} `json:"bulkOperationRunQuery"`
+}
+
+// bulkQueryCache caches the completed bulk queries by query, so that the data sources running the same query
+// within one plan or apply run a single bulk operation, as a shop only runs a bulk query of an app at a time.
+type bulkQueryCache struct {
+	mu         sync.Mutex
+	operations map[string]*BulkOperation
+	// running deduplicates the same queries in flight.
+	running singleflight.Group
+}
+
+func (c *bulkQueryCache) get(query string) (*BulkOperation, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	operation, ok := c.operations[query]
+	return operation, ok
+}
+
+func (c *bulkQueryCache) put(query string, operation *BulkOperation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.operations == nil {
+		c.operations = make(map[string]*BulkOperation)
+	}
+	c.operations[query] = operation
+}
+
+// RunBulkQuery runs the query in a bulk operation, waits for it to complete and returns it,
+// with the URL of its JSONL results to be read by FetchBulkResults. The completed queries are cached by the client,
+// so running the same query again returns the same operation. The operation only reads the shop,
+// so it runs even with a read-only client.
+func (c *Client) RunBulkQuery(ctx context.Context, query string) (*BulkOperation, error) {
+	if operation, ok := c.bulkQueries.get(query); ok {
+		return operation, nil
+	}
+	result, err, _ := c.bulkQueries.running.Do(query, func() (interface{}, error) {
+		operation, err := c.runBulkQuery(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		c.bulkQueries.put(query, operation)
+		return operation, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BulkOperation), nil
+}
+
+func (c *Client) runBulkQuery(ctx context.Context, query string) (*BulkOperation, error) {
+	mutation := `
+mutation bulkOperationRunQuery($query: String!) {
+  bulkOperationRunQuery(query: $query) {
+    bulkOperation {
+      id
+      status
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+	// Unlike mutate, the operation isn't refused by a read-only client nor recorded, as it doesn't modify the shop
+	var data json.RawMessage
+	err := c.shopifyClient.GraphQL.Query(ctx, mutation, map[string]interface{}{"query": query}, &data)
+	err = wrapAccessDeniedError(mutation, tolerateGraphQLErrors(ctx, data, err))
+	if err != nil {
+		return nil, err
+	}
+	var gqlResp RunBulkQueryResponse
+	if err := json.Unmarshal(data, &gqlResp); err != nil {
+		return nil, err
+	}
+	if err := gqlResp.BulkOperationRunQuery.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	operation := gqlResp.BulkOperationRunQuery.BulkOperation
+	if operation == nil {
+		return nil, fmt.Errorf("no bulk operation has been started")
+	}
+
+	operation, err = c.waitForBulkOperation(ctx, operation)
+	if err != nil {
+		return nil, err
+	}
+	if operation.Status != "COMPLETED" {
+		errorCode := "unknown"
+		if operation.ErrorCode != nil {
+			errorCode = *operation.ErrorCode
+		}
+		return nil, fmt.Errorf("bulk operation %s is %s, error code: %s", operation.ID, operation.Status, errorCode)
+	}
+	return operation, nil
+}
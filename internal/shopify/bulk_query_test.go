@@ -0,0 +1,66 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRunBulkQuery(t *testing.T) {
+	var runs int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case strings.Contains(body.Query, "bulkOperationRunQuery"):
+			runs++
+			if body.Variables["query"] != "{ products { edges { node { id } } } }" {
+				t.Errorf("unexpected query: %v", body.Variables["query"])
+			}
+			_, _ = w.Write([]byte(`{"data":{"bulkOperationRunQuery":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","status":"CREATED"},"userErrors":[]}}}`))
+		case strings.Contains(body.Query, "bulkOperation("):
+			_, _ = w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"COMPLETED","objectCount":"2","url":"https://results.test/results.jsonl"}}}`))
+		default:
+			t.Errorf("unexpected query: %s", body.Query)
+		}
+	})
+	// The bulk query only reads the shop
+	client.config.ReadOnly = true
+
+	for range 2 {
+		operation, err := client.RunBulkQuery(context.Background(), "{ products { edges { node { id } } } }")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if operation.ObjectCount != "2" || operation.URL == nil || *operation.URL != "https://results.test/results.jsonl" {
+			t.Errorf("unexpected operation: %+v", operation)
+		}
+	}
+	if runs != 1 {
+		t.Errorf("expected the completed query to be cached, got %d runs", runs)
+	}
+}
+
+func TestRunBulkQuery_failed(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if strings.Contains(body.Query, "bulkOperationRunQuery") {
+			_, _ = w.Write([]byte(`{"data":{"bulkOperationRunQuery":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","status":"CREATED"},"userErrors":[]}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"FAILED","errorCode":"TIMEOUT"}}}`))
+	})
+
+	_, err := client.RunBulkQuery(context.Background(), "{ products { edges { node { id } } } }")
+	if err == nil || !strings.Contains(err.Error(), "TIMEOUT") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
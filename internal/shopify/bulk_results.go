@@ -0,0 +1,65 @@
+package shopify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// FetchBulkResults downloads the JSONL results of a bulk operation from the URL and yields each line as it's read,
+// so that the results don't have to fit in memory. Iteration stops with an error if the download fails,
+// a line isn't valid JSON, or the context is done.
+func (c *Client) FetchBulkResults(ctx context.Context, url string) iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		// The results may be too large to be logged
+		req, err := http.NewRequestWithContext(utils.WithoutResponseBodyLog(ctx), http.MethodGet, url, nil)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		// The URL is signed, so the request must not carry the access token of the shop
+		resp, err := c.shopifyClient.Client.Do(req)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			yield(nil, fmt.Errorf("unable to download the bulk operation results: %s", resp.Status))
+			return
+		}
+
+		reader := bufio.NewReader(resp.Body)
+		for lineNumber := 1; ; lineNumber++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+			line, err := reader.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(nil, err)
+				return
+			}
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				if !json.Valid(line) {
+					yield(nil, fmt.Errorf("invalid JSON on line %d of the bulk operation results", lineNumber))
+					return
+				}
+				if !yield(json.RawMessage(line), nil) {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+		}
+	}
+}
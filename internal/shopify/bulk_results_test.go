@@ -0,0 +1,86 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+const bulkResultsFixture = `{"id":"gid://shopify/Product/1","title":"First"}
+{"id":"gid://shopify/ProductVariant/11","__parentId":"gid://shopify/Product/1"}
+
+{"id":"gid://shopify/Product/2","title":"Second"}`
+
+func TestFetchBulkResults(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Shopify-Access-Token") != "" {
+			t.Error("expected the access token not to be sent")
+		}
+		_, _ = w.Write([]byte(bulkResultsFixture))
+	})
+
+	var ids []string
+	for line, err := range client.FetchBulkResults(context.Background(), "https://storage.googleapis.com/results.jsonl") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		var record struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(line, &record); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, record.ID)
+	}
+	want := []string{"gid://shopify/Product/1", "gid://shopify/ProductVariant/11", "gid://shopify/Product/2"}
+	if len(ids) != len(want) {
+		t.Fatalf("expected %v, got %v", want, ids)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, ids)
+		}
+	}
+}
+
+func TestFetchBulkResults_cancelled(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(bulkResultsFixture))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var lines int
+	var lastErr error
+	for _, err := range client.FetchBulkResults(ctx, "https://storage.googleapis.com/results.jsonl") {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		lines++
+		// Stop the download after the first line
+		cancel()
+	}
+	if lines != 1 {
+		t.Errorf("expected 1 line before the cancellation, got %d", lines)
+	}
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", lastErr)
+	}
+}
+
+func TestFetchBulkResults_invalidLine(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{\"id\":\"1\"}\nnot json\n"))
+	})
+
+	var lastErr error
+	for _, err := range client.FetchBulkResults(context.Background(), "https://storage.googleapis.com/results.jsonl") {
+		lastErr = err
+	}
+	if lastErr == nil {
+		t.Error("expected an error for the invalid line")
+	}
+}
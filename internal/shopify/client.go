@@ -1,15 +1,209 @@
 package shopify
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
 	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
 )
 
+// Config is the configuration the client has been created with.
+type Config struct {
+	Shop        string
+	APIVersion  string
+	AccessToken string
+	// MaxConcurrency is the maximum number of mutating operations in flight at once.
+	// Zero means no limit.
+	MaxConcurrency int
+	// ReadOnly makes every mutating operation fail with ErrReadOnly, while the reads still work.
+	ReadOnly bool
+	// NewVersionClient creates the raw client of another API version, for the resources overriding it.
+	// Nil if the client can't use another API version.
+	NewVersionClient func(apiVersion string) (*goshopify.Client, error)
+	// OnOperation is called after every mutating operation, successful or not. It's called concurrently
+	// by the concurrent operations. Nil if the operations aren't recorded.
+	OnOperation func(Operation)
+}
+
+// ErrReadOnly is the error of the mutating operations of a read-only client.
+var ErrReadOnly = errors.New("provider is in read_only mode, so the shop can't be modified")
+
 type Client struct {
 	shopifyClient *goshopify.Client
+	config        Config
+	// semaphore limits the number of concurrent mutating operations, nil if unlimited.
+	semaphore chan struct{}
+	// reads deduplicates identical queries in flight.
+	reads singleflight.Group
+	// locks serializes the read-modify-write operations on the same object, e.g. a metaobject definition, by key.
+	locks *sync.Map
+	// versions caches the clients of other API versions by version.
+	versions   map[string]*Client
+	versionsMu sync.Mutex
+	// restCallLimit is the last usage of the REST call bucket, shared by the clients of every API version.
+	restCallLimit *atomic.Pointer[RESTCallLimit]
+	// definitionGIDs caches the GIDs of the metaobject definitions by type, shared by the clients of every API version.
+	definitionGIDs *definitionGIDCache
+	// bulkQueries caches the completed bulk queries by query.
+	bulkQueries bulkQueryCache
 }
 
-func NewClient(shopifyClient *goshopify.Client) *Client {
+func NewClient(shopifyClient *goshopify.Client, config Config) *Client {
+	var semaphore chan struct{}
+	if config.MaxConcurrency > 0 {
+		semaphore = make(chan struct{}, config.MaxConcurrency)
+	}
 	return &Client{
-		shopifyClient: shopifyClient,
+		shopifyClient:  shopifyClient,
+		config:         config,
+		semaphore:      semaphore,
+		locks:          &sync.Map{},
+		restCallLimit:  &atomic.Pointer[RESTCallLimit]{},
+		definitionGIDs: &definitionGIDCache{},
+	}
+}
+
+// Config returns the configuration the client has been created with.
+func (c *Client) Config() Config {
+	return c.config
+}
+
+// APIVersion returns the Shopify API version the client uses, with latest already resolved to the actual version.
+func (c *Client) APIVersion() string {
+	return c.config.APIVersion
+}
+
+// SupportsAPIVersion returns whether the client uses the API version or a later one,
+// for the features introduced in that version. The unstable version is later than any.
+func (c *Client) SupportsAPIVersion(apiVersion string) bool {
+	return c.config.APIVersion == "unstable" || c.config.APIVersion >= apiVersion
+}
+
+// ForAPIVersion returns the client using the API version. The clients of other versions than the configured one
+// are created on demand and cached, and share the limit of concurrent mutating operations. An empty version returns the client itself.
+func (c *Client) ForAPIVersion(apiVersion string) (*Client, error) {
+	if apiVersion == "" || apiVersion == c.config.APIVersion {
+		return c, nil
+	}
+	if c.config.NewVersionClient == nil {
+		return nil, fmt.Errorf("the client can't use another API version than %s", c.config.APIVersion)
+	}
+
+	c.versionsMu.Lock()
+	defer c.versionsMu.Unlock()
+	if client, ok := c.versions[apiVersion]; ok {
+		return client, nil
+	}
+	shopifyClient, err := c.config.NewVersionClient(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	config := c.config
+	config.APIVersion = apiVersion
+	client := &Client{
+		shopifyClient:  shopifyClient,
+		config:         config,
+		semaphore:      c.semaphore,
+		locks:          c.locks,
+		restCallLimit:  c.restCallLimit,
+		definitionGIDs: c.definitionGIDs,
+	}
+	if c.versions == nil {
+		c.versions = make(map[string]*Client)
+	}
+	c.versions[apiVersion] = client
+	return client, nil
+}
+
+// acquire waits for a slot to run a mutating operation and returns the function to release it.
+// It fails with the context error if the context is done before a slot is available,
+// and with ErrReadOnly if the client is read-only. Every mutating operation must acquire a slot first.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.config.ReadOnly {
+		return nil, ErrReadOnly
+	}
+	if c.semaphore == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.semaphore <- struct{}{}:
+		return func() { <-c.semaphore }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// lock locks the key and returns the function to unlock it, so that the operations on the same object
+// run one at a time across the resources using the client.
+func (c *Client) lock(key string) func() {
+	mu, _ := c.locks.LoadOrStore(key, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// mutate runs the GraphQL mutation once a slot for mutating operations is available.
+// A mutation denied for lack of scope fails with an AccessDeniedError naming the scope.
+// The errors returned along with usable data are handled by tolerateGraphQLErrors.
+// After an ambiguous failure, e.g. a timeout, the idempotent mutations are sent once again,
+// while the creates fail with an AmbiguousMutationError, not to be duplicated by a retry.
+func (c *Client) mutate(ctx context.Context, query string, variables, resp interface{}) error {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	mutation := mutationName(query)
+	var data json.RawMessage
+	err = c.shopifyClient.GraphQL.Query(ctx, query, variables, &data)
+	if isAmbiguousFailure(err) && idempotentMutations[mutation] && ctx.Err() == nil {
+		tflog.Warn(ctx, "Shopify GraphQL API mutation failed without telling whether it has been applied, retrying it as it's idempotent", map[string]interface{}{
+			"mutation": mutation,
+			"error":    err.Error(),
+		})
+		data = nil
+		err = c.shopifyClient.GraphQL.Query(ctx, query, variables, &data)
+	}
+	if isCreateMutation(mutation) && !idempotentMutations[mutation] {
+		err = wrapAmbiguousCreateError(mutation, err)
+	}
+	err = wrapAccessDeniedError(query, tolerateGraphQLErrors(ctx, data, err))
+	c.recordMutation(variables, data, err)
+	if len(data) > 0 {
+		if unmarshalErr := json.Unmarshal(data, resp); err == nil {
+			err = unmarshalErr
+		}
+	}
+	return err
+}
+
+// query runs the GraphQL query. Identical queries in flight at the same time share a single request.
+// The errors returned along with usable data are handled by tolerateGraphQLErrors.
+func (c *Client) query(ctx context.Context, query string, variables, resp interface{}) error {
+	vars, err := json.Marshal(variables)
+	if err != nil {
+		return err
+	}
+	key := query + "\x00" + string(vars)
+	ch := c.reads.DoChan(key, func() (interface{}, error) {
+		// The request is shared, so it must not fail because the caller who started it has gone
+		var data json.RawMessage
+		err := c.shopifyClient.GraphQL.Query(context.WithoutCancel(ctx), query, variables, &data)
+		return data, tolerateGraphQLErrors(ctx, data, err)
+	})
+	select {
+	case result := <-ch:
+		if result.Err != nil {
+			return result.Err
+		}
+		return json.Unmarshal(result.Val.(json.RawMessage), resp)
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
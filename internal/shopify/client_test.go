@@ -0,0 +1,189 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestClient returns a client whose requests are served by the given handler
+// instead of the Shopify API.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			return rec.Result(), nil
+		}),
+	}
+	rawClient, err := goshopify.NewClient(goshopify.App{}, "test", "token", goshopify.WithVersion("2024-07"), goshopify.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(rawClient, Config{Shop: "test", APIVersion: "2024-07", AccessToken: "token"})
+}
+
+func TestClient_acquire(t *testing.T) {
+	c := NewClient(nil, Config{MaxConcurrency: 1})
+	release, err := c.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// No slot is available until the first one is released
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	release()
+	release, err = c.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+}
+
+func TestClient_acquireUnlimited(t *testing.T) {
+	c := NewClient(nil, Config{})
+	for i := 0; i < 10; i++ {
+		if _, err := c.acquire(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestClient_readOnly(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"shop":{"id":"gid://shopify/Shop/1"}}}`))
+	})
+	client.config.ReadOnly = true
+
+	if err := client.Ping(context.Background()); err != nil {
+		t.Errorf("expected reads to work, got %v", err)
+	}
+	if err := client.DeleteMetafieldDefinition(context.Background(), "gid://shopify/MetafieldDefinition/1"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly, got %v", err)
+	}
+	if err := client.DeletePage(context.Background(), 1); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly, got %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected only the read to be sent, got %d requests", n)
+	}
+}
+
+func TestClient_queryDeduplicatesConcurrentReads(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`{"data":{"metaobjectDefinition":{"id":"gid://shopify/MetaobjectDefinition/1","name":"Author","type":"author"}}}`))
+	})
+
+	var wg sync.WaitGroup
+	results := make([]*MetaobjectDefinition, 2)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			definition, err := client.GetMetaobjectDefinition(context.Background(), "gid://shopify/MetaobjectDefinition/1")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			results[i] = definition
+		}()
+	}
+	// Give both reads the time to be in flight before the response is sent
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected 1 request, got %d", n)
+	}
+	for _, definition := range results {
+		if definition == nil || definition.Name != "Author" {
+			t.Errorf("unexpected definition: %+v", definition)
+		}
+	}
+}
+
+func TestClient_ForAPIVersion(t *testing.T) {
+	var created []string
+	c := NewClient(nil, Config{
+		APIVersion:     "2024-07",
+		MaxConcurrency: 1,
+		NewVersionClient: func(apiVersion string) (*goshopify.Client, error) {
+			created = append(created, apiVersion)
+			return goshopify.NewClient(goshopify.App{}, "test", "token", goshopify.WithVersion(apiVersion))
+		},
+	})
+
+	for _, apiVersion := range []string{"", "2024-07"} {
+		if client, err := c.ForAPIVersion(apiVersion); err != nil || client != c {
+			t.Errorf("expected the client itself for %q, got %v, %v", apiVersion, client, err)
+		}
+	}
+
+	client, err := c.ForAPIVersion("2024-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Config().APIVersion != "2024-01" {
+		t.Errorf("unexpected API version: %s", client.Config().APIVersion)
+	}
+	if client.semaphore != c.semaphore {
+		t.Error("expected the limit of concurrent operations to be shared")
+	}
+	if cached, _ := c.ForAPIVersion("2024-01"); cached != client {
+		t.Error("expected the client to be cached")
+	}
+	if len(created) != 1 {
+		t.Errorf("expected 1 client to be created, got %v", created)
+	}
+}
+
+func TestClient_ForAPIVersionUnsupported(t *testing.T) {
+	c := NewClient(nil, Config{APIVersion: "2024-07"})
+	if _, err := c.ForAPIVersion("2024-01"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestClient_SupportsAPIVersion(t *testing.T) {
+	tests := []struct {
+		apiVersion string
+		want       bool
+	}{
+		{apiVersion: "2023-10", want: false},
+		{apiVersion: "2024-01", want: true},
+		{apiVersion: "2026-07", want: true},
+		{apiVersion: "unstable", want: true},
+	}
+	for _, tt := range tests {
+		c := NewClient(nil, Config{APIVersion: tt.apiVersion})
+		if got := c.SupportsAPIVersion("2024-01"); got != tt.want {
+			t.Errorf("SupportsAPIVersion(2024-01) with %s = %v, want %v", tt.apiVersion, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package shopify
+
+import (
+	"context"
+)
+
+// CustomerAccountSettings are the settings of the customer accounts of the shop.
+// The Admin API can only read them, they're changed in the Shopify admin.
+type CustomerAccountSettings struct {
+	// ShopID is the ID of the shop the settings belong to.
+	ShopID string `json:"-"`
+	// CustomerAccounts is whether the customers can or must log in, one of DISABLED, OPTIONAL or REQUIRED.
+	CustomerAccounts string `json:"-"`
+	// CustomerAccountsVersion is CLASSIC for the classic customer accounts, or NEW_CUSTOMER_ACCOUNTS.
+	CustomerAccountsVersion                  string  `json:"customerAccountsVersion"`
+	LoginLinksVisibleOnStorefrontAndCheckout bool    `json:"loginLinksVisibleOnStorefrontAndCheckout"`
+	LoginRequiredAtCheckout                  bool    `json:"loginRequiredAtCheckout"`
+	URL                                      *string `json:"url"`
+}
+
+type GetCustomerAccountSettingsResponse struct {
+	Shop struct {
+		ID                 string                   `json:"id"`
+		CustomerAccounts   string                   `json:"customerAccounts"`
+		CustomerAccountsV2 *CustomerAccountSettings `json:"customerAccountsV2"`
+	} `json:"shop"`
+}
+
+func (c *Client) GetCustomerAccountSettings(ctx context.Context) (*CustomerAccountSettings, error) {
+	query := `
+query customerAccountSettings {
+  shop {
+    id
+    customerAccounts
+    customerAccountsV2 {
+      customerAccountsVersion
+      loginLinksVisibleOnStorefrontAndCheckout
+      loginRequiredAtCheckout
+      url
+    }
+  }
+}
+`
+
+	var gqlResp GetCustomerAccountSettingsResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	settings := gqlResp.Shop.CustomerAccountsV2
+	if settings == nil {
+		settings = &CustomerAccountSettings{}
+	}
+	settings.ShopID = gqlResp.Shop.ID
+	settings.CustomerAccounts = gqlResp.Shop.CustomerAccounts
+	return settings, nil
+}
@@ -0,0 +1,107 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// GetCustomerAddress returns the address of the customer, or nil if it doesn't exist.
+func (c *Client) GetCustomerAddress(ctx context.Context, customerID, addressID uint64) (*goshopify.CustomerAddress, error) {
+	address, err := c.shopifyClient.CustomerAddress.Get(ctx, customerID, addressID, nil)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("customers/%d/addresses/%d.json", customerID, addressID))
+	if err != nil {
+		var responseErr goshopify.ResponseError
+		if errors.As(err, &responseErr) && responseErr.Status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return address, nil
+}
+
+func (c *Client) CreateCustomerAddress(ctx context.Context, customerID uint64, address goshopify.CustomerAddress) (*goshopify.CustomerAddress, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	createdAddress, err := c.shopifyClient.CustomerAddress.Create(ctx, customerID, address)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("customers/%d/addresses.json", customerID))
+	var id uint64
+	if createdAddress != nil {
+		id = createdAddress.Id
+	}
+	c.recordREST("customerAddress", "Create", "MailingAddress", id, err)
+	return createdAddress, wrapAmbiguousCreateError("customer address create", wrapRESTAccessDeniedError("customerAddress", "Create", err))
+}
+
+// CustomerAddressUpdateInput is the input to update a customer address.
+// Unlike goshopify.CustomerAddress, empty values are sent so that fields can be cleared.
+type CustomerAddressUpdateInput struct {
+	ID           uint64 `json:"id"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Company      string `json:"company"`
+	Address1     string `json:"address1"`
+	Address2     string `json:"address2"`
+	City         string `json:"city"`
+	ProvinceCode string `json:"province_code"`
+	CountryCode  string `json:"country_code"`
+	Zip          string `json:"zip"`
+	Phone        string `json:"phone"`
+}
+
+func (c *Client) UpdateCustomerAddress(ctx context.Context, customerID uint64, input *CustomerAddressUpdateInput) (*goshopify.CustomerAddress, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	path := fmt.Sprintf("customers/%d/addresses/%d.json", customerID, input.ID)
+	data := map[string]interface{}{"address": input}
+	var resource goshopify.CustomerAddressResource
+	err = c.shopifyClient.Put(ctx, path, data, &resource)
+	c.observeRawRESTCallLimit(ctx, path)
+	c.recordREST("customerAddress", "Update", "MailingAddress", input.ID, err)
+	err = wrapRESTAccessDeniedError("customerAddress", "Update", err)
+	if err != nil {
+		return nil, err
+	}
+	return resource.Address, nil
+}
+
+// SetDefaultCustomerAddress makes the address the default address of the customer.
+// The previous default address is no longer the default.
+func (c *Client) SetDefaultCustomerAddress(ctx context.Context, customerID, addressID uint64) (*goshopify.CustomerAddress, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	path := fmt.Sprintf("customers/%d/addresses/%d/default.json", customerID, addressID)
+	var resource goshopify.CustomerAddressResource
+	err = c.shopifyClient.Put(ctx, path, nil, &resource)
+	c.observeRawRESTCallLimit(ctx, path)
+	c.recordREST("customerAddress", "SetDefault", "MailingAddress", addressID, err)
+	err = wrapRESTAccessDeniedError("customerAddress", "SetDefault", err)
+	if err != nil {
+		return nil, err
+	}
+	return resource.Address, nil
+}
+
+func (c *Client) DeleteCustomerAddress(ctx context.Context, customerID, addressID uint64) error {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	err = c.shopifyClient.CustomerAddress.Delete(ctx, customerID, addressID)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("customers/%d/addresses/%d.json", customerID, addressID))
+	c.recordREST("customerAddress", "Delete", "MailingAddress", addressID, err)
+	return wrapRESTAccessDeniedError("customerAddress", "Delete", err)
+}
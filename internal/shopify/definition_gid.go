@@ -0,0 +1,105 @@
+package shopify
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// maxDefinitionGIDs bounds the number of cached definition GIDs, far more than the metaobject definitions a shop has.
+const maxDefinitionGIDs = 1024
+
+// definitionGIDCache caches the GIDs of the metaobject definitions by type, so that resolving the same type
+// many times within one apply, e.g. for the references of many fields, queries the API once.
+// Only the found definitions are cached, as a missing one may be created later in the apply.
+type definitionGIDCache struct {
+	mu   sync.Mutex
+	gids map[string]string
+	// types are the cached types from the oldest to the newest, to evict the oldest one when full.
+	types []string
+	// resolving deduplicates the resolutions of the same type in flight.
+	resolving singleflight.Group
+}
+
+func (c *definitionGIDCache) get(metaobjectType string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	gid, ok := c.gids[metaobjectType]
+	return gid, ok
+}
+
+func (c *definitionGIDCache) put(metaobjectType, gid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.gids == nil {
+		c.gids = make(map[string]string)
+	}
+	if _, ok := c.gids[metaobjectType]; !ok {
+		if len(c.types) >= maxDefinitionGIDs {
+			delete(c.gids, c.types[0])
+			c.types = c.types[1:]
+		}
+		c.types = append(c.types, metaobjectType)
+	}
+	c.gids[metaobjectType] = gid
+}
+
+// forget removes the type of the definition GID, e.g. once the definition is deleted.
+func (c *definitionGIDCache) forget(gid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, metaobjectType := range c.types {
+		if c.gids[metaobjectType] == gid {
+			delete(c.gids, metaobjectType)
+			c.types = append(c.types[:i], c.types[i+1:]...)
+			return
+		}
+	}
+}
+
+// ResolveMetaobjectDefinitionGID returns the GID of the metaobject definition of the type, or an empty string if it doesn't exist.
+func (c *Client) ResolveMetaobjectDefinitionGID(ctx context.Context, metaobjectType string) (string, error) {
+	return c.resolveDefinitionGID(ctx, metaobjectType)
+}
+
+// resolveDefinitionGID resolves the metaobject type to the GID of its definition, cached by the client.
+// The concurrent resolutions of the same uncached type share one query.
+func (c *Client) resolveDefinitionGID(ctx context.Context, metaobjectType string) (string, error) {
+	if gid, ok := c.definitionGIDs.get(metaobjectType); ok {
+		return gid, nil
+	}
+	gid, err, _ := c.definitionGIDs.resolving.Do(metaobjectType, func() (interface{}, error) {
+		// Resolved by another call since the lookup above
+		if gid, ok := c.definitionGIDs.get(metaobjectType); ok {
+			return gid, nil
+		}
+		query := `
+query metaobjectDefinitionByType($type: String!) {
+  metaobjectDefinitionByType(type: $type) {
+    id
+  }
+}
+`
+
+		var gqlResp struct {
+			MetaobjectDefinitionByType *struct {
+				ID string `json:"id"`
+			} `json:"metaobjectDefinitionByType"`
+		}
+		err := c.query(ctx, query, map[string]interface{}{"type": metaobjectType}, &gqlResp)
+		if err != nil {
+			return "", err
+		}
+		if gqlResp.MetaobjectDefinitionByType == nil {
+			return "", nil
+		}
+		gid := gqlResp.MetaobjectDefinitionByType.ID
+		c.definitionGIDs.put(metaobjectType, gid)
+		return gid, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return gid.(string), nil
+}
@@ -0,0 +1,84 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestResolveMetaobjectDefinitionGID_cached(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"metaobjectDefinitionByType":{"id":"gid://shopify/MetaobjectDefinition/1"}}}`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			gid, err := client.ResolveMetaobjectDefinitionGID(context.Background(), "author")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if gid != "gid://shopify/MetaobjectDefinition/1" {
+				t.Errorf("unexpected GID %q", gid)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := client.ResolveMetaobjectDefinitionGID(context.Background(), "author"); err != nil {
+		t.Fatal(err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected the API to be queried once, got %d", got)
+	}
+}
+
+func TestResolveMetaobjectDefinitionGID_notFound(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"metaobjectDefinitionByType":null}}`))
+	})
+
+	for i := 0; i < 2; i++ {
+		gid, err := client.ResolveMetaobjectDefinitionGID(context.Background(), "author")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gid != "" {
+			t.Errorf("expected no GID, got %q", gid)
+		}
+	}
+	// A missing definition isn't cached, as it may be created later
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected the API to be queried twice, got %d", got)
+	}
+}
+
+func TestDefinitionGIDCache(t *testing.T) {
+	var cache definitionGIDCache
+	for i := 0; i < maxDefinitionGIDs+1; i++ {
+		cache.put(fmt.Sprintf("type_%d", i), fmt.Sprintf("gid://shopify/MetaobjectDefinition/%d", i))
+	}
+	if _, ok := cache.get("type_0"); ok {
+		t.Error("expected the oldest type to be evicted")
+	}
+	if len(cache.gids) != maxDefinitionGIDs || len(cache.types) != maxDefinitionGIDs {
+		t.Errorf("expected %d cached types, got %d", maxDefinitionGIDs, len(cache.gids))
+	}
+
+	cache.forget("gid://shopify/MetaobjectDefinition/1")
+	if _, ok := cache.get("type_1"); ok {
+		t.Error("expected the forgotten definition to be removed")
+	}
+	if gid, ok := cache.get("type_2"); !ok || gid != "gid://shopify/MetaobjectDefinition/2" {
+		t.Errorf("unexpected GID %q", gid)
+	}
+}
@@ -0,0 +1,289 @@
+package shopify
+
+import (
+	"context"
+)
+
+// DeliveryProfile is a shipping profile, which sets the shipping rates of the zones the locations ship to.
+type DeliveryProfile struct {
+	ID                    string                          `json:"id"`
+	Name                  string                          `json:"name"`
+	ProfileLocationGroups []*DeliveryProfileLocationGroup `json:"profileLocationGroups"`
+}
+
+// DeliveryProfileLocationGroup is the group of locations of a delivery profile shipping to the same zones.
+type DeliveryProfileLocationGroup struct {
+	LocationGroup struct {
+		ID        string `json:"id"`
+		Locations struct {
+			Nodes []struct {
+				ID string `json:"id"`
+			} `json:"nodes"`
+		} `json:"locations"`
+	} `json:"locationGroup"`
+	LocationGroupZones struct {
+		Nodes []*DeliveryLocationGroupZone `json:"nodes"`
+	} `json:"locationGroupZones"`
+}
+
+// LocationIDs returns the IDs of the locations in the location group.
+func (g *DeliveryProfileLocationGroup) LocationIDs() []string {
+	ids := make([]string, 0, len(g.LocationGroup.Locations.Nodes))
+	for _, location := range g.LocationGroup.Locations.Nodes {
+		ids = append(ids, location.ID)
+	}
+	return ids
+}
+
+type DeliveryLocationGroupZone struct {
+	Zone struct {
+		ID        string             `json:"id"`
+		Name      string             `json:"name"`
+		Countries []*DeliveryCountry `json:"countries"`
+	} `json:"zone"`
+	MethodDefinitions struct {
+		Nodes []*DeliveryMethodDefinition `json:"nodes"`
+	} `json:"methodDefinitions"`
+}
+
+type DeliveryCountry struct {
+	Code struct {
+		CountryCode *string `json:"countryCode"`
+		RestOfWorld bool    `json:"restOfWorld"`
+	} `json:"code"`
+	Provinces []struct {
+		Code string `json:"code"`
+	} `json:"provinces"`
+}
+
+// DeliveryMethodDefinition is a shipping rate of a zone.
+type DeliveryMethodDefinition struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Active       bool   `json:"active"`
+	RateProvider struct {
+		Typename string `json:"__typename"`
+		// Set for DeliveryRateDefinition
+		ID    string `json:"id"`
+		Price *Money `json:"price"`
+	} `json:"rateProvider"`
+}
+
+const deliveryProfileFields = `
+      id
+      name
+      profileLocationGroups {
+        locationGroup {
+          id
+          locations(first: 250) {
+            nodes {
+              id
+            }
+          }
+        }
+        locationGroupZones(first: 250) {
+          nodes {
+            zone {
+              id
+              name
+              countries {
+                code {
+                  countryCode
+                  restOfWorld
+                }
+                provinces {
+                  code
+                }
+              }
+            }
+            methodDefinitions(first: 250) {
+              nodes {
+                id
+                name
+                description
+                active
+                rateProvider {
+                  __typename
+                  ... on DeliveryRateDefinition {
+                    id
+                    price {
+                      amount
+                      currencyCode
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }`
+
+type DeliveryProfileInput struct {
+	Name                      *string                              `json:"name,omitempty"`
+	LocationGroupsToCreate    []*DeliveryProfileLocationGroupInput `json:"locationGroupsToCreate,omitempty"`
+	LocationGroupsToUpdate    []*DeliveryProfileLocationGroupInput `json:"locationGroupsToUpdate,omitempty"`
+	LocationGroupsToDelete    []string                             `json:"locationGroupsToDelete,omitempty"`
+	ZonesToDelete             []string                             `json:"zonesToDelete,omitempty"`
+	MethodDefinitionsToDelete []string                             `json:"methodDefinitionsToDelete,omitempty"`
+}
+
+type DeliveryProfileLocationGroupInput struct {
+	ID                *string                           `json:"id,omitempty"`
+	Locations         []string                          `json:"locations,omitempty"`
+	LocationsToAdd    []string                          `json:"locationsToAdd,omitempty"`
+	LocationsToRemove []string                          `json:"locationsToRemove,omitempty"`
+	ZonesToCreate     []*DeliveryLocationGroupZoneInput `json:"zonesToCreate,omitempty"`
+	ZonesToUpdate     []*DeliveryLocationGroupZoneInput `json:"zonesToUpdate,omitempty"`
+}
+
+type DeliveryLocationGroupZoneInput struct {
+	ID                        *string                          `json:"id,omitempty"`
+	Name                      string                           `json:"name"`
+	Countries                 []*DeliveryCountryInput          `json:"countries"`
+	MethodDefinitionsToCreate []*DeliveryMethodDefinitionInput `json:"methodDefinitionsToCreate,omitempty"`
+	MethodDefinitionsToUpdate []*DeliveryMethodDefinitionInput `json:"methodDefinitionsToUpdate,omitempty"`
+}
+
+type DeliveryCountryInput struct {
+	Code                *string                  `json:"code,omitempty"`
+	RestOfWorld         bool                     `json:"restOfWorld"`
+	Provinces           []*DeliveryProvinceInput `json:"provinces,omitempty"`
+	IncludeAllProvinces bool                     `json:"includeAllProvinces"`
+}
+
+type DeliveryProvinceInput struct {
+	Code string `json:"code"`
+}
+
+type DeliveryMethodDefinitionInput struct {
+	ID             *string                      `json:"id,omitempty"`
+	Name           string                       `json:"name"`
+	Description    *string                      `json:"description,omitempty"`
+	Active         bool                         `json:"active"`
+	RateDefinition *DeliveryRateDefinitionInput `json:"rateDefinition"`
+}
+
+type DeliveryRateDefinitionInput struct {
+	ID    *string `json:"id,omitempty"`
+	Price *Money  `json:"price"`
+}
+
+type GetDeliveryProfileResponse struct {
+	DeliveryProfile *DeliveryProfile `json:"deliveryProfile"`
+}
+
+// GetDeliveryProfile returns the delivery profile, or nil if it doesn't exist.
+func (c *Client) GetDeliveryProfile(ctx context.Context, id string) (*DeliveryProfile, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query deliveryProfile($id: ID!) {
+  deliveryProfile(id: $id) {` + deliveryProfileFields + `
+  }
+}
+`
+
+	var gqlResp GetDeliveryProfileResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.DeliveryProfile, nil
+}
+
+type CreateDeliveryProfileResponse struct {
+	DeliveryProfileCreate struct {
+		Profile    *DeliveryProfile `json:"profile"`
+		UserErrors UserErrors       `json:"userErrors"`
+	} `json:"deliveryProfileCreate"`
+}
+
+// CreateDeliveryProfile creates the delivery profile.
+func (c *Client) CreateDeliveryProfile(ctx context.Context, input *DeliveryProfileInput) (*DeliveryProfile, error) {
+	variables := map[string]interface{}{"profile": input}
+	query := `
+mutation deliveryProfileCreate($profile: DeliveryProfileInput!) {
+  deliveryProfileCreate(profile: $profile) {
+    profile {` + deliveryProfileFields + `
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}
+`
+
+	var gqlResp CreateDeliveryProfileResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.DeliveryProfileCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.DeliveryProfileCreate.Profile, nil
+}
+
+type UpdateDeliveryProfileResponse struct {
+	DeliveryProfileUpdate struct {
+		Profile    *DeliveryProfile `json:"profile"`
+		UserErrors UserErrors       `json:"userErrors"`
+	} `json:"deliveryProfileUpdate"`
+}
+
+// UpdateDeliveryProfile updates the delivery profile. The location groups, zones and rates are added, updated and deleted
+// as the input lists them, and the others are kept as is.
+func (c *Client) UpdateDeliveryProfile(ctx context.Context, id string, input *DeliveryProfileInput) (*DeliveryProfile, error) {
+	variables := map[string]interface{}{"id": id, "profile": input}
+	query := `
+mutation deliveryProfileUpdate($id: ID!, $profile: DeliveryProfileInput!) {
+  deliveryProfileUpdate(id: $id, profile: $profile) {
+    profile {` + deliveryProfileFields + `
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}
+`
+
+	var gqlResp UpdateDeliveryProfileResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.DeliveryProfileUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.DeliveryProfileUpdate.Profile, nil
+}
+
+type DeleteDeliveryProfileResponse struct {
+	DeliveryProfileRemove struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"deliveryProfileRemove"`
+}
+
+// DeleteDeliveryProfile deletes the delivery profile. Shopify moves its products back to the general profile in the background.
+func (c *Client) DeleteDeliveryProfile(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation deliveryProfileRemove($id: ID!) {
+  deliveryProfileRemove(id: $id) {
+    userErrors {
+      field
+      message
+    }
+  }
+}
+`
+
+	var gqlResp DeleteDeliveryProfileResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.DeliveryProfileRemove.UserErrors.Error()
+}
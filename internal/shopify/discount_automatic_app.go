@@ -0,0 +1,257 @@
+package shopify
+
+import (
+	"context"
+	"time"
+)
+
+// DiscountFunctionAPITypes are the API types of the Shopify Functions that can back an app discount.
+var DiscountFunctionAPITypes = []string{"discount", "product_discounts", "order_discounts", "shipping_discounts"}
+
+// The classes of discounts, i.e. what they apply to.
+const (
+	DiscountClassOrder    = "ORDER"
+	DiscountClassProduct  = "PRODUCT"
+	DiscountClassShipping = "SHIPPING"
+)
+
+// DiscountFunctionAPITypeClasses are the classes of the discounts backed by the functions of the API types.
+// The discount API type isn't listed, as its functions can apply to several classes.
+var DiscountFunctionAPITypeClasses = map[string]string{
+	"product_discounts":  DiscountClassProduct,
+	"order_discounts":    DiscountClassOrder,
+	"shipping_discounts": DiscountClassShipping,
+}
+
+// DiscountAutomaticApp is an automatic discount whose logic is implemented by a Shopify Function of an app.
+type DiscountAutomaticApp struct {
+	DiscountID      string                `json:"discountId"`
+	Title           string                `json:"title"`
+	Status          string                `json:"status"`
+	StartsAt        time.Time             `json:"startsAt"`
+	EndsAt          *time.Time            `json:"endsAt"`
+	CombinesWith    *DiscountCombinesWith `json:"combinesWith"`
+	AppDiscountType struct {
+		FunctionID string `json:"functionId"`
+	} `json:"appDiscountType"`
+	// Metafields are the metafields of the discount, which configure the function.
+	Metafields []*Metafield `json:"-"`
+}
+
+// DiscountCombinesWith is the classes of discounts a discount can be combined with.
+type DiscountCombinesWith struct {
+	OrderDiscounts    bool `json:"orderDiscounts"`
+	ProductDiscounts  bool `json:"productDiscounts"`
+	ShippingDiscounts bool `json:"shippingDiscounts"`
+}
+
+type DiscountAutomaticAppInput struct {
+	Title        string                `json:"title"`
+	FunctionID   string                `json:"functionId,omitempty"`
+	StartsAt     time.Time             `json:"startsAt"`
+	EndsAt       *time.Time            `json:"endsAt"`
+	CombinesWith *DiscountCombinesWith `json:"combinesWith,omitempty"`
+	Metafields   []*MetafieldInput     `json:"metafields,omitempty"`
+}
+
+// MetafieldInput is a metafield created or updated along with its owner.
+type MetafieldInput struct {
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+}
+
+// ShopifyFunction is a Shopify Function of an app installed on the shop.
+type ShopifyFunction struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	APIType string `json:"apiType"`
+}
+
+const discountAutomaticAppFields = `
+      discountId
+      title
+      status
+      startsAt
+      endsAt
+      combinesWith {
+        orderDiscounts
+        productDiscounts
+        shippingDiscounts
+      }
+      appDiscountType {
+        functionId
+      }`
+
+type GetDiscountAutomaticAppResponse struct {
+	DiscountNode *struct {
+		Discount   *DiscountAutomaticApp `json:"discount"`
+		Metafields struct {
+			Nodes []*Metafield `json:"nodes"`
+		} `json:"metafields"`
+	} `json:"discountNode"`
+}
+
+// GetDiscountAutomaticApp returns the automatic app discount with its metafields, or nil if it doesn't exist.
+func (c *Client) GetDiscountAutomaticApp(ctx context.Context, id string) (*DiscountAutomaticApp, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query discountNode($id: ID!) {
+  discountNode(id: $id) {
+    discount {
+      ... on DiscountAutomaticApp {` + discountAutomaticAppFields + `
+      }
+    }
+    metafields(first: 250) {
+      nodes {
+        id
+        namespace
+        key
+        type
+        value
+      }
+    }
+  }
+}
+`
+
+	var gqlResp GetDiscountAutomaticAppResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	// The discount is empty if it's another kind of discount
+	if gqlResp.DiscountNode == nil || gqlResp.DiscountNode.Discount == nil || gqlResp.DiscountNode.Discount.DiscountID == "" {
+		return nil, nil
+	}
+	discount := gqlResp.DiscountNode.Discount
+	discount.Metafields = gqlResp.DiscountNode.Metafields.Nodes
+	return discount, nil
+}
+
+type CreateDiscountAutomaticAppResponse struct {
+	DiscountAutomaticAppCreate struct {
+		AutomaticAppDiscount *DiscountAutomaticApp `json:"automaticAppDiscount"`
+		UserErrors           UserErrors            `json:"userErrors"`
+	} `json:"discountAutomaticAppCreate"`
+}
+
+// CreateDiscountAutomaticApp creates the automatic app discount. The returned discount has no metafields.
+func (c *Client) CreateDiscountAutomaticApp(ctx context.Context, input *DiscountAutomaticAppInput) (*DiscountAutomaticApp, error) {
+	variables := map[string]interface{}{"automaticAppDiscount": input}
+	query := `
+mutation discountAutomaticAppCreate($automaticAppDiscount: DiscountAutomaticAppInput!) {
+  discountAutomaticAppCreate(automaticAppDiscount: $automaticAppDiscount) {
+    automaticAppDiscount {` + discountAutomaticAppFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp CreateDiscountAutomaticAppResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.DiscountAutomaticAppCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.DiscountAutomaticAppCreate.AutomaticAppDiscount, nil
+}
+
+type UpdateDiscountAutomaticAppResponse struct {
+	DiscountAutomaticAppUpdate struct {
+		AutomaticAppDiscount *DiscountAutomaticApp `json:"automaticAppDiscount"`
+		UserErrors           UserErrors            `json:"userErrors"`
+	} `json:"discountAutomaticAppUpdate"`
+}
+
+// UpdateDiscountAutomaticApp updates the automatic app discount. The metafields in the input are created or updated,
+// the other ones are left unchanged. The returned discount has no metafields.
+func (c *Client) UpdateDiscountAutomaticApp(ctx context.Context, id string, input *DiscountAutomaticAppInput) (*DiscountAutomaticApp, error) {
+	variables := map[string]interface{}{"id": id, "automaticAppDiscount": input}
+	query := `
+mutation discountAutomaticAppUpdate($id: ID!, $automaticAppDiscount: DiscountAutomaticAppInput!) {
+  discountAutomaticAppUpdate(id: $id, automaticAppDiscount: $automaticAppDiscount) {
+    automaticAppDiscount {` + discountAutomaticAppFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp UpdateDiscountAutomaticAppResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.DiscountAutomaticAppUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.DiscountAutomaticAppUpdate.AutomaticAppDiscount, nil
+}
+
+type DeleteDiscountAutomaticResponse struct {
+	DiscountAutomaticDelete struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"discountAutomaticDelete"`
+}
+
+// DeleteDiscountAutomatic deletes the automatic discount, whatever its kind.
+func (c *Client) DeleteDiscountAutomatic(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation discountAutomaticDelete($id: ID!) {
+  discountAutomaticDelete(id: $id) {
+    deletedAutomaticDiscountId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp DeleteDiscountAutomaticResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.DiscountAutomaticDelete.UserErrors.Error()
+}
+
+type GetShopifyFunctionResponse struct {
+	ShopifyFunction *ShopifyFunction `json:"shopifyFunction"`
+}
+
+// GetShopifyFunction returns the Shopify Function, or nil if it doesn't exist.
+func (c *Client) GetShopifyFunction(ctx context.Context, id string) (*ShopifyFunction, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query shopifyFunction($id: String!) {
+  shopifyFunction(id: $id) {
+    id
+    title
+    apiType
+  }
+}
+`
+
+	var gqlResp GetShopifyFunctionResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.ShopifyFunction, nil
+}
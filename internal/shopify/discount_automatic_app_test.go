@@ -0,0 +1,41 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetDiscountAutomaticApp(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"discountNode":{"discount":{"discountId":"gid://shopify/DiscountAutomaticNode/1","title":"Test","status":"ACTIVE","startsAt":"2024-01-01T00:00:00Z","endsAt":null,` +
+			`"combinesWith":{"orderDiscounts":false,"productDiscounts":true,"shippingDiscounts":false},"appDiscountType":{"functionId":"fn"}},` +
+			`"metafields":{"nodes":[{"id":"gid://shopify/Metafield/1","namespace":"$app:discount","key":"configuration","type":"json","value":"{}"}]}}}}`))
+	})
+
+	discount, err := client.GetDiscountAutomaticApp(context.Background(), "gid://shopify/DiscountAutomaticNode/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if discount == nil || discount.AppDiscountType.FunctionID != "fn" || !discount.CombinesWith.ProductDiscounts {
+		t.Fatalf("unexpected discount: %+v", discount)
+	}
+	if len(discount.Metafields) != 1 || discount.Metafields[0].Key != "configuration" {
+		t.Errorf("unexpected metafields: %+v", discount.Metafields)
+	}
+}
+
+func TestGetDiscountAutomaticApp_otherKind(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// A basic automatic discount doesn't match the fragment
+		_, _ = w.Write([]byte(`{"data":{"discountNode":{"discount":{},"metafields":{"nodes":[]}}}}`))
+	})
+
+	discount, err := client.GetDiscountAutomaticApp(context.Background(), "gid://shopify/DiscountAutomaticNode/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if discount != nil {
+		t.Errorf("expected no discount, got %+v", discount)
+	}
+}
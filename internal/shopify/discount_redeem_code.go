@@ -0,0 +1,218 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MaxDiscountRedeemCodeBulkSize is the maximum number of codes that can be added in a single bulk creation.
+const MaxDiscountRedeemCodeBulkSize = 250
+
+const discountRedeemCodeBulkPollInterval = time.Second
+
+type DiscountRedeemCodeBulkCreation struct {
+	ID            string                                 `json:"id"`
+	Done          bool                                   `json:"done"`
+	CodesCount    int                                    `json:"codesCount"`
+	ImportedCount int                                    `json:"importedCount"`
+	FailedCount   int                                    `json:"failedCount"`
+	Codes         DiscountRedeemCodeBulkCreationCodeList `json:"codes"`
+}
+
+type DiscountRedeemCodeBulkCreationCodeList struct {
+	Nodes []*DiscountRedeemCodeBulkCreationCode `json:"nodes"`
+}
+
+type DiscountRedeemCodeBulkCreationCode struct {
+	Code               string              `json:"code"`
+	Errors             UserErrors          `json:"errors"`
+	DiscountRedeemCode *DiscountRedeemCode `json:"discountRedeemCode"`
+}
+
+type DiscountRedeemCode struct {
+	ID   string `json:"id"`
+	Code string `json:"code"`
+}
+
+type DiscountRedeemCodeInput struct {
+	Code string `json:"code"`
+}
+
+const discountRedeemCodeBulkCreationFields = `
+      id
+      done
+      codesCount
+      importedCount
+      failedCount
+      codes(first: 250) {
+        nodes {
+          code
+          errors {
+            field
+            message
+            code
+          }
+          discountRedeemCode {
+            id
+            code
+          }
+        }
+      }`
+
+type AddDiscountRedeemCodesResponse struct {
+	DiscountRedeemCodeBulkAdd struct {
+		BulkCreation *DiscountRedeemCodeBulkCreation `json:"bulkCreation"`
+		UserErrors   UserErrors                      `json:"userErrors"`
+	} `json:"discountRedeemCodeBulkAdd"`
+}
+
+// AddDiscountRedeemCodes adds the codes to the discount and waits for the bulk creation to finish.
+func (c *Client) AddDiscountRedeemCodes(ctx context.Context, discountID string, codes []string) (*DiscountRedeemCodeBulkCreation, error) {
+	inputs := make([]*DiscountRedeemCodeInput, 0, len(codes))
+	for _, code := range codes {
+		inputs = append(inputs, &DiscountRedeemCodeInput{Code: code})
+	}
+	variables := map[string]interface{}{"discountId": discountID, "codes": inputs}
+	query := `
+mutation AddDiscountRedeemCodes($discountId: ID!, $codes: [DiscountRedeemCodeInput!]!) {
+  discountRedeemCodeBulkAdd(discountId: $discountId, codes: $codes) {
+    bulkCreation {` + discountRedeemCodeBulkCreationFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp AddDiscountRedeemCodesResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.DiscountRedeemCodeBulkAdd.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+
+	bulkCreation := gqlResp.DiscountRedeemCodeBulkAdd.BulkCreation
+	err = pollUntil(ctx, discountRedeemCodeBulkPollInterval, func(ctx context.Context) (bool, error) {
+		if bulkCreation.Done {
+			return true, nil
+		}
+		id := bulkCreation.ID
+		bulkCreation, err = c.GetDiscountRedeemCodeBulkCreation(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if bulkCreation == nil {
+			return false, fmt.Errorf("discount redeem code bulk creation %s not found", id)
+		}
+		return bulkCreation.Done, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bulkCreation, nil
+}
+
+type GetDiscountRedeemCodeBulkCreationResponse struct {
+	DiscountRedeemCodeBulkCreation *DiscountRedeemCodeBulkCreation `json:"discountRedeemCodeBulkCreation"`
+}
+
+func (c *Client) GetDiscountRedeemCodeBulkCreation(ctx context.Context, id string) (*DiscountRedeemCodeBulkCreation, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query discountRedeemCodeBulkCreation($id: ID!) {
+  discountRedeemCodeBulkCreation(id: $id) {` + discountRedeemCodeBulkCreationFields + `
+  }
+}
+`
+
+	var gqlResp GetDiscountRedeemCodeBulkCreationResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.DiscountRedeemCodeBulkCreation, nil
+}
+
+type Job struct {
+	ID   string `json:"id"`
+	Done bool   `json:"done"`
+}
+
+type GetJobResponse struct {
+	Job *Job `json:"job"`
+}
+
+func (c *Client) GetJob(ctx context.Context, id string) (*Job, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query job($id: ID!) {
+  job(id: $id) {
+    id
+    done
+  }
+}
+`
+
+	var gqlResp GetJobResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Job, nil
+}
+
+type DeleteDiscountRedeemCodesResponse struct {
+	DiscountCodeRedeemCodeBulkDelete struct {
+		Job        *Job       `json:"job"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"discountCodeRedeemCodeBulkDelete"`
+}
+
+// DeleteDiscountRedeemCodes deletes the codes from the discount and waits for the deletion to finish.
+func (c *Client) DeleteDiscountRedeemCodes(ctx context.Context, discountID string, ids []string) error {
+	variables := map[string]interface{}{"discountId": discountID, "ids": ids}
+	query := `
+mutation DeleteDiscountRedeemCodes($discountId: ID!, $ids: [ID!]) {
+  discountCodeRedeemCodeBulkDelete(discountId: $discountId, ids: $ids) {
+    job {
+      id
+      done
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp DeleteDiscountRedeemCodesResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.DiscountCodeRedeemCodeBulkDelete.UserErrors.Error(); err != nil {
+		return err
+	}
+
+	job := gqlResp.DiscountCodeRedeemCodeBulkDelete.Job
+	if job == nil {
+		return nil
+	}
+	return pollUntil(ctx, discountRedeemCodeBulkPollInterval, func(ctx context.Context) (bool, error) {
+		if job.Done {
+			return true, nil
+		}
+		job, err = c.GetJob(ctx, job.ID)
+		if err != nil {
+			return false, err
+		}
+		// Finished jobs may no longer be found
+		return job == nil || job.Done, nil
+	})
+}
@@ -3,6 +3,11 @@ package shopify
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
 )
 
 type UserError struct {
@@ -26,9 +31,84 @@ func (u *UserError) Error() error {
 type UserErrors []UserError
 
 func (u UserErrors) Error() error {
-	errs := make([]error, 0, len(u))
+	if len(u) == 0 {
+		return nil
+	}
+	return &UserErrorsError{UserErrors: u}
+}
+
+// HasCode returns whether any of the user errors has the code.
+func (u UserErrors) HasCode(code string) bool {
 	for _, userError := range u {
-		errs = append(errs, userError.Error())
+		if userError.CodeString() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// UserErrorsError is the error made of the user errors of a mutation.
+// Use errors.As to inspect the user errors, e.g. to tell which input field they are about.
+type UserErrorsError struct {
+	UserErrors UserErrors
+}
+
+func (e *UserErrorsError) Error() string {
+	messages := make([]string, 0, len(e.UserErrors))
+	for _, userError := range e.UserErrors {
+		messages = append(messages, userError.Error().Error())
 	}
-	return errors.Join(errs...)
+	return strings.Join(messages, "\n")
+}
+
+// ValidationError is the error of a REST request rejected with 422 Unprocessable Entity.
+// Use errors.As to inspect the messages by field, e.g. to tell that the handle has already been taken.
+type ValidationError struct {
+	// FieldErrors are the messages by field of the request body, e.g. {"handle": ["has already been taken"]}.
+	// The messages that aren't about a field are under the "base" key.
+	FieldErrors map[string][]string
+	Err         error
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.FieldErrors))
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		for _, message := range e.FieldErrors[field] {
+			messages = append(messages, field+": "+message)
+		}
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// wrapValidationError returns a ValidationError for the errors of the 422 responses, the error itself otherwise.
+func wrapValidationError(err error) error {
+	var respErr goshopify.ResponseError
+	if !errors.As(err, &respErr) || respErr.Status != http.StatusUnprocessableEntity || len(respErr.Errors) == 0 {
+		return err
+	}
+	// goshopify flattens the errors to "field: message"
+	fieldErrors := make(map[string][]string)
+	for _, fieldError := range respErr.Errors {
+		field, message, ok := strings.Cut(fieldError, ": ")
+		if !ok {
+			field, message = "base", fieldError
+		}
+		fieldErrors[field] = append(fieldErrors[field], message)
+	}
+	return &ValidationError{FieldErrors: fieldErrors, Err: err}
+}
+
+// IsUnauthorized returns whether the request has been rejected because of invalid credentials.
+func IsUnauthorized(err error) bool {
+	var respErr goshopify.ResponseError
+	return errors.As(err, &respErr) && respErr.Status == http.StatusUnauthorized
 }
@@ -0,0 +1,77 @@
+package shopify
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// The error codes classify the errors of the client by their cause. They're stable, so that they can be searched for in logs.
+const (
+	// ErrorCodeReadOnly is the code of the mutating operations of a read-only client.
+	ErrorCodeReadOnly = "SHOPIFY_READ_ONLY"
+	// ErrorCodeUnauthorized is the code of the requests whose credentials have been rejected.
+	ErrorCodeUnauthorized = "SHOPIFY_UNAUTHORIZED"
+	// ErrorCodeScopeMissing is the code of the requests the access token lacks the scope for.
+	ErrorCodeScopeMissing = "SHOPIFY_SCOPE_MISSING"
+	// ErrorCodeThrottled is the code of the requests rejected for exceeding the rate limits.
+	ErrorCodeThrottled = "SHOPIFY_THROTTLED"
+	// ErrorCodeAmbiguous is the code of the creates that may have been applied despite the error.
+	ErrorCodeAmbiguous = "SHOPIFY_AMBIGUOUS"
+	// ErrorCodeNotFound is the code of the requests about an object that doesn't exist.
+	ErrorCodeNotFound = "SHOPIFY_NOT_FOUND"
+	// ErrorCodeValidation is the code of the requests rejected because of their input, e.g. with user errors.
+	ErrorCodeValidation = "SHOPIFY_VALIDATION"
+	// ErrorCodeUnknown is the code of the other errors, e.g. a server error or a network failure.
+	ErrorCodeUnknown = "SHOPIFY_UNKNOWN"
+)
+
+// userErrorCodeNotFound is the code of the user errors about an object that doesn't exist.
+const userErrorCodeNotFound = "NOT_FOUND"
+
+// ErrorCode returns the code classifying the error of the client, ErrorCodeUnknown if it has no specific code.
+func ErrorCode(err error) string {
+	var respErr goshopify.ResponseError
+	var rateLimitErr goshopify.RateLimitError
+	var userErrs *UserErrorsError
+	var validationErr *ValidationError
+	switch {
+	case errors.Is(err, ErrReadOnly):
+		return ErrorCodeReadOnly
+	case IsUnauthorized(err):
+		return ErrorCodeUnauthorized
+	case IsAccessDenied(err):
+		return ErrorCodeScopeMissing
+	case errors.As(err, &rateLimitErr), isThrottled(err):
+		return ErrorCodeThrottled
+	case IsAmbiguousMutation(err):
+		return ErrorCodeAmbiguous
+	case errors.As(err, &respErr) && respErr.Status == http.StatusNotFound,
+		errors.As(err, &userErrs) && userErrs.UserErrors.HasCode(userErrorCodeNotFound):
+		return ErrorCodeNotFound
+	case errors.As(err, &userErrs), errors.As(err, &validationErr):
+		return ErrorCodeValidation
+	default:
+		return ErrorCodeUnknown
+	}
+}
+
+// isThrottled returns whether the request has been rejected for exceeding the rate limits,
+// either with 429 Too Many Requests or with the Throttled GraphQL error of the cost limit.
+func isThrottled(err error) bool {
+	var respErr goshopify.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	if respErr.Status == http.StatusTooManyRequests {
+		return true
+	}
+	for _, message := range append([]string{respErr.Message}, respErr.Errors...) {
+		if strings.EqualFold(message, "Throttled") {
+			return true
+		}
+	}
+	return false
+}
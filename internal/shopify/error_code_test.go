@@ -0,0 +1,41 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+func TestErrorCode(t *testing.T) {
+	notFound := "NOT_FOUND"
+	taken := UserErrorCodeTaken
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"read only", fmt.Errorf("wrapped: %w", ErrReadOnly), ErrorCodeReadOnly},
+		{"unauthorized", goshopify.ResponseError{Status: http.StatusUnauthorized}, ErrorCodeUnauthorized},
+		{"access denied", &AccessDeniedError{Mutation: "menuCreate", Scope: "write_online_store_navigation", Err: goshopify.ResponseError{Status: http.StatusForbidden}}, ErrorCodeScopeMissing},
+		{"rate limited", goshopify.RateLimitError{ResponseError: goshopify.ResponseError{Status: http.StatusTooManyRequests}}, ErrorCodeThrottled},
+		{"throttled query", goshopify.ResponseError{Status: http.StatusOK, Errors: []string{"Throttled"}}, ErrorCodeThrottled},
+		{"ambiguous create", &AmbiguousMutationError{Operation: "page create", Err: context.DeadlineExceeded}, ErrorCodeAmbiguous},
+		{"REST not found", goshopify.ResponseError{Status: http.StatusNotFound}, ErrorCodeNotFound},
+		{"user error not found", UserErrors{{Code: &notFound, Message: "Record not found"}}.Error(), ErrorCodeNotFound},
+		{"user error", UserErrors{{Code: &taken, Message: "Key is in use"}}.Error(), ErrorCodeValidation},
+		{"REST validation", &ValidationError{FieldErrors: map[string][]string{"handle": {"has already been taken"}}}, ErrorCodeValidation},
+		{"server error", goshopify.ResponseError{Status: http.StatusInternalServerError}, ErrorCodeUnknown},
+		{"other", errors.New("boom"), ErrorCodeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCode(tt.err); got != tt.want {
+				t.Errorf("ErrorCode() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
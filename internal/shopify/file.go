@@ -0,0 +1,88 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+)
+
+type File struct {
+	ID  string  `json:"id"`
+	Alt *string `json:"alt"`
+}
+
+type FileUpdateInput struct {
+	ID       string  `json:"id"`
+	Alt      *string `json:"alt,omitempty"`
+	Filename *string `json:"filename,omitempty"`
+}
+
+type GetFileResponse struct {
+	Node *File `json:"node"`
+}
+
+// GetFile returns the file, or nil if it doesn't exist.
+func (c *Client) GetFile(ctx context.Context, id string) (*File, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query file($id: ID!) {
+  node(id: $id) {
+    ... on File {
+      id
+      alt
+    }
+  }
+}
+`
+
+	var gqlResp GetFileResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	// A node that isn't a file has no fields selected
+	if gqlResp.Node == nil || gqlResp.Node.ID == "" {
+		return nil, nil
+	}
+	return gqlResp.Node, nil
+}
+
+type UpdateFilesResponse struct {
+	FileUpdate struct {
+		Files      []*File    `json:"files"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"fileUpdate"`
+}
+
+// UpdateFile updates the alt text and the filename of the file. The values missing in the input are left unchanged.
+func (c *Client) UpdateFile(ctx context.Context, input *FileUpdateInput) (*File, error) {
+	variables := map[string]interface{}{"files": []*FileUpdateInput{input}}
+	query := `
+mutation UpdateFile($files: [FileUpdateInput!]!) {
+  fileUpdate(files: $files) {
+    files {
+      id
+      alt
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateFilesResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.FileUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	for _, file := range gqlResp.FileUpdate.Files {
+		if file.ID == input.ID {
+			return file, nil
+		}
+	}
+	return nil, fmt.Errorf("file %s has not been updated", input.ID)
+}
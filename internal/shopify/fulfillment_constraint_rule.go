@@ -0,0 +1,150 @@
+package shopify
+
+import (
+	"context"
+)
+
+// FulfillmentConstraintFunctionAPITypes are the API types of the Shopify Functions that can back a fulfillment constraint rule.
+var FulfillmentConstraintFunctionAPITypes = []string{"fulfillment_constraints"}
+
+// DeliveryMethodTypes are the types of delivery methods a fulfillment constraint rule can apply to.
+var DeliveryMethodTypes = []string{"LOCAL", "NONE", "PICKUP_POINT", "PICK_UP", "RETAIL", "SHIPPING"}
+
+// FulfillmentConstraintRule is a rule of a Shopify Function constraining how the orders are fulfilled,
+// e.g. which items must be fulfilled together.
+type FulfillmentConstraintRule struct {
+	ID       string `json:"id"`
+	Function struct {
+		ID string `json:"id"`
+	} `json:"function"`
+	DeliveryMethodTypes []string `json:"deliveryMethodTypes"`
+	// Metafields are the metafields of the rule, which configure the function.
+	Metafields []*Metafield `json:"-"`
+}
+
+type FulfillmentConstraintRuleCreateInput struct {
+	FunctionID          string            `json:"functionId"`
+	DeliveryMethodTypes []string          `json:"deliveryMethodTypes"`
+	Metafields          []*MetafieldInput `json:"metafields,omitempty"`
+}
+
+type ListFulfillmentConstraintRulesResponse struct {
+	FulfillmentConstraintRules []*struct {
+		FulfillmentConstraintRule
+		MetafieldNodes struct {
+			Nodes []*Metafield `json:"nodes"`
+		} `json:"metafields"`
+	} `json:"fulfillmentConstraintRules"`
+}
+
+// GetFulfillmentConstraintRule returns the fulfillment constraint rule with its metafields, or nil if it doesn't exist.
+// The rules can only be listed, and a shop has a few of them at most.
+func (c *Client) GetFulfillmentConstraintRule(ctx context.Context, id string) (*FulfillmentConstraintRule, error) {
+	query := `
+query fulfillmentConstraintRules {
+  fulfillmentConstraintRules {
+    id
+    function {
+      id
+    }
+    deliveryMethodTypes
+    metafields(first: 250) {
+      nodes {
+        id
+        namespace
+        key
+        type
+        value
+      }
+    }
+  }
+}
+`
+
+	var gqlResp ListFulfillmentConstraintRulesResponse
+	err := c.query(ctx, query, map[string]interface{}{}, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range gqlResp.FulfillmentConstraintRules {
+		if node.ID == id {
+			rule := node.FulfillmentConstraintRule
+			rule.Metafields = node.MetafieldNodes.Nodes
+			return &rule, nil
+		}
+	}
+	return nil, nil
+}
+
+type CreateFulfillmentConstraintRuleResponse struct {
+	FulfillmentConstraintRuleCreate struct {
+		FulfillmentConstraintRule *FulfillmentConstraintRule `json:"fulfillmentConstraintRule"`
+		UserErrors                UserErrors                 `json:"userErrors"`
+	} `json:"fulfillmentConstraintRuleCreate"`
+}
+
+// CreateFulfillmentConstraintRule creates the fulfillment constraint rule. The returned rule has no metafields.
+func (c *Client) CreateFulfillmentConstraintRule(ctx context.Context, input *FulfillmentConstraintRuleCreateInput) (*FulfillmentConstraintRule, error) {
+	variables := map[string]interface{}{
+		"functionId":          input.FunctionID,
+		"deliveryMethodTypes": input.DeliveryMethodTypes,
+		"metafields":          input.Metafields,
+	}
+	query := `
+mutation fulfillmentConstraintRuleCreate($functionId: String!, $deliveryMethodTypes: [DeliveryMethodType!]!, $metafields: [MetafieldInput!]) {
+  fulfillmentConstraintRuleCreate(functionId: $functionId, deliveryMethodTypes: $deliveryMethodTypes, metafields: $metafields) {
+    fulfillmentConstraintRule {
+      id
+      function {
+        id
+      }
+      deliveryMethodTypes
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp CreateFulfillmentConstraintRuleResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.FulfillmentConstraintRuleCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.FulfillmentConstraintRuleCreate.FulfillmentConstraintRule, nil
+}
+
+type DeleteFulfillmentConstraintRuleResponse struct {
+	FulfillmentConstraintRuleDelete struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"fulfillmentConstraintRuleDelete"`
+}
+
+func (c *Client) DeleteFulfillmentConstraintRule(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation fulfillmentConstraintRuleDelete($id: ID!) {
+  fulfillmentConstraintRuleDelete(id: $id) {
+    success
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp DeleteFulfillmentConstraintRuleResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.FulfillmentConstraintRuleDelete.UserErrors.Error()
+}
@@ -0,0 +1,32 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetFulfillmentConstraintRule(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"fulfillmentConstraintRules":[
+{"id":"gid://shopify/FulfillmentConstraintRule/1","function":{"id":"fn-1"},"deliveryMethodTypes":["SHIPPING"],"metafields":{"nodes":[]}},
+{"id":"gid://shopify/FulfillmentConstraintRule/2","function":{"id":"fn-2"},"deliveryMethodTypes":["PICK_UP"],"metafields":{"nodes":[{"namespace":"$app:constraints","key":"configuration","type":"json","value":"{}"}]}}
+]}}`))
+	})
+
+	rule, err := client.GetFulfillmentConstraintRule(context.Background(), "gid://shopify/FulfillmentConstraintRule/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule == nil || rule.Function.ID != "fn-2" || len(rule.Metafields) != 1 {
+		t.Errorf("unexpected rule: %+v", rule)
+	}
+
+	rule, err = client.GetFulfillmentConstraintRule(context.Background(), "gid://shopify/FulfillmentConstraintRule/3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule != nil {
+		t.Errorf("expected no rule, got %+v", rule)
+	}
+}
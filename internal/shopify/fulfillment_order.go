@@ -0,0 +1,138 @@
+package shopify
+
+import (
+	"context"
+)
+
+const (
+	FulfillmentOrderStatusCancelled = "CANCELLED"
+	FulfillmentOrderStatusClosed    = "CLOSED"
+	FulfillmentOrderStatusOnHold    = "ON_HOLD"
+)
+
+type FulfillmentOrder struct {
+	ID               string             `json:"id"`
+	Status           string             `json:"status"`
+	FulfillmentHolds []*FulfillmentHold `json:"fulfillmentHolds"`
+}
+
+// IsFinished returns whether the fulfillment order can no longer change,
+// i.e. it has already been fulfilled or cancelled.
+func (f *FulfillmentOrder) IsFinished() bool {
+	return f.Status == FulfillmentOrderStatusClosed || f.Status == FulfillmentOrderStatusCancelled
+}
+
+type FulfillmentHold struct {
+	ID          string  `json:"id"`
+	Reason      string  `json:"reason"`
+	ReasonNotes *string `json:"reasonNotes"`
+}
+
+type FulfillmentOrderHoldInput struct {
+	Reason      string  `json:"reason"`
+	ReasonNotes *string `json:"reasonNotes,omitempty"`
+}
+
+type GetFulfillmentOrderResponse struct {
+	FulfillmentOrder *FulfillmentOrder `json:"fulfillmentOrder"`
+}
+
+func (c *Client) GetFulfillmentOrder(ctx context.Context, id string) (*FulfillmentOrder, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query fulfillmentOrder($id: ID!) {
+  fulfillmentOrder(id: $id) {
+    id
+    status
+    fulfillmentHolds {
+      id
+      reason
+      reasonNotes
+    }
+  }
+}
+`
+
+	var gqlResp GetFulfillmentOrderResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.FulfillmentOrder, nil
+}
+
+type HoldFulfillmentOrderResponse struct {
+	FulfillmentOrderHold struct {
+		FulfillmentHold  *FulfillmentHold  `json:"fulfillmentHold"`
+		FulfillmentOrder *FulfillmentOrder `json:"fulfillmentOrder"`
+		UserErrors       UserErrors        `json:"userErrors"`
+	} `json:"fulfillmentOrderHold"`
+}
+
+func (c *Client) HoldFulfillmentOrder(ctx context.Context, id string, input *FulfillmentOrderHoldInput) (*FulfillmentHold, *FulfillmentOrder, error) {
+	variables := map[string]interface{}{"id": id, "fulfillmentHold": input}
+	query := `
+mutation HoldFulfillmentOrder($id: ID!, $fulfillmentHold: FulfillmentOrderHoldInput!) {
+  fulfillmentOrderHold(id: $id, fulfillmentHold: $fulfillmentHold) {
+    fulfillmentHold {
+      id
+      reason
+      reasonNotes
+    }
+    fulfillmentOrder {
+      id
+      status
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp HoldFulfillmentOrderResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := gqlResp.FulfillmentOrderHold.UserErrors.Error(); err != nil {
+		return nil, nil, err
+	}
+	return gqlResp.FulfillmentOrderHold.FulfillmentHold, gqlResp.FulfillmentOrderHold.FulfillmentOrder, nil
+}
+
+type ReleaseFulfillmentOrderHoldResponse struct {
+	FulfillmentOrderReleaseHold struct {
+		FulfillmentOrder *FulfillmentOrder `json:"fulfillmentOrder"`
+		UserErrors       UserErrors        `json:"userErrors"`
+	} `json:"fulfillmentOrderReleaseHold"`
+}
+
+func (c *Client) ReleaseFulfillmentOrderHold(ctx context.Context, id string, holdIDs []string) error {
+	variables := map[string]interface{}{"id": id, "holdIds": holdIDs}
+	query := `
+mutation ReleaseFulfillmentOrderHold($id: ID!, $holdIds: [ID!]) {
+  fulfillmentOrderReleaseHold(id: $id, holdIds: $holdIds) {
+    fulfillmentOrder {
+      id
+      status
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp ReleaseFulfillmentOrderHoldResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	if err := gqlResp.FulfillmentOrderReleaseHold.UserErrors.Error(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package shopify
+
+import (
+	"context"
+)
+
+type GiftCardConfiguration struct {
+	// ShopID is the ID of the shop the configuration belongs to.
+	ShopID string          `json:"id"`
+	Expiry *GiftCardExpiry `json:"giftCardExpiry"`
+}
+
+// GiftCardExpiry is the default expiry policy of the gift cards issued by the shop.
+type GiftCardExpiry struct {
+	Enabled bool `json:"enabled"`
+	// Months is the number of months after which the gift cards expire, nil if they don't expire.
+	Months *int64 `json:"months"`
+}
+
+type GiftCardConfigurationInput struct {
+	Expiry *GiftCardExpiry `json:"giftCardExpiry,omitempty"`
+}
+
+type GetGiftCardConfigurationResponse struct {
+	Shop *GiftCardConfiguration `json:"shop"`
+}
+
+func (c *Client) GetGiftCardConfiguration(ctx context.Context) (*GiftCardConfiguration, error) {
+	query := `
+query giftCardConfiguration {
+  shop {
+    id
+    giftCardExpiry {
+      enabled
+      months
+    }
+  }
+}
+`
+
+	var gqlResp GetGiftCardConfigurationResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Shop, nil
+}
+
+type UpdateGiftCardConfigurationResponse struct {
+	ShopUpdate struct {
+		Shop       *GiftCardConfiguration `json:"shop"`
+		UserErrors UserErrors             `json:"userErrors"`
+	} `json:"shopUpdate"`
+}
+
+func (c *Client) UpdateGiftCardConfiguration(ctx context.Context, input *GiftCardConfigurationInput) (*GiftCardConfiguration, error) {
+	variables := map[string]interface{}{"input": input}
+	query := `
+mutation UpdateGiftCardConfiguration($input: ShopInput!) {
+  shopUpdate(input: $input) {
+    shop {
+      id
+      giftCardExpiry {
+        enabled
+        months
+      }
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateGiftCardConfigurationResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ShopUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.ShopUpdate.Shop, nil
+}
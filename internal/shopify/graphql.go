@@ -0,0 +1,46 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RawGraphQL runs the GraphQL query and returns its data as is.
+// It's an escape hatch for queries the provider doesn't model yet.
+func (c *Client) RawGraphQL(ctx context.Context, query string, vars map[string]any) (json.RawMessage, error) {
+	var data json.RawMessage
+	if err := c.query(ctx, query, vars, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// tolerateGraphQLErrors is the policy for the GraphQL responses with both data and errors, e.g. a nested field nulled
+// because it failed to resolve. The errors are logged and ignored if every top-level field of the data is set,
+// as the errors could only null nested fields, which the callers handle as unset. Otherwise a top-level field is null,
+// which can't be told apart from a missing object or a failed mutation, so the errors are returned.
+// The user errors of the mutations are part of the data, and the callers fail on them.
+func tolerateGraphQLErrors(ctx context.Context, data json.RawMessage, err error) error {
+	var respErr goshopify.ResponseError
+	if err == nil || !errors.As(err, &respErr) || respErr.Status != http.StatusOK {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil || len(fields) == 0 {
+		return err
+	}
+	for _, value := range fields {
+		if string(value) == "null" {
+			return err
+		}
+	}
+	tflog.Warn(ctx, "Shopify GraphQL API returned errors along with the data, ignoring them", map[string]interface{}{
+		"errors": respErr.Errors,
+	})
+	return nil
+}
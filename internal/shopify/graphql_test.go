@@ -0,0 +1,75 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRawGraphQL(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"shop":{"currencyCode":"CAD"}}}`))
+	})
+
+	data, err := client.RawGraphQL(context.Background(), `query { shop { currencyCode } }`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"shop":{"currencyCode":"CAD"}}` {
+		t.Errorf("unexpected data: %s", data)
+	}
+}
+
+func TestRawGraphQL_partialData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// An optional nested field failed to resolve, the rest of the data is usable
+		_, _ = w.Write([]byte(`{"data":{"shop":{"currencyCode":"CAD","plan":null}},"errors":[{"message":"Internal error","path":["shop","plan"]}]}`))
+	})
+
+	data, err := client.RawGraphQL(context.Background(), `query { shop { currencyCode plan { displayName } } }`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"shop":{"currencyCode":"CAD","plan":null}}` {
+		t.Errorf("unexpected data: %s", data)
+	}
+}
+
+func TestRawGraphQL_hardError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// A null top-level field can't be told apart from a missing object
+		_, _ = w.Write([]byte(`{"data":{"metaobjectDefinition":null},"errors":[{"message":"Internal error","path":["metaobjectDefinition"]}]}`))
+	})
+
+	_, err := client.RawGraphQL(context.Background(), `query { metaobjectDefinition(id: "gid://shopify/MetaobjectDefinition/1") { id } }`, nil)
+	if err == nil || !strings.Contains(err.Error(), "Internal error") {
+		t.Fatalf("expected the error of the response, got %v", err)
+	}
+}
+
+func TestRawGraphQL_noData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Field 'foo' doesn't exist on type 'QueryRoot'"}]}`))
+	})
+
+	_, err := client.RawGraphQL(context.Background(), `query { foo }`, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestMutate_partialDataWithUserErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// The errors about a nested field are ignored, but the user errors still fail the mutation
+		_, _ = w.Write([]byte(`{"data":{"themePublish":{"theme":null,"userErrors":[{"field":["id"],"message":"Theme is still processing","code":"INVALID"}]}},` +
+			`"errors":[{"message":"Internal error","path":["themePublish","theme","name"]}]}`))
+	})
+
+	_, err := client.PublishTheme(context.Background(), "gid://shopify/OnlineStoreTheme/1")
+	var userErrs *UserErrorsError
+	if !errors.As(err, &userErrs) {
+		t.Fatalf("expected UserErrorsError, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// idempotentMutations are the mutations that are safe to send again after an ambiguous failure,
+// i.e. applying them twice has the same effect as applying them once:
+//   - metafieldsSet and metaobjectUpsert set the values of the objects identified by their input.
+//   - publishablePublish, publishableUnpublish, themePublish and privacyFeaturesDisable
+//     move the object to a state, whatever its current one.
+//   - subscriptionBillingAttemptCreate takes an idempotency key, which is part of the variables,
+//     so that the retry sends the same key and Shopify returns the attempt created by the first request.
+//
+// The other mutations, most notably the creates, aren't retried.
+var idempotentMutations = map[string]bool{
+	"metafieldsSet":                    true,
+	"metaobjectUpsert":                 true,
+	"privacyFeaturesDisable":           true,
+	"publishablePublish":               true,
+	"publishableUnpublish":             true,
+	"subscriptionBillingAttemptCreate": true,
+	"themePublish":                     true,
+}
+
+// AmbiguousMutationError is the error of a create whose request failed without telling whether Shopify applied it,
+// e.g. it timed out. It isn't retried, as the retry would create a duplicate if the first request has been applied.
+type AmbiguousMutationError struct {
+	// Operation is the name of the mutation, e.g. metaobjectDefinitionCreate, or of the REST request, e.g. page create.
+	Operation string
+	Err       error
+}
+
+func (e *AmbiguousMutationError) Error() string {
+	return fmt.Sprintf("%s failed without a response telling whether it has been applied: %s. "+
+		"Verify manually in the Shopify admin whether the object has been created, and import it before applying again if so",
+		e.Operation, e.Err)
+}
+
+func (e *AmbiguousMutationError) Unwrap() error {
+	return e.Err
+}
+
+// IsAmbiguousMutation returns whether the create may have been applied despite the error.
+func IsAmbiguousMutation(err error) bool {
+	var ambiguousErr *AmbiguousMutationError
+	return errors.As(err, &ambiguousErr)
+}
+
+// isAmbiguousFailure returns whether the request may have been applied despite the error,
+// i.e. it has been sent but no response has told its outcome.
+func isAmbiguousFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var respErr goshopify.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.Status >= http.StatusInternalServerError
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		// The connection has never been established, so the request hasn't been sent
+		return false
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// mutationName returns the name of the mutation of the query, empty if it isn't a mutation.
+func mutationName(query string) string {
+	if m := mutationFieldPattern.FindStringSubmatch(query); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// wrapAmbiguousCreateError returns an AmbiguousMutationError for the ambiguous failures of the create operation,
+// the error itself otherwise.
+func wrapAmbiguousCreateError(operation string, err error) error {
+	if !isAmbiguousFailure(err) {
+		return err
+	}
+	return &AmbiguousMutationError{Operation: operation, Err: err}
+}
+
+// isCreateMutation returns whether the mutation creates an object, which is duplicated if it's sent twice.
+func isCreateMutation(mutation string) bool {
+	return strings.HasSuffix(mutation, "Create")
+}
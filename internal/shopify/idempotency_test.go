@@ -0,0 +1,93 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+func TestMutate_ambiguousCreate(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+
+	_, err := client.CreateMetaobjectDefinition(context.Background(), &MetaobjectDefinitionCreateInput{Type: "author"})
+	if !IsAmbiguousMutation(err) {
+		t.Fatalf("expected an ambiguous mutation error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "metaobjectDefinitionCreate") || !strings.Contains(err.Error(), "Verify manually") {
+		t.Errorf("expected the error to name the mutation and ask for verification, got %q", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected the create not to be retried, got %d requests", got)
+	}
+}
+
+func TestMutate_retryIdempotent(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"metafieldsSet":{"metafields":[{"id":"gid://shopify/Metafield/1"}],"userErrors":[]}}}`))
+	})
+
+	metafields, err := client.SetMetafields(context.Background(), []*MetafieldsSetInput{{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metafields) != 1 {
+		t.Errorf("expected the metafields of the retry, got %v", metafields)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected the mutation to be retried once, got %d requests", got)
+	}
+}
+
+func TestMutate_notAmbiguous(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, err := client.CreateMetaobjectDefinition(context.Background(), &MetaobjectDefinitionCreateInput{Type: "author"})
+	if err == nil || IsAmbiguousMutation(err) {
+		t.Errorf("expected an error other than ambiguous, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected a single request, got %d requests", got)
+	}
+}
+
+func TestIsAmbiguousFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server error", goshopify.ResponseError{Status: http.StatusServiceUnavailable}, true},
+		{"client error", goshopify.ResponseError{Status: http.StatusUnprocessableEntity}, false},
+		{"timeout", &url.Error{Op: "Post", URL: "https://test", Err: context.DeadlineExceeded}, true},
+		{"connection reset", &url.Error{Op: "Post", URL: "https://test", Err: &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}}, true},
+		{"dial", &url.Error{Op: "Post", URL: "https://test", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAmbiguousFailure(tt.err); got != tt.want {
+				t.Errorf("isAmbiguousFailure() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
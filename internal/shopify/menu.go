@@ -0,0 +1,158 @@
+package shopify
+
+import (
+	"context"
+)
+
+type Menu struct {
+	ID     string      `json:"id"`
+	Title  string      `json:"title"`
+	Handle string      `json:"handle"`
+	Items  []*MenuItem `json:"items"`
+}
+
+type MenuItem struct {
+	Title      string  `json:"title"`
+	Type       string  `json:"type"`
+	URL        *string `json:"url"`
+	ResourceID *string `json:"resourceId"`
+}
+
+type MenuItemInput struct {
+	Title      string  `json:"title"`
+	Type       string  `json:"type"`
+	URL        *string `json:"url,omitempty"`
+	ResourceID *string `json:"resourceId,omitempty"`
+}
+
+type MenuInput struct {
+	Title  string           `json:"title"`
+	Handle string           `json:"handle"`
+	Items  []*MenuItemInput `json:"items"`
+}
+
+const menuFields = `
+    id
+    title
+    handle
+    items {
+      title
+      type
+      url
+      resourceId
+    }
+`
+
+type CreateMenuResponse struct {
+	MenuCreate struct {
+		Menu       *Menu      `json:"menu"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"menuCreate"`
+}
+
+func (c *Client) CreateMenu(ctx context.Context, input *MenuInput) (*Menu, error) {
+	variables := map[string]interface{}{"title": input.Title, "handle": input.Handle, "items": input.Items}
+	query := `
+mutation CreateMenu($title: String!, $handle: String!, $items: [MenuItemCreateInput!]!) {
+  menuCreate(title: $title, handle: $handle, items: $items) {
+    menu {` + menuFields + `    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp CreateMenuResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.MenuCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.MenuCreate.Menu, nil
+}
+
+type GetMenuResponse struct {
+	Menu *Menu `json:"menu"`
+}
+
+// GetMenu returns the menu, or nil if it doesn't exist.
+func (c *Client) GetMenu(ctx context.Context, id string) (*Menu, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query menu($id: ID!) {
+  menu(id: $id) {` + menuFields + `  }
+}
+`
+
+	var gqlResp GetMenuResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Menu, nil
+}
+
+type UpdateMenuResponse struct {
+	MenuUpdate struct {
+		Menu       *Menu      `json:"menu"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"menuUpdate"`
+}
+
+// UpdateMenu updates the menu. The items of the menu are replaced by the items of the input.
+func (c *Client) UpdateMenu(ctx context.Context, id string, input *MenuInput) (*Menu, error) {
+	variables := map[string]interface{}{"id": id, "title": input.Title, "handle": input.Handle, "items": input.Items}
+	query := `
+mutation UpdateMenu($id: ID!, $title: String!, $handle: String, $items: [MenuItemUpdateInput!]!) {
+  menuUpdate(id: $id, title: $title, handle: $handle, items: $items) {
+    menu {` + menuFields + `    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateMenuResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.MenuUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.MenuUpdate.Menu, nil
+}
+
+type DeleteMenuResponse struct {
+	MenuDelete struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"menuDelete"`
+}
+
+func (c *Client) DeleteMenu(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation DeleteMenu($id: ID!) {
+  menuDelete(id: $id) {
+    deletedMenuId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp DeleteMenuResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.MenuDelete.UserErrors.Error()
+}
@@ -0,0 +1,228 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// metafieldBulkThreshold is the number of metafields from which they are set by a bulk operation
+// rather than by a mutation per metafield, which is faster for a few metafields but slow and throttled for many.
+const metafieldBulkThreshold = 50
+
+// metafieldsDeleteLimit is the maximum number of metafields deleted by a single metafieldsDelete mutation.
+const metafieldsDeleteLimit = 250
+
+type Metafield struct {
+	ID        string `json:"id"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+}
+
+type MetafieldsSetInput struct {
+	OwnerID   string `json:"ownerId"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+}
+
+type MetafieldIdentifierInput struct {
+	OwnerID   string `json:"ownerId"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+}
+
+type SetMetafieldsResponse struct {
+	MetafieldsSet struct {
+		Metafields []*Metafield `json:"metafields"`
+		UserErrors UserErrors   `json:"userErrors"`
+	} `json:"metafieldsSet"`
+}
+
+const setMetafieldsMutation = `
+mutation SetMetafields($metafields: [MetafieldsSetInput!]!) {
+  metafieldsSet(metafields: $metafields) {
+    metafields {
+      id
+      namespace
+      key
+      type
+      value
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+// SetMetafields creates or updates the metafields.
+func (c *Client) SetMetafields(ctx context.Context, inputs []*MetafieldsSetInput) ([]*Metafield, error) {
+	resolved := make([]*MetafieldsSetInput, len(inputs))
+	for i, input := range inputs {
+		var err error
+		if resolved[i], err = c.resolveMetafieldsSetInputReferences(ctx, input); err != nil {
+			return nil, err
+		}
+	}
+	variables := map[string]interface{}{"metafields": resolved}
+	var gqlResp SetMetafieldsResponse
+	err := c.mutate(ctx, setMetafieldsMutation, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.MetafieldsSet.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.MetafieldsSet.Metafields, nil
+}
+
+type GetOwnerMetafieldResponse struct {
+	Node *struct {
+		Metafield *Metafield `json:"metafield"`
+	} `json:"node"`
+}
+
+// GetOwnerMetafield returns the metafield of the owner, e.g. a customer or a blog,
+// or nil if the owner or the metafield doesn't exist.
+func (c *Client) GetOwnerMetafield(ctx context.Context, ownerID, namespace, key string) (*Metafield, error) {
+	variables := map[string]interface{}{"id": ownerID, "namespace": namespace, "key": key}
+	query := `
+query ownerMetafield($id: ID!, $namespace: String!, $key: String!) {
+  node(id: $id) {
+    ... on HasMetafields {
+      metafield(namespace: $namespace, key: $key) {
+        id
+        namespace
+        key
+        type
+        value
+      }
+    }
+  }
+}
+`
+
+	var gqlResp GetOwnerMetafieldResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Node == nil {
+		return nil, nil
+	}
+	return gqlResp.Node.Metafield, nil
+}
+
+type DeleteMetafieldsResponse struct {
+	MetafieldsDelete struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"metafieldsDelete"`
+}
+
+func (c *Client) DeleteMetafields(ctx context.Context, inputs []*MetafieldIdentifierInput) error {
+	variables := map[string]interface{}{"metafields": inputs}
+	query := `
+mutation DeleteMetafields($metafields: [MetafieldIdentifierInput!]!) {
+  metafieldsDelete(metafields: $metafields) {
+    deletedMetafields {
+      key
+      namespace
+      ownerId
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp DeleteMetafieldsResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.MetafieldsDelete.UserErrors.Error()
+}
+
+// BulkSetMetafields creates or updates the metafields one by one, and returns the error of each metafield in the order of the inputs,
+// nil for the metafields which have been set. Many metafields are set by a bulk operation, a few by a mutation per metafield.
+// The metafields fail independently of each other, so some may have been set when others fail.
+func (c *Client) BulkSetMetafields(ctx context.Context, inputs []*MetafieldsSetInput) []error {
+	errs := make([]error, len(inputs))
+	// The metafields whose references can't be resolved fail without being sent
+	var indexes []int
+	var variables []map[string]interface{}
+	for i, input := range inputs {
+		resolved, err := c.resolveMetafieldsSetInputReferences(ctx, input)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		indexes = append(indexes, i)
+		variables = append(variables, map[string]interface{}{"metafields": []*MetafieldsSetInput{resolved}})
+	}
+
+	if len(variables) < metafieldBulkThreshold {
+		for j, i := range indexes {
+			var gqlResp SetMetafieldsResponse
+			errs[i] = c.mutate(ctx, setMetafieldsMutation, variables[j], &gqlResp)
+			if errs[i] == nil {
+				errs[i] = gqlResp.MetafieldsSet.UserErrors.Error()
+			}
+		}
+		return errs
+	}
+
+	bulkResults, err := c.RunBulkMutation(ctx, setMetafieldsMutation, variables)
+	for j, i := range indexes {
+		bulkResult, ok := bulkResults[j]
+		if !ok {
+			errs[i] = fmt.Errorf("no result from the bulk operation: %w", err)
+			continue
+		}
+		if errs[i] = bulkResult.Err(); errs[i] != nil {
+			continue
+		}
+		var data struct {
+			MetafieldsSet struct {
+				UserErrors UserErrors `json:"userErrors"`
+			} `json:"metafieldsSet"`
+		}
+		if errs[i] = json.Unmarshal(bulkResult.Data, &data); errs[i] != nil {
+			continue
+		}
+		errs[i] = data.MetafieldsSet.UserErrors.Error()
+	}
+	return errs
+}
+
+// resolveMetafieldsSetInputReferences returns a copy of the input with the metaobjects its value refers to by handle resolved to GIDs.
+func (c *Client) resolveMetafieldsSetInputReferences(ctx context.Context, input *MetafieldsSetInput) (*MetafieldsSetInput, error) {
+	value, err := c.ResolveMetafieldReferences(ctx, input.Type, input.Value)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *input
+	resolved.Value = value
+	return &resolved, nil
+}
+
+// BulkDeleteMetafields deletes the metafields in batches, and returns the error of each metafield in the order of the inputs,
+// nil for the metafields which have been deleted. A batch fails or succeeds as a whole, so its metafields share the error.
+func (c *Client) BulkDeleteMetafields(ctx context.Context, inputs []*MetafieldIdentifierInput) []error {
+	errs := make([]error, len(inputs))
+	for start := 0; start < len(inputs); start += metafieldsDeleteLimit {
+		end := min(start+metafieldsDeleteLimit, len(inputs))
+		if err := c.DeleteMetafields(ctx, inputs[start:end]); err != nil {
+			for i := start; i < end; i++ {
+				errs[i] = err
+			}
+		}
+	}
+	return errs
+}
@@ -2,8 +2,12 @@ package shopify
 
 import (
 	"context"
+	"fmt"
 )
 
+// UserErrorCodeTaken is the code of the user error returned when the value is already in use.
+const UserErrorCodeTaken = "TAKEN"
+
 type MetafieldDefinition struct {
 	ID             string                           `json:"id"`
 	Name           string                           `json:"name"`
@@ -14,11 +18,64 @@ type MetafieldDefinition struct {
 	Type           *MetafieldDefinitionType         `json:"type"`
 	PinnedPosition *int                             `json:"pinnedPosition"`
 	Validations    []*MetafieldDefinitionValidation `json:"validations"`
+	// StandardTemplate is set if the definition has been enabled from a standard template.
+	StandardTemplate *struct {
+		ID string `json:"id"`
+	} `json:"standardTemplate"`
+	Capabilities *MetafieldDefinitionCapabilities `json:"capabilities"`
+	Access       *MetafieldAccess                 `json:"access"`
+}
+
+// MetafieldAccess is the access to the metafields of a definition. Unlike MetaobjectAccess,
+// it includes the access of the customer accounts.
+type MetafieldAccess struct {
+	Admin           string `json:"admin,omitempty"`
+	Storefront      string `json:"storefront,omitempty"`
+	CustomerAccount string `json:"customerAccount,omitempty"`
+}
+
+// MetafieldDefinitionCapabilities are the capabilities of a metafield definition.
+type MetafieldDefinitionCapabilities struct {
+	// SmartCollectionCondition is whether the metafields can be used in the conditions of smart collections.
+	SmartCollectionCondition *MetafieldDefinitionCapability `json:"smartCollectionCondition"`
+	// AdminFilterable is whether the resources can be filtered by the metafields in the Shopify admin.
+	AdminFilterable *MetafieldDefinitionCapability `json:"adminFilterable"`
+	// UniqueValues is whether the value of each metafield must be unique among the resources.
+	UniqueValues *MetafieldDefinitionCapability `json:"uniqueValues"`
+}
+
+// MetafieldDefinitionCapabilitiesInput are the capabilities of a metafield definition to enable or disable.
+// The capabilities which aren't set are left as they are.
+type MetafieldDefinitionCapabilitiesInput struct {
+	SmartCollectionCondition *MetafieldDefinitionCapabilityInput `json:"smartCollectionCondition,omitempty"`
+	AdminFilterable          *MetafieldDefinitionCapabilityInput `json:"adminFilterable,omitempty"`
+	UniqueValues             *MetafieldDefinitionCapabilityInput `json:"uniqueValues,omitempty"`
+}
+
+type MetafieldDefinitionCapabilityInput struct {
+	Enabled bool `json:"enabled"`
+}
+
+// MetafieldDefinitionCapability is a capability of a metafield definition. Enabling it has no effect
+// unless the definition is eligible for it, which depends on its type and owner type.
+type MetafieldDefinitionCapability struct {
+	Enabled  bool `json:"enabled"`
+	Eligible bool `json:"eligible"`
 }
 
+// MetafieldDefinitionType is the type of a metafield or metaobject field definition.
+// The deprecated valueType isn't queried, as the name and the category describe the type.
 type MetafieldDefinitionType struct {
+	// Category is the category of the type, e.g. TEXT or REFERENCE.
 	Category string `json:"category"`
 	Name     string `json:"name"`
+	// SupportsDefinitionMigrations is whether definitions of the type can be migrated to another type.
+	SupportsDefinitionMigrations bool `json:"supportsDefinitionMigrations"`
+}
+
+// IsList returns whether the type holds a list of values, e.g. list.single_line_text_field.
+func (t *MetafieldDefinitionType) IsList() bool {
+	return IsListMetafieldType(t.Name)
 }
 
 type MetafieldDefinitionValidation struct {
@@ -27,14 +84,16 @@ type MetafieldDefinitionValidation struct {
 }
 
 type MetafieldDefinitionInput struct {
-	Name        string                           `json:"name"`
-	Description string                           `json:"description,omitempty"`
-	OwnerType   string                           `json:"ownerType"`
-	Namespace   string                           `json:"namespace"`
-	Key         string                           `json:"key"`
-	Type        string                           `json:"type"`
-	Pin         bool                             `json:"pin"`
-	Validations []*MetafieldDefinitionValidation `json:"validations"`
+	Name         string                                `json:"name"`
+	Description  string                                `json:"description,omitempty"`
+	OwnerType    string                                `json:"ownerType"`
+	Namespace    string                                `json:"namespace"`
+	Key          string                                `json:"key"`
+	Type         string                                `json:"type"`
+	Pin          bool                                  `json:"pin"`
+	Validations  []*MetafieldDefinitionValidation      `json:"validations"`
+	Access       *MetafieldAccess                      `json:"access,omitempty"`
+	Capabilities *MetafieldDefinitionCapabilitiesInput `json:"capabilities,omitempty"`
 }
 
 type CreateMetafieldDefinitionResponse struct {
@@ -45,7 +104,13 @@ type CreateMetafieldDefinitionResponse struct {
 }
 
 func (c *Client) CreateMetafieldDefinition(ctx context.Context, input *MetafieldDefinitionInput) (*MetafieldDefinition, error) {
-	variables := map[string]interface{}{"definition": input}
+	validations, err := c.ResolveValidationReferences(ctx, input.Validations)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *input
+	resolved.Validations = validations
+	variables := map[string]interface{}{"definition": &resolved}
 	query := `
 mutation CreateMetafieldDefinition($definition: MetafieldDefinitionInput!) {
   metafieldDefinitionCreate(definition: $definition) {
@@ -59,8 +124,31 @@ mutation CreateMetafieldDefinition($definition: MetafieldDefinitionInput!) {
       type {
         category
         name
+        supportsDefinitionMigrations
       }
       pinnedPosition
+      standardTemplate {
+        id
+      }
+      capabilities {
+        smartCollectionCondition {
+          enabled
+          eligible
+        }
+        adminFilterable {
+          enabled
+          eligible
+        }
+        uniqueValues {
+          enabled
+          eligible
+        }
+      }
+      access {
+        admin
+        storefront
+        customerAccount
+      }
       validations {
         name	
         value
@@ -75,16 +163,57 @@ mutation CreateMetafieldDefinition($definition: MetafieldDefinitionInput!) {
 }`
 
 	var gqlResp CreateMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err = c.mutate(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
 	if err := gqlResp.MetafieldDefinitionCreate.UserErrors.Error(); err != nil {
+		if gqlResp.MetafieldDefinitionCreate.UserErrors.HasCode(UserErrorCodeTaken) {
+			return nil, c.newMetafieldDefinitionTakenError(ctx, input, err)
+		}
 		return nil, err
 	}
 	return gqlResp.MetafieldDefinitionCreate.CreatedDefinition, nil
 }
 
+// MetafieldDefinitionTakenError is returned when a metafield definition with the same owner type,
+// namespace and key already exists.
+type MetafieldDefinitionTakenError struct {
+	OwnerType string
+	Namespace string
+	Key       string
+	// ExistingID is the ID of the existing metafield definition, or empty if it couldn't be found.
+	ExistingID string
+	Err        error
+}
+
+func (e *MetafieldDefinitionTakenError) Error() string {
+	existing := e.ExistingID
+	if existing == "" {
+		existing = "an unknown definition"
+	}
+	return fmt.Sprintf("metafield definition %s/%s/%s is already taken by %s: %s", e.OwnerType, e.Namespace, e.Key, existing, e.Err)
+}
+
+func (e *MetafieldDefinitionTakenError) Unwrap() error {
+	return e.Err
+}
+
+func (c *Client) newMetafieldDefinitionTakenError(ctx context.Context, input *MetafieldDefinitionInput, err error) error {
+	takenErr := &MetafieldDefinitionTakenError{
+		OwnerType: input.OwnerType,
+		Namespace: input.Namespace,
+		Key:       input.Key,
+		Err:       err,
+	}
+	// The existing definition only makes the error more actionable, so ignore the failure to find it
+	definition, getErr := c.GetMetafieldDefinitionByKey(ctx, input.OwnerType, input.Namespace, input.Key)
+	if getErr == nil && definition != nil {
+		takenErr.ExistingID = definition.ID
+	}
+	return takenErr
+}
+
 type GetMetafieldDefinitionResponse struct {
 	MetafieldDefinition *MetafieldDefinition `json:"metafieldDefinition"`
 }
@@ -103,8 +232,31 @@ query metafieldDefinition($id: ID!) {
     type {
       category
       name
+      supportsDefinitionMigrations
     }
 	pinnedPosition
+    standardTemplate {
+      id
+    }
+    capabilities {
+      smartCollectionCondition {
+        enabled
+        eligible
+      }
+      adminFilterable {
+        enabled
+        eligible
+      }
+      uniqueValues {
+        enabled
+        eligible
+      }
+    }
+    access {
+      admin
+      storefront
+      customerAccount
+    }
     validations {
       name	
       value
@@ -114,7 +266,7 @@ query metafieldDefinition($id: ID!) {
 `
 
 	var gqlResp GetMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -122,13 +274,15 @@ query metafieldDefinition($id: ID!) {
 }
 
 type MetafieldDefinitionUpdateInput struct {
-	Name        string                           `json:"name"`
-	Description string                           `json:"description,omitempty"`
-	OwnerType   string                           `json:"ownerType"`
-	Namespace   string                           `json:"namespace"`
-	Key         string                           `json:"key"`
-	Pin         bool                             `json:"pin"`
-	Validations []*MetafieldDefinitionValidation `json:"validations"`
+	Name         string                                `json:"name"`
+	Description  *string                               `json:"description"`
+	OwnerType    string                                `json:"ownerType"`
+	Namespace    string                                `json:"namespace"`
+	Key          string                                `json:"key"`
+	Pin          bool                                  `json:"pin"`
+	Validations  []*MetafieldDefinitionValidation      `json:"validations"`
+	Access       *MetafieldAccess                      `json:"access,omitempty"`
+	Capabilities *MetafieldDefinitionCapabilitiesInput `json:"capabilities,omitempty"`
 }
 
 type UpdateMetafieldDefinitionResponse struct {
@@ -139,7 +293,13 @@ type UpdateMetafieldDefinitionResponse struct {
 }
 
 func (c *Client) UpdateMetafieldDefinition(ctx context.Context, input *MetafieldDefinitionUpdateInput) (*MetafieldDefinition, error) {
-	variables := map[string]interface{}{"definition": input}
+	validations, err := c.ResolveValidationReferences(ctx, input.Validations)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *input
+	resolved.Validations = validations
+	variables := map[string]interface{}{"definition": &resolved}
 	query := `
 mutation UpdateMetafieldDefinition($definition: MetafieldDefinitionUpdateInput!) {
   metafieldDefinitionUpdate(definition: $definition) {
@@ -153,8 +313,31 @@ mutation UpdateMetafieldDefinition($definition: MetafieldDefinitionUpdateInput!)
       type {
         category
         name
+        supportsDefinitionMigrations
       }
       pinnedPosition
+      standardTemplate {
+        id
+      }
+      capabilities {
+        smartCollectionCondition {
+          enabled
+          eligible
+        }
+        adminFilterable {
+          enabled
+          eligible
+        }
+        uniqueValues {
+          enabled
+          eligible
+        }
+      }
+      access {
+        admin
+        storefront
+        customerAccount
+      }
       validations {
         name	
         value
@@ -169,7 +352,7 @@ mutation UpdateMetafieldDefinition($definition: MetafieldDefinitionUpdateInput!)
 }`
 
 	var gqlResp UpdateMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err = c.mutate(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +384,7 @@ mutation DeleteMetafieldDefinition($id: ID!) {
 }`
 
 	var gqlResp DeleteMetafieldDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.mutate(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return err
 	}
@@ -210,3 +393,100 @@ mutation DeleteMetafieldDefinition($id: ID!) {
 	}
 	return nil
 }
+
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+type ListMetafieldDefinitionsResponse struct {
+	MetafieldDefinitions struct {
+		Nodes    []*MetafieldDefinition `json:"nodes"`
+		PageInfo PageInfo               `json:"pageInfo"`
+	} `json:"metafieldDefinitions"`
+}
+
+// ListMetafieldDefinitions returns all the metafield definitions of the owner type in the namespace.
+func (c *Client) ListMetafieldDefinitions(ctx context.Context, ownerType, namespace string) ([]*MetafieldDefinition, error) {
+	query := `
+query metafieldDefinitions($ownerType: MetafieldOwnerType!, $namespace: String, $after: String) {
+  metafieldDefinitions(ownerType: $ownerType, namespace: $namespace, first: 250, after: $after) {
+    nodes {
+      id
+      name
+      description
+      key
+      namespace
+      ownerType
+      type {
+        category
+        name
+        supportsDefinitionMigrations
+      }
+      pinnedPosition
+      standardTemplate {
+        id
+      }
+      capabilities {
+        smartCollectionCondition {
+          enabled
+          eligible
+        }
+        adminFilterable {
+          enabled
+          eligible
+        }
+        uniqueValues {
+          enabled
+          eligible
+        }
+      }
+      access {
+        admin
+        storefront
+        customerAccount
+      }
+      validations {
+        name
+        value
+      }
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}
+`
+
+	var definitions []*MetafieldDefinition
+	var after *string
+	for {
+		variables := map[string]interface{}{"ownerType": ownerType, "namespace": namespace, "after": after}
+		var gqlResp ListMetafieldDefinitionsResponse
+		err := c.query(ctx, query, variables, &gqlResp)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, gqlResp.MetafieldDefinitions.Nodes...)
+		if !gqlResp.MetafieldDefinitions.PageInfo.HasNextPage {
+			return definitions, nil
+		}
+		after = gqlResp.MetafieldDefinitions.PageInfo.EndCursor
+	}
+}
+
+// GetMetafieldDefinitionByKey returns the metafield definition of the owner type with the namespace and the key,
+// or nil if it doesn't exist.
+func (c *Client) GetMetafieldDefinitionByKey(ctx context.Context, ownerType, namespace, key string) (*MetafieldDefinition, error) {
+	definitions, err := c.ListMetafieldDefinitions(ctx, ownerType, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, definition := range definitions {
+		if definition.Key == key {
+			return definition, nil
+		}
+	}
+	return nil, nil
+}
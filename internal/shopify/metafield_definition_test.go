@@ -0,0 +1,53 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCreateMetafieldDefinition_taken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "metafieldDefinitionCreate") {
+			_, _ = w.Write([]byte(`{"data":{"metafieldDefinitionCreate":{"createdDefinition":null,"userErrors":[{"field":["definition","key"],"message":"Key is in use for Product metafields on the 'custom' namespace.","code":"TAKEN"}]}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"metafieldDefinitions":{"nodes":[{"id":"gid://shopify/MetafieldDefinition/1","key":"other"},{"id":"gid://shopify/MetafieldDefinition/2","key":"color"}],"pageInfo":{"hasNextPage":false}}}}`))
+	})
+
+	_, err := client.CreateMetafieldDefinition(context.Background(), &MetafieldDefinitionInput{
+		Name:      "Color",
+		OwnerType: "PRODUCT",
+		Namespace: "custom",
+		Key:       "color",
+		Type:      "single_line_text_field",
+	})
+	var takenErr *MetafieldDefinitionTakenError
+	if !errors.As(err, &takenErr) {
+		t.Fatalf("expected MetafieldDefinitionTakenError, got %v", err)
+	}
+	if takenErr.ExistingID != "gid://shopify/MetafieldDefinition/2" {
+		t.Errorf("unexpected existing id: %s", takenErr.ExistingID)
+	}
+	if !strings.Contains(err.Error(), "gid://shopify/MetafieldDefinition/2") {
+		t.Errorf("expected the error to name the existing definition, got %s", err)
+	}
+}
+
+func TestGetMetafieldDefinitionByKey_notFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"metafieldDefinitions":{"nodes":[{"id":"gid://shopify/MetafieldDefinition/1","key":"other"}],"pageInfo":{"hasNextPage":false}}}}`))
+	})
+
+	definition, err := client.GetMetafieldDefinitionByKey(context.Background(), "PRODUCT", "custom", "color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if definition != nil {
+		t.Errorf("expected no definition, got %+v", definition)
+	}
+}
@@ -0,0 +1,89 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetOwnerMetafield(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"node":{"metafield":{"id":"gid://shopify/Metafield/1","namespace":"custom","key":"layout","type":"json","value":"{}"}}}}`))
+	})
+
+	metafield, err := client.GetOwnerMetafield(context.Background(), "gid://shopify/Blog/1", "custom", "layout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metafield == nil || metafield.ID != "gid://shopify/Metafield/1" {
+		t.Errorf("unexpected metafield: %+v", metafield)
+	}
+}
+
+func TestGetOwnerMetafield_ownerNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"node":null}}`))
+	})
+
+	metafield, err := client.GetOwnerMetafield(context.Background(), "gid://shopify/Blog/1", "custom", "layout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metafield != nil {
+		t.Errorf("expected no metafield, got %+v", metafield)
+	}
+}
+
+func TestBulkSetMetafields(t *testing.T) {
+	var calls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			_, _ = w.Write([]byte(`{"data":{"metafieldsSet":{"metafields":[],"userErrors":[{"field":["metafields","0","value"],"message":"Value is invalid","code":"INVALID_VALUE"}]}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"metafieldsSet":{"metafields":[{"id":"gid://shopify/Metafield/1"}],"userErrors":[]}}}`))
+	})
+
+	inputs := []*MetafieldsSetInput{
+		{OwnerID: "gid://shopify/Product/1", Namespace: "custom", Key: "a", Type: "single_line_text_field", Value: "a"},
+		{OwnerID: "gid://shopify/Product/1", Namespace: "custom", Key: "b", Type: "number_integer", Value: "b"},
+		{OwnerID: "gid://shopify/Product/2", Namespace: "custom", Key: "a", Type: "single_line_text_field", Value: "a"},
+	}
+	errs := client.BulkSetMetafields(context.Background(), inputs)
+	if calls != len(inputs) {
+		t.Errorf("expected a mutation per metafield, got %d mutations", calls)
+	}
+	if errs[0] != nil || errs[1] == nil || errs[2] != nil {
+		t.Errorf("expected only the second metafield to fail, got %v", errs)
+	}
+}
+
+func TestBulkDeleteMetafields(t *testing.T) {
+	var calls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"metafieldsDelete":{"deletedMetafields":[],"userErrors":[]}}}`))
+	})
+
+	inputs := make([]*MetafieldIdentifierInput, metafieldsDeleteLimit+10)
+	for i := range inputs {
+		inputs[i] = &MetafieldIdentifierInput{OwnerID: "gid://shopify/Product/1", Namespace: "custom", Key: "key"}
+	}
+	errs := client.BulkDeleteMetafields(context.Background(), inputs)
+	if calls != 2 {
+		t.Errorf("expected 2 batches, got %d mutations", calls)
+	}
+	if errs[0] != nil || errs[metafieldsDeleteLimit-1] != nil {
+		t.Errorf("expected the first batch to be deleted, got %v", errs[0])
+	}
+	for i := metafieldsDeleteLimit; i < len(inputs); i++ {
+		if errs[i] == nil {
+			t.Fatalf("expected the second batch to fail, got no error for %d", i)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package shopify
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// deprecatedMetafieldTypes maps the deprecated metafield type names to the names that replaced them.
+// Shopify only returns the new names, even for definitions created with a deprecated one.
+var deprecatedMetafieldTypes = map[string]string{
+	"string":      "single_line_text_field",
+	"integer":     "number_integer",
+	"json_string": "json",
+}
+
+// CanonicalMetafieldType returns the name that replaced the deprecated metafield type name,
+// or the name itself if it isn't deprecated.
+func CanonicalMetafieldType(name string) string {
+	if canonical, ok := deprecatedMetafieldTypes[name]; ok {
+		return canonical
+	}
+	return name
+}
+
+// IsDeprecatedMetafieldType returns whether the metafield type name has been replaced by another one.
+func IsDeprecatedMetafieldType(name string) bool {
+	_, ok := deprecatedMetafieldTypes[name]
+	return ok
+}
+
+// listMetafieldTypePrefix prefixes the list types, e.g. list.product_reference.
+const listMetafieldTypePrefix = "list."
+
+// IsListMetafieldType returns whether the metafield type is a list of values, e.g. list.product_reference.
+func IsListMetafieldType(name string) bool {
+	return strings.HasPrefix(name, listMetafieldTypePrefix)
+}
+
+// MetafieldItemType returns the type of the values of the list type, or the type itself if it isn't a list.
+func MetafieldItemType(name string) string {
+	return strings.TrimPrefix(CanonicalMetafieldType(name), listMetafieldTypePrefix)
+}
+
+// ListMetafieldValidations are the validations of the number of values of the list types.
+var ListMetafieldValidations = []string{"list.min", "list.max"}
+
+// referenceMetafieldValidations maps the reference types to the only validations they accept,
+// as the validations of the scalar values, e.g. min or regex, don't apply to references.
+var referenceMetafieldValidations = map[string][]string{
+	"collection_reference": nil,
+	"company_reference":    nil,
+	"customer_reference":   nil,
+	"file_reference":       {"file_type_options"},
+	"metaobject_reference": {"metaobject_definition_id"},
+	"mixed_reference":      {"metaobject_definition_ids"},
+	"page_reference":       nil,
+	"product_reference":    nil,
+	"variant_reference":    nil,
+}
+
+// CheckMetafieldValidation returns an error if the metafield type doesn't accept the validation:
+// the list validations only apply to the list types, and the reference types only accept their own validations.
+// The validations of the other types aren't checked, Shopify rejects the unsupported ones.
+func CheckMetafieldValidation(typeName, validationName string) error {
+	if slices.Contains(ListMetafieldValidations, validationName) {
+		if !IsListMetafieldType(typeName) {
+			return fmt.Errorf("the validation %q only applies to list types, not to %q", validationName, typeName)
+		}
+		return nil
+	}
+	itemType := MetafieldItemType(typeName)
+	if accepted, ok := referenceMetafieldValidations[itemType]; ok && !slices.Contains(accepted, validationName) {
+		return fmt.Errorf("the validation %q doesn't apply to the references of %q", validationName, typeName)
+	}
+	return nil
+}
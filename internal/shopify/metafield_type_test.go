@@ -0,0 +1,57 @@
+package shopify
+
+import "testing"
+
+func TestCheckMetafieldValidation(t *testing.T) {
+	tests := []struct {
+		typeName, validationName string
+		ok                       bool
+	}{
+		{"list.product_reference", "list.min", true},
+		{"list.product_reference", "min", false},
+		{"product_reference", "list.max", false},
+		{"list.metaobject_reference", "metaobject_definition_id", true},
+		{"metaobject_reference", "metaobject_definition_id", true},
+		{"list.variant_reference", "regex", false},
+		{"list.single_line_text_field", "regex", true},
+		{"single_line_text_field", "max", true},
+		// The deprecated type names are those of scalar types
+		{"string", "list.min", false},
+	}
+	for _, tt := range tests {
+		err := CheckMetafieldValidation(tt.typeName, tt.validationName)
+		if (err == nil) != tt.ok {
+			t.Errorf("CheckMetafieldValidation(%q, %q) = %v", tt.typeName, tt.validationName, err)
+		}
+	}
+}
+
+func TestMetafieldItemType(t *testing.T) {
+	for name, want := range map[string]string{
+		"list.product_reference": "product_reference",
+		"product_reference":      "product_reference",
+		"integer":                "number_integer",
+	} {
+		if got := MetafieldItemType(name); got != want {
+			t.Errorf("MetafieldItemType(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestMetafieldDefinitionType_IsList(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "single_line_text_field", want: false},
+		{name: "list.single_line_text_field", want: true},
+		{name: "list.metaobject_reference", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (&MetafieldDefinitionType{Name: tt.name}).IsList(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
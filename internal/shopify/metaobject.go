@@ -0,0 +1,279 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// metaobjectBulkThreshold is the number of metaobject entries from which they are changed by a bulk operation
+// rather than by a mutation per entry, which is faster for a few entries but slow and throttled for many.
+const metaobjectBulkThreshold = 50
+
+type Metaobject struct {
+	ID     string `json:"id"`
+	Handle string `json:"handle"`
+	// Fields are only read by ListMetaobjectEntries and the mutations.
+	Fields []*MetaobjectField `json:"fields,omitempty"`
+}
+
+type MetaobjectField struct {
+	Key string `json:"key"`
+	// Value is nil if the field has no value.
+	Value *string `json:"value"`
+}
+
+type ListMetaobjectsResponse struct {
+	Metaobjects struct {
+		Nodes    []*Metaobject `json:"nodes"`
+		PageInfo PageInfo      `json:"pageInfo"`
+	} `json:"metaobjects"`
+}
+
+// ListMetaobjects returns all the metaobject entries of the type.
+func (c *Client) ListMetaobjects(ctx context.Context, metaobjectType string) ([]*Metaobject, error) {
+	query := `
+query metaobjects($type: String!, $after: String) {
+  metaobjects(type: $type, first: 250, after: $after) {
+    nodes {
+      id
+      handle
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}
+`
+
+	var metaobjects []*Metaobject
+	var after *string
+	for {
+		variables := map[string]interface{}{"type": metaobjectType, "after": after}
+		var gqlResp ListMetaobjectsResponse
+		err := c.query(ctx, query, variables, &gqlResp)
+		if err != nil {
+			return nil, err
+		}
+		metaobjects = append(metaobjects, gqlResp.Metaobjects.Nodes...)
+		if !gqlResp.Metaobjects.PageInfo.HasNextPage {
+			return metaobjects, nil
+		}
+		after = gqlResp.Metaobjects.PageInfo.EndCursor
+	}
+}
+
+// ListMetaobjectEntries returns all the metaobject entries of the type with their fields.
+func (c *Client) ListMetaobjectEntries(ctx context.Context, metaobjectType string) ([]*Metaobject, error) {
+	query := `
+query metaobjectEntries($type: String!, $after: String) {
+  metaobjects(type: $type, first: 250, after: $after) {
+    nodes {` + metaobjectFields + `
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}
+`
+
+	var metaobjects []*Metaobject
+	var after *string
+	for {
+		variables := map[string]interface{}{"type": metaobjectType, "after": after}
+		var gqlResp ListMetaobjectsResponse
+		err := c.query(ctx, query, variables, &gqlResp)
+		if err != nil {
+			return nil, err
+		}
+		metaobjects = append(metaobjects, gqlResp.Metaobjects.Nodes...)
+		if !gqlResp.Metaobjects.PageInfo.HasNextPage {
+			return metaobjects, nil
+		}
+		after = gqlResp.Metaobjects.PageInfo.EndCursor
+	}
+}
+
+const metaobjectFields = `
+      id
+      handle
+      fields {
+        key
+        value
+      }`
+
+type MetaobjectHandleInput struct {
+	Type   string `json:"type"`
+	Handle string `json:"handle"`
+}
+
+type MetaobjectFieldInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type MetaobjectUpsertInput struct {
+	Fields []*MetaobjectFieldInput `json:"fields"`
+}
+
+// MetaobjectUpsertResult is the result of upserting the metaobject entry of the handle.
+type MetaobjectUpsertResult struct {
+	Handle     string
+	Metaobject *Metaobject
+	// Err is the error of the entry, nil if it has been upserted.
+	Err error
+}
+
+type UpsertMetaobjectResponse struct {
+	MetaobjectUpsert struct {
+		Metaobject *Metaobject `json:"metaobject"`
+		UserErrors UserErrors  `json:"userErrors"`
+	} `json:"metaobjectUpsert"`
+}
+
+const upsertMetaobjectMutation = `
+mutation metaobjectUpsert($handle: MetaobjectHandleInput!, $metaobject: MetaobjectUpsertInput!) {
+  metaobjectUpsert(handle: $handle, metaobject: $metaobject) {
+    metaobject {` + metaobjectFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+// UpsertMetaobjects creates or updates the metaobject entries of the type by handle, and returns the result of each entry
+// in the order of the handles. Many entries are upserted by a bulk operation, a few by a mutation per entry.
+// The entries fail independently of each other, so some may have been upserted when others fail.
+func (c *Client) UpsertMetaobjects(ctx context.Context, metaobjectType string, handles []string, inputs []*MetaobjectUpsertInput) []*MetaobjectUpsertResult {
+	results := make([]*MetaobjectUpsertResult, len(handles))
+	variables := make([]map[string]interface{}, len(handles))
+	for i, handle := range handles {
+		results[i] = &MetaobjectUpsertResult{Handle: handle}
+		variables[i] = map[string]interface{}{
+			"handle":     &MetaobjectHandleInput{Type: metaobjectType, Handle: handle},
+			"metaobject": inputs[i],
+		}
+	}
+
+	if len(handles) < metaobjectBulkThreshold {
+		for i, result := range results {
+			var gqlResp UpsertMetaobjectResponse
+			result.Err = c.mutate(ctx, upsertMetaobjectMutation, variables[i], &gqlResp)
+			if result.Err == nil {
+				result.Err = gqlResp.MetaobjectUpsert.UserErrors.Error()
+			}
+			result.Metaobject = gqlResp.MetaobjectUpsert.Metaobject
+		}
+		return results
+	}
+
+	bulkResults, err := c.RunBulkMutation(ctx, upsertMetaobjectMutation, variables)
+	for i, result := range results {
+		bulkResult, ok := bulkResults[i]
+		if !ok {
+			result.Err = fmt.Errorf("no result from the bulk operation: %w", err)
+			continue
+		}
+		if result.Err = bulkResult.Err(); result.Err != nil {
+			continue
+		}
+		var data struct {
+			MetaobjectUpsert struct {
+				Metaobject *Metaobject `json:"metaobject"`
+				UserErrors UserErrors  `json:"userErrors"`
+			} `json:"metaobjectUpsert"`
+		}
+		if result.Err = json.Unmarshal(bulkResult.Data, &data); result.Err != nil {
+			continue
+		}
+		result.Err = data.MetaobjectUpsert.UserErrors.Error()
+		result.Metaobject = data.MetaobjectUpsert.Metaobject
+	}
+	return results
+}
+
+type DeleteMetaobjectResponse struct {
+	MetaobjectDelete struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"metaobjectDelete"`
+}
+
+type BulkDeleteMetaobjectsResponse struct {
+	MetaobjectBulkDelete struct {
+		Job        *Job       `json:"job"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"metaobjectBulkDelete"`
+}
+
+// DeleteMetaobjects deletes the metaobject entries and returns the errors by ID of the entries which haven't been deleted.
+// Many entries are deleted by a single job, which fails or succeeds as a whole, a few by a mutation per entry.
+func (c *Client) DeleteMetaobjects(ctx context.Context, ids []string) map[string]error {
+	errs := map[string]error{}
+	if len(ids) < metaobjectBulkThreshold {
+		query := `
+mutation metaobjectDelete($id: ID!) {
+  metaobjectDelete(id: $id) {
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+		for _, id := range ids {
+			var gqlResp DeleteMetaobjectResponse
+			err := c.mutate(ctx, query, map[string]interface{}{"id": id}, &gqlResp)
+			if err == nil {
+				err = gqlResp.MetaobjectDelete.UserErrors.Error()
+			}
+			if err != nil {
+				errs[id] = err
+			}
+		}
+		return errs
+	}
+
+	query := `
+mutation metaobjectBulkDelete($where: MetaobjectBulkDeleteWhereCondition!) {
+  metaobjectBulkDelete(where: $where) {
+    job {
+      id
+      done
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+	var gqlResp BulkDeleteMetaobjectsResponse
+	err := c.mutate(ctx, query, map[string]interface{}{"where": map[string]interface{}{"ids": ids}}, &gqlResp)
+	if err == nil {
+		err = gqlResp.MetaobjectBulkDelete.UserErrors.Error()
+	}
+	if job := gqlResp.MetaobjectBulkDelete.Job; err == nil && job != nil {
+		err = pollUntil(ctx, bulkOperationPollInterval, func(ctx context.Context) (bool, error) {
+			if job.Done {
+				return true, nil
+			}
+			job, err = c.GetJob(ctx, job.ID)
+			if err != nil {
+				return false, err
+			}
+			// Finished jobs may no longer be found
+			return job == nil || job.Done, nil
+		})
+	}
+	if err != nil {
+		for _, id := range ids {
+			errs[id] = err
+		}
+	}
+	return errs
+}
@@ -2,6 +2,7 @@ package shopify
 
 import (
 	"context"
+	"strings"
 )
 
 type MetaobjectAccess struct {
@@ -9,6 +10,50 @@ type MetaobjectAccess struct {
 	Storefront string `json:"storefront,omitempty"`
 }
 
+// RenderableCapabilityAPIVersion is the first API version accepting the renderable and the online store capabilities
+// in the input of metaobject definitions.
+const RenderableCapabilityAPIVersion = "2024-01"
+
+type MetaobjectCapabilityStatus struct {
+	Enabled bool `json:"enabled"`
+}
+
+// MetaobjectCapabilityRenderable is the renderable capability, which lets the metaobjects be rendered as web pages
+// with the SEO meta tags taken from their fields.
+// Its input is only accepted since RenderableCapabilityAPIVersion.
+type MetaobjectCapabilityRenderable struct {
+	Enabled bool                                `json:"enabled"`
+	Data    *MetaobjectCapabilityRenderableData `json:"data,omitempty"`
+}
+
+type MetaobjectCapabilityRenderableData struct {
+	MetaTitleKey       *string `json:"metaTitleKey,omitempty"`
+	MetaDescriptionKey *string `json:"metaDescriptionKey,omitempty"`
+}
+
+// MetaobjectCapabilityOnlineStore is the online store capability, which gives the metaobjects web pages on the online store
+// under the URL handle, rendered with a theme template. It requires the renderable capability.
+type MetaobjectCapabilityOnlineStore struct {
+	Enabled bool                                 `json:"enabled"`
+	Data    *MetaobjectCapabilityOnlineStoreData `json:"data,omitempty"`
+}
+
+// MetaobjectCapabilityOnlineStoreData is the data of the online store capability.
+// CanCreateRedirects is only read, while CreateRedirects is only sent, both telling whether changing the URL handle
+// redirects the previous URLs of the metaobjects.
+type MetaobjectCapabilityOnlineStoreData struct {
+	URLHandle          string `json:"urlHandle"`
+	CanCreateRedirects bool   `json:"canCreateRedirects,omitempty"`
+	CreateRedirects    *bool  `json:"createRedirects,omitempty"`
+}
+
+type MetaobjectCapabilities struct {
+	Publishable  *MetaobjectCapabilityStatus      `json:"publishable,omitempty"`
+	Translatable *MetaobjectCapabilityStatus      `json:"translatable,omitempty"`
+	Renderable   *MetaobjectCapabilityRenderable  `json:"renderable,omitempty"`
+	OnlineStore  *MetaobjectCapabilityOnlineStore `json:"onlineStore,omitempty"`
+}
+
 type MetaobjectDefinition struct {
 	ID                string                       `json:"id"`
 	Type              string                       `json:"type"`
@@ -18,6 +63,20 @@ type MetaobjectDefinition struct {
 	FieldDefinitions  []*MetaobjectFieldDefinition `json:"fieldDefinitions"`
 	HasThumbnailField bool                         `json:"hasThumbnailField"`
 	Access            *MetaobjectAccess            `json:"access"`
+	Capabilities      *MetaobjectCapabilities      `json:"capabilities"`
+}
+
+// The prefixes of the types of the definitions which the merchant doesn't own: the "$app:" types reserved by an app,
+// which are resolved to the app--{app id}-- prefix, and the standard definitions maintained by Shopify.
+const (
+	appOwnedMetaobjectTypePrefix = "app--"
+	standardMetaobjectTypePrefix = "shopify--"
+)
+
+// IsAppOwned returns whether the definition is owned by an app or by Shopify rather than by the merchant,
+// i.e. whether its type is reserved by an app or it's a standard definition, so that it shouldn't be managed as a custom definition.
+func (d *MetaobjectDefinition) IsAppOwned() bool {
+	return strings.HasPrefix(d.Type, appOwnedMetaobjectTypePrefix) || strings.HasPrefix(d.Type, standardMetaobjectTypePrefix)
 }
 
 type MetaobjectFieldDefinition struct {
@@ -37,6 +96,7 @@ type MetaobjectDefinitionCreateInput struct {
 	DisplayNameKey   *string                                 `json:"displayNameKey,omitempty"`
 	FieldDefinitions []*MetaobjectFieldDefinitionCreateInput `json:"fieldDefinitions"`
 	Access           *MetaobjectAccess                       `json:"access,omitempty"`
+	Capabilities     *MetaobjectCapabilities                 `json:"capabilities,omitempty"`
 }
 
 type MetaobjectFieldDefinitionCreateInput struct {
@@ -49,7 +109,13 @@ type MetaobjectFieldDefinitionCreateInput struct {
 }
 
 func (c *Client) CreateMetaobjectDefinition(ctx context.Context, input *MetaobjectDefinitionCreateInput) (*MetaobjectDefinition, error) {
-	variables := map[string]interface{}{"definition": input}
+	fieldDefinitions, err := c.resolveFieldDefinitionReferences(ctx, input.FieldDefinitions)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *input
+	resolved.FieldDefinitions = fieldDefinitions
+	variables := map[string]interface{}{"definition": &resolved}
 	query := `
 mutation CreateMetaobjectDefinition($definition: MetaobjectDefinitionCreateInput!) {
   metaobjectDefinitionCreate(definition: $definition) {
@@ -66,6 +132,7 @@ mutation CreateMetaobjectDefinition($definition: MetaobjectDefinitionCreateInput
 		type {
 		  category
           name
+          supportsDefinitionMigrations
 		}	
 		required
         validations {
@@ -78,6 +145,28 @@ mutation CreateMetaobjectDefinition($definition: MetaobjectDefinitionCreateInput
         admin
         storefront
       }
+      capabilities {
+        publishable {
+          enabled
+        }
+        translatable {
+          enabled
+        }
+        renderable {
+          enabled
+          data {
+            metaTitleKey
+            metaDescriptionKey
+          }
+        }
+        onlineStore {
+          enabled
+          data {
+            urlHandle
+            canCreateRedirects
+          }
+        }
+      }
     }
     userErrors {
       field
@@ -94,7 +183,7 @@ mutation CreateMetaobjectDefinition($definition: MetaobjectDefinitionCreateInput
 		} `json:"metaobjectDefinitionCreate"`
 	}
 	var gqlResp CreateMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err = c.mutate(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -104,15 +193,7 @@ mutation CreateMetaobjectDefinition($definition: MetaobjectDefinitionCreateInput
 	return gqlResp.MetaobjectDefinitionCreate.CreatedDefinition, nil
 }
 
-type GetMetaobjectDefinitionResponse struct {
-	MetaobjectDefinition *MetaobjectDefinition `json:"metaobjectDefinition"`
-}
-
-func (c *Client) GetMetaobjectDefinition(ctx context.Context, id string) (*MetaobjectDefinition, error) {
-	variables := map[string]interface{}{"id": id}
-	query := `
-query metaobjectDefinition($id: ID!) {
-  metaobjectDefinition(id: $id) {
+const metaobjectDefinitionFields = `
     id
     type
     name
@@ -125,6 +206,7 @@ query metaobjectDefinition($id: ID!) {
       type {
         category
         name
+        supportsDefinitionMigrations
       }	
       required
       validations {
@@ -137,24 +219,122 @@ query metaobjectDefinition($id: ID!) {
       admin
       storefront
     }
-  }
+    capabilities {
+      publishable {
+        enabled
+      }
+      translatable {
+        enabled
+      }
+      renderable {
+        enabled
+        data {
+          metaTitleKey
+          metaDescriptionKey
+        }
+      }
+      onlineStore {
+        enabled
+        data {
+          urlHandle
+          canCreateRedirects
+        }
+      }
+    }
+`
+
+type GetMetaobjectDefinitionResponse struct {
+	MetaobjectDefinition *MetaobjectDefinition `json:"metaobjectDefinition"`
+}
+
+func (c *Client) GetMetaobjectDefinition(ctx context.Context, id string) (*MetaobjectDefinition, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query metaobjectDefinition($id: ID!) {
+  metaobjectDefinition(id: $id) {` + metaobjectDefinitionFields + `  }
 }
 `
 
 	var gqlResp GetMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.query(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
 	return gqlResp.MetaobjectDefinition, nil
 }
 
+type GetMetaobjectDefinitionByTypeResponse struct {
+	MetaobjectDefinitionByType *MetaobjectDefinition `json:"metaobjectDefinitionByType"`
+}
+
+// GetMetaobjectDefinitionByType returns the metaobject definition of the type, or nil if it doesn't exist.
+func (c *Client) GetMetaobjectDefinitionByType(ctx context.Context, metaobjectType string) (*MetaobjectDefinition, error) {
+	variables := map[string]interface{}{"type": metaobjectType}
+	query := `
+query metaobjectDefinitionByType($type: String!) {
+  metaobjectDefinitionByType(type: $type) {` + metaobjectDefinitionFields + `  }
+}
+`
+
+	var gqlResp GetMetaobjectDefinitionByTypeResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.MetaobjectDefinitionByType, nil
+}
+
+type ListMetaobjectDefinitionsResponse struct {
+	MetaobjectDefinitions struct {
+		Nodes    []*MetaobjectDefinition `json:"nodes"`
+		PageInfo PageInfo                `json:"pageInfo"`
+	} `json:"metaobjectDefinitions"`
+}
+
+// ListMetaobjectDefinitions returns all the metaobject definitions of the shop, without the ones owned by apps or by Shopify
+// unless includeAppOwned is true, so that only the definitions the merchant can manage are returned by default.
+func (c *Client) ListMetaobjectDefinitions(ctx context.Context, includeAppOwned bool) ([]*MetaobjectDefinition, error) {
+	query := `
+query metaobjectDefinitions($after: String) {
+  metaobjectDefinitions(first: 250, after: $after) {
+    nodes {` + metaobjectDefinitionFields + `    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}
+`
+
+	var definitions []*MetaobjectDefinition
+	var after *string
+	for {
+		variables := map[string]interface{}{"after": after}
+		var gqlResp ListMetaobjectDefinitionsResponse
+		err := c.query(ctx, query, variables, &gqlResp)
+		if err != nil {
+			return nil, err
+		}
+		for _, definition := range gqlResp.MetaobjectDefinitions.Nodes {
+			if includeAppOwned || !definition.IsAppOwned() {
+				definitions = append(definitions, definition)
+			}
+		}
+		if !gqlResp.MetaobjectDefinitions.PageInfo.HasNextPage {
+			return definitions, nil
+		}
+		after = gqlResp.MetaobjectDefinitions.PageInfo.EndCursor
+	}
+}
+
+// MetaobjectDefinitionUpdateInput updates the attributes set in it, leaving the other ones unchanged.
 type MetaobjectDefinitionUpdateInput struct {
-	Name             string                                     `json:"name"`
+	Name             *string                                    `json:"name,omitempty"`
 	Description      *string                                    `json:"description,omitempty"`
 	DisplayNameKey   *string                                    `json:"displayNameKey,omitempty"`
 	FieldDefinitions []*MetaobjectFieldDefinitionOperationInput `json:"fieldDefinitions"`
 	Access           *MetaobjectAccess                          `json:"access,omitempty"`
+	Capabilities     *MetaobjectCapabilities                    `json:"capabilities,omitempty"`
 }
 
 type MetaobjectFieldDefinitionOperationInput struct {
@@ -176,7 +356,16 @@ type MetaobjectFieldDefinitionDeleteInput struct {
 }
 
 func (c *Client) UpdateMetaobjectDefinition(ctx context.Context, id string, input *MetaobjectDefinitionUpdateInput) (*MetaobjectDefinition, error) {
-	variables := map[string]interface{}{"id": id, "definition": input}
+	unlock := c.lock("MetaobjectDefinition:" + id)
+	defer unlock()
+
+	operations, err := c.resolveFieldDefinitionOperationReferences(ctx, input.FieldDefinitions)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *input
+	resolved.FieldDefinitions = operations
+	variables := map[string]interface{}{"id": id, "definition": &resolved}
 	query := `
 mutation UpdateMetaobjectDefinition($id: ID!, $definition: MetaobjectDefinitionUpdateInput!) {
   metaobjectDefinitionUpdate(id: $id, definition: $definition) {
@@ -193,6 +382,7 @@ mutation UpdateMetaobjectDefinition($id: ID!, $definition: MetaobjectDefinitionU
 	  	type {
 	  	  category
           name
+          supportsDefinitionMigrations
 	  	}	
 	  	required
         validations {
@@ -205,6 +395,28 @@ mutation UpdateMetaobjectDefinition($id: ID!, $definition: MetaobjectDefinitionU
         admin
         storefront
       }
+      capabilities {
+        publishable {
+          enabled
+        }
+        translatable {
+          enabled
+        }
+        renderable {
+          enabled
+          data {
+            metaTitleKey
+            metaDescriptionKey
+          }
+        }
+        onlineStore {
+          enabled
+          data {
+            urlHandle
+            canCreateRedirects
+          }
+        }
+      }
     }
     userErrors {
       field
@@ -222,7 +434,7 @@ mutation UpdateMetaobjectDefinition($id: ID!, $definition: MetaobjectDefinitionU
 	}
 
 	var gqlResp UpdateMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err = c.mutate(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return nil, err
 	}
@@ -253,12 +465,57 @@ mutation DeleteMetaobjectDefinition($id: ID!) {
 		} `json:"metaobjectDefinitionDelete"`
 	}
 	var gqlResp DeleteMetaobjectDefinitionResponse
-	err := c.shopifyClient.GraphQL.Query(ctx, query, variables, &gqlResp)
+	err := c.mutate(ctx, query, variables, &gqlResp)
 	if err != nil {
 		return err
 	}
 	if err := gqlResp.MetaobjectDefinitionDelete.UserErrors.Error(); err != nil {
 		return err
 	}
+	c.definitionGIDs.forget(id)
 	return nil
 }
+
+// UpdateMetaobjectFieldDefinitions creates, updates and deletes the field definitions of the metaobject definition,
+// leaving its other fields and settings as they are. The updates of the same definition run one at a time.
+func (c *Client) UpdateMetaobjectFieldDefinitions(ctx context.Context, id string, operations []*MetaobjectFieldDefinitionOperationInput) (*MetaobjectDefinition, error) {
+	unlock := c.lock("MetaobjectDefinition:" + id)
+	defer unlock()
+
+	operations, err := c.resolveFieldDefinitionOperationReferences(ctx, operations)
+	if err != nil {
+		return nil, err
+	}
+	variables := map[string]interface{}{
+		"id":         id,
+		"definition": map[string]interface{}{"fieldDefinitions": operations},
+	}
+	query := `
+mutation UpdateMetaobjectFieldDefinitions($id: ID!, $definition: MetaobjectDefinitionUpdateInput!) {
+  metaobjectDefinitionUpdate(id: $id, definition: $definition) {
+    metaobjectDefinition {` + metaobjectDefinitionFields + `    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	type UpdateMetaobjectDefinitionResponse struct {
+		MetaobjectDefinitionUpdate struct {
+			UpdatedDefinition *MetaobjectDefinition `json:"metaobjectDefinition"`
+			UserErrors        UserErrors            `json:"userErrors"`
+		} `json:"metaobjectDefinitionUpdate"`
+	}
+
+	var gqlResp UpdateMetaobjectDefinitionResponse
+	err = c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.MetaobjectDefinitionUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.MetaobjectDefinitionUpdate.UpdatedDefinition, nil
+}
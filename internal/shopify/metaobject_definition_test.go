@@ -0,0 +1,39 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestListMetaobjectDefinitions(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"metaobjectDefinitions":{"nodes":[
+  {"id":"gid://shopify/MetaobjectDefinition/1","type":"author"},
+  {"id":"gid://shopify/MetaobjectDefinition/2","type":"app--1234--reviews"},
+  {"id":"gid://shopify/MetaobjectDefinition/3","type":"shopify--qa-pair"},
+  {"id":"gid://shopify/MetaobjectDefinition/4","type":"shopify_app_settings"}
+],"pageInfo":{"hasNextPage":false}}}}`))
+	})
+
+	for _, tt := range []struct {
+		includeAppOwned bool
+		want            []string
+	}{
+		{includeAppOwned: false, want: []string{"author", "shopify_app_settings"}},
+		{includeAppOwned: true, want: []string{"author", "app--1234--reviews", "shopify--qa-pair", "shopify_app_settings"}},
+	} {
+		definitions, err := client.ListMetaobjectDefinitions(context.Background(), tt.includeAppOwned)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var types []string
+		for _, definition := range definitions {
+			types = append(types, definition.Type)
+		}
+		if !slices.Equal(types, tt.want) {
+			t.Errorf("includeAppOwned=%t: expected %v, got %v", tt.includeAppOwned, tt.want, types)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListMetaobjects(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Variables["after"] == nil {
+			_, _ = w.Write([]byte(`{"data":{"metaobjects":{"nodes":[{"id":"gid://shopify/Metaobject/1","handle":"first"}],"pageInfo":{"hasNextPage":true,"endCursor":"cursor"}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"metaobjects":{"nodes":[{"id":"gid://shopify/Metaobject/2","handle":"second"}],"pageInfo":{"hasNextPage":false}}}}`))
+	})
+
+	metaobjects, err := client.ListMetaobjects(context.Background(), "author")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metaobjects) != 2 || metaobjects[0].Handle != "first" || metaobjects[1].Handle != "second" {
+		t.Errorf("unexpected metaobjects: %+v", metaobjects)
+	}
+}
+
+func TestUpdateMetaobjectFieldDefinitions_serialized(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		if n > maxInFlight.Load() {
+			maxInFlight.Store(n)
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"data":{"metaobjectDefinitionUpdate":{"metaobjectDefinition":{"id":"gid://shopify/MetaobjectDefinition/1","type":"author"},"userErrors":[]}}}`))
+	})
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"bio", "website", "age"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.UpdateMetaobjectFieldDefinitions(context.Background(), "gid://shopify/MetaobjectDefinition/1", []*MetaobjectFieldDefinitionOperationInput{
+				{Delete: &MetaobjectFieldDefinitionDeleteInput{Key: key}},
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if maxInFlight.Load() != 1 {
+		t.Errorf("expected the updates of the same definition to run one at a time, got %d at once", maxInFlight.Load())
+	}
+}
+
+func TestUpsertMetaobjects(t *testing.T) {
+	var handles []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				Handle MetaobjectHandleInput `json:"handle"`
+			} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		handles = append(handles, body.Variables.Handle.Handle)
+		if body.Variables.Handle.Handle == "invalid" {
+			_, _ = w.Write([]byte(`{"data":{"metaobjectUpsert":{"metaobject":null,"userErrors":[{"field":["metaobject","fields","0"],"message":"Value is invalid","code":"INVALID_VALUE"}]}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"metaobjectUpsert":{"metaobject":{"id":"gid://shopify/Metaobject/1","handle":"` + body.Variables.Handle.Handle + `","fields":[{"key":"name","value":"Jane"}]},"userErrors":[]}}}`))
+	})
+
+	results := client.UpsertMetaobjects(context.Background(), "author", []string{"jane", "invalid"}, []*MetaobjectUpsertInput{
+		{Fields: []*MetaobjectFieldInput{{Key: "name", Value: "Jane"}}},
+		{Fields: []*MetaobjectFieldInput{{Key: "name", Value: ""}}},
+	})
+	if len(handles) != 2 {
+		t.Errorf("expected a mutation per entry, got %v", handles)
+	}
+	if results[0].Handle != "jane" || results[0].Err != nil || results[0].Metaobject.Fields[0].Key != "name" {
+		t.Errorf("unexpected result: %+v", results[0])
+	}
+	if results[1].Handle != "invalid" || results[1].Err == nil {
+		t.Errorf("expected the entry to fail: %+v", results[1])
+	}
+}
+
+func TestUpsertMetaobjects_bulk(t *testing.T) {
+	client := newTestClient(t, bulkMutationHandler(t, "COMPLETED", func(line int, variables string) string {
+		if line == 1 {
+			return `{"data":{"metaobjectUpsert":{"metaobject":null,"userErrors":[{"field":["handle"],"message":"Handle is invalid","code":"INVALID"}]}},"__lineNumber":1}`
+		}
+		var v struct {
+			Handle MetaobjectHandleInput `json:"handle"`
+		}
+		_ = json.Unmarshal([]byte(variables), &v)
+		return `{"data":{"metaobjectUpsert":{"metaobject":{"id":"gid://shopify/Metaobject/1","handle":"` + v.Handle.Handle + `"},"userErrors":[]}},"__lineNumber":` + strconv.Itoa(line) + `}`
+	}))
+
+	handles := make([]string, metaobjectBulkThreshold)
+	inputs := make([]*MetaobjectUpsertInput, metaobjectBulkThreshold)
+	for i := range handles {
+		handles[i] = "entry-" + strconv.Itoa(i)
+		inputs[i] = &MetaobjectUpsertInput{Fields: []*MetaobjectFieldInput{{Key: "name", Value: handles[i]}}}
+	}
+	results := client.UpsertMetaobjects(context.Background(), "author", handles, inputs)
+	for i, result := range results {
+		if i == 1 {
+			if result.Err == nil {
+				t.Errorf("expected the entry to fail: %+v", result)
+			}
+			continue
+		}
+		if result.Err != nil || result.Metaobject == nil || result.Metaobject.Handle != handles[i] {
+			t.Errorf("unexpected result %d: %+v", i, result)
+		}
+	}
+}
+
+func TestDeleteMetaobjects(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Variables["id"] == "gid://shopify/Metaobject/2" {
+			_, _ = w.Write([]byte(`{"data":{"metaobjectDelete":{"userErrors":[{"field":["id"],"message":"Record not found","code":"RECORD_NOT_FOUND"}]}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"metaobjectDelete":{"userErrors":[]}}}`))
+	})
+
+	errs := client.DeleteMetaobjects(context.Background(), []string{"gid://shopify/Metaobject/1", "gid://shopify/Metaobject/2"})
+	if len(errs) != 1 || errs["gid://shopify/Metaobject/2"] == nil {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
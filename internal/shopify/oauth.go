@@ -0,0 +1,83 @@
+package shopify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// TokenRefresher obtains new access tokens of a public app with the refresh token of its expiring offline access token.
+// Shopify rotates the refresh token on every refresh, so the refresher keeps the latest one.
+type TokenRefresher struct {
+	httpClient   *http.Client
+	shop         string
+	clientID     string
+	clientSecret string
+
+	mu           sync.Mutex
+	refreshToken string
+}
+
+// NewTokenRefresher returns the refresher of the access tokens of the shop, its myshopify domain,
+// for the app identified by its API key and API secret key.
+func NewTokenRefresher(httpClient *http.Client, shop, clientID, clientSecret, refreshToken string) *TokenRefresher {
+	return &TokenRefresher{
+		httpClient:   httpClient,
+		shop:         shop,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		refreshToken: refreshToken,
+	}
+}
+
+type refreshAccessTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Refresh returns a new access token. The refreshes are serialized, as each one consumes the refresh token.
+func (r *TokenRefresher) Refresh(ctx context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body, err := json.Marshal(map[string]string{
+		"client_id":     r.clientID,
+		"client_secret": r.clientSecret,
+		"grant_type":    "refresh_token",
+		"refresh_token": r.refreshToken,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+r.shop+"/admin/oauth/access_token", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("failed to refresh the access token: %s: %s", resp.Status, bytes.TrimSpace(message))
+	}
+
+	var tokenResp refreshAccessTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode the refreshed access token: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("failed to refresh the access token: no access_token in the response")
+	}
+	if tokenResp.RefreshToken != "" {
+		r.refreshToken = tokenResp.RefreshToken
+	}
+	return tokenResp.AccessToken, nil
+}
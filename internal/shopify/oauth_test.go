@@ -0,0 +1,62 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTokenRefresher_Refresh(t *testing.T) {
+	var refreshTokens []string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.String() != "https://theshop.myshopify.com/admin/oauth/access_token" {
+				t.Errorf("unexpected URL %s", req.URL)
+			}
+			var body map[string]string
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body["grant_type"] != "refresh_token" || body["client_id"] != "key" || body["client_secret"] != "secret" {
+				t.Errorf("unexpected body %v", body)
+			}
+			refreshTokens = append(refreshTokens, body["refresh_token"])
+			rec := httptest.NewRecorder()
+			_, _ = rec.WriteString(`{"access_token":"shpat_new","expires_in":3600,"refresh_token":"shprt_rotated"}`)
+			return rec.Result(), nil
+		}),
+	}
+	refresher := NewTokenRefresher(httpClient, "theshop.myshopify.com", "key", "secret", "shprt_initial")
+
+	for i := 0; i < 2; i++ {
+		token, err := refresher.Refresh(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "shpat_new" {
+			t.Errorf("unexpected access token %q", token)
+		}
+	}
+	// The rotated refresh token is used for the next refresh
+	if len(refreshTokens) != 2 || refreshTokens[0] != "shprt_initial" || refreshTokens[1] != "shprt_rotated" {
+		t.Errorf("unexpected refresh tokens %v", refreshTokens)
+	}
+}
+
+func TestTokenRefresher_Refresh_rejected(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			rec.WriteHeader(http.StatusBadRequest)
+			_, _ = rec.WriteString(`{"error":"invalid_grant"}`)
+			return rec.Result(), nil
+		}),
+	}
+	refresher := NewTokenRefresher(httpClient, "theshop.myshopify.com", "key", "secret", "shprt_revoked")
+
+	if _, err := refresher.Refresh(context.Background()); err == nil {
+		t.Error("expected an error")
+	}
+}
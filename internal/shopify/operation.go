@@ -0,0 +1,84 @@
+package shopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Operation is a mutating operation the client has run, e.g. to keep an audit trail of the changes to the shop.
+type Operation struct {
+	// Resource is the type of the changed object, e.g. metafieldDefinition or page.
+	Resource string
+	// Action is the change, e.g. Create, Update or Delete.
+	Action string
+	// ID is the GID of the changed object, or empty if it's unknown.
+	ID   string
+	Time time.Time
+	// Err is the error of the operation, nil if it has succeeded.
+	Err error
+}
+
+// record passes the operation to the OnOperation hook of the configuration, if any.
+func (c *Client) record(resource, action, id string, err error) {
+	if c.config.OnOperation == nil {
+		return
+	}
+	c.config.OnOperation(Operation{Resource: resource, Action: action, ID: id, Time: time.Now(), Err: err})
+}
+
+// recordREST records the mutating REST operation on the object of the type and the numeric ID.
+func (c *Client) recordREST(resource, action, gidType string, id uint64, err error) {
+	gid := ""
+	if id != 0 {
+		gid = fmt.Sprintf("gid://shopify/%s/%d", gidType, id)
+	}
+	c.record(resource, action, gid, err)
+}
+
+var gidPattern = regexp.MustCompile(`"id":\s*"(gid://shopify/[^"]+)"`)
+
+// recordMutation records the GraphQL mutation from its response, which is keyed by the name of the mutation,
+// e.g. metafieldDefinitionCreate. The user errors in the response fail the operation as well.
+func (c *Client) recordMutation(variables interface{}, data json.RawMessage, err error) {
+	if c.config.OnOperation == nil {
+		return
+	}
+	var fields map[string]*struct {
+		UserErrors UserErrors `json:"userErrors"`
+	}
+	_ = json.Unmarshal(data, &fields)
+	name := "unknown"
+	for field, result := range fields {
+		name = field
+		if err == nil && result != nil {
+			err = result.UserErrors.Error()
+		}
+	}
+	resource, action := splitMutationName(name)
+
+	// The ID of an existing object is usually a variable, and the one of a created object is in the response
+	id := ""
+	if vars, ok := variables.(map[string]interface{}); ok {
+		if v, ok := vars["id"].(string); ok && strings.HasPrefix(v, "gid://") {
+			id = v
+		}
+	}
+	if m := gidPattern.FindSubmatch(data); id == "" && m != nil {
+		id = string(m[1])
+	}
+	c.record(resource, action, id, err)
+}
+
+// splitMutationName splits the name of a mutation into the resource and the action,
+// e.g. metafieldDefinitionCreate into metafieldDefinition and Create.
+func splitMutationName(name string) (resource, action string) {
+	i := strings.LastIndexFunc(name, unicode.IsUpper)
+	if i <= 0 {
+		return name, ""
+	}
+	return name[:i], name[i:]
+}
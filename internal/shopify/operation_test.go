@@ -0,0 +1,51 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_recordsOperations(t *testing.T) {
+	responses := []string{
+		`{"data":{"metafieldDefinitionCreate":{"createdDefinition":{"id":"gid://shopify/MetafieldDefinition/1"},"userErrors":[]}}}`,
+		`{"data":{"metafieldDefinitionDelete":{"deletedDefinitionId":null,"userErrors":[{"field":["id"],"message":"Definition not found"}]}}}`,
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(responses[0]))
+		responses = responses[1:]
+	})
+	var operations []Operation
+	client.config.OnOperation = func(op Operation) {
+		operations = append(operations, op)
+	}
+
+	_, _ = client.CreateMetafieldDefinition(context.Background(), &MetafieldDefinitionInput{Name: "Color", OwnerType: "PRODUCT", Namespace: "custom", Key: "color", Type: "single_line_text_field"})
+	_ = client.DeleteMetafieldDefinition(context.Background(), "gid://shopify/MetafieldDefinition/2")
+
+	if len(operations) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(operations))
+	}
+	if op := operations[0]; op.Resource != "metafieldDefinition" || op.Action != "Create" || op.ID != "gid://shopify/MetafieldDefinition/1" || op.Err != nil {
+		t.Errorf("unexpected create operation: %+v", op)
+	}
+	if op := operations[1]; op.Resource != "metafieldDefinition" || op.Action != "Delete" || op.ID != "gid://shopify/MetafieldDefinition/2" || op.Err == nil {
+		t.Errorf("unexpected delete operation: %+v", op)
+	}
+}
+
+func TestSplitMutationName(t *testing.T) {
+	tests := []struct {
+		name, resource, action string
+	}{
+		{name: "metafieldDefinitionCreate", resource: "metafieldDefinition", action: "Create"},
+		{name: "metafieldsSet", resource: "metafields", action: "Set"},
+		{name: "deliveryProfileRemove", resource: "deliveryProfile", action: "Remove"},
+		{name: "unknown", resource: "unknown", action: ""},
+	}
+	for _, tt := range tests {
+		if resource, action := splitMutationName(tt.name); resource != tt.resource || action != tt.action {
+			t.Errorf("splitMutationName(%q) = %q, %q", tt.name, resource, action)
+		}
+	}
+}
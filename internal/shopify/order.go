@@ -0,0 +1,129 @@
+package shopify
+
+import (
+	"context"
+)
+
+type Order struct {
+	ID   string   `json:"id"`
+	Tags []string `json:"tags"`
+	Note *string  `json:"note"`
+}
+
+type GetOrderResponse struct {
+	Order *Order `json:"order"`
+}
+
+func (c *Client) GetOrder(ctx context.Context, id string) (*Order, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query order($id: ID!) {
+  order(id: $id) {
+    id
+    tags
+    note
+  }
+}
+`
+
+	var gqlResp GetOrderResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Order, nil
+}
+
+type OrderInput struct {
+	ID   string  `json:"id"`
+	Note *string `json:"note"`
+}
+
+type UpdateOrderResponse struct {
+	OrderUpdate struct {
+		Order      *Order     `json:"order"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"orderUpdate"`
+}
+
+func (c *Client) UpdateOrder(ctx context.Context, input *OrderInput) (*Order, error) {
+	variables := map[string]interface{}{"input": input}
+	query := `
+mutation UpdateOrder($input: OrderInput!) {
+  orderUpdate(input: $input) {
+    order {
+      id
+      tags
+      note
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp UpdateOrderResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.OrderUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.OrderUpdate.Order, nil
+}
+
+type AddTagsResponse struct {
+	TagsAdd struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"tagsAdd"`
+}
+
+// AddTags adds the tags to the taggable resource, e.g. an order, keeping its other tags.
+func (c *Client) AddTags(ctx context.Context, id string, tags []string) error {
+	variables := map[string]interface{}{"id": id, "tags": tags}
+	query := `
+mutation AddTags($id: ID!, $tags: [String!]!) {
+  tagsAdd(id: $id, tags: $tags) {
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp AddTagsResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.TagsAdd.UserErrors.Error()
+}
+
+type RemoveTagsResponse struct {
+	TagsRemove struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"tagsRemove"`
+}
+
+// RemoveTags removes the tags from the taggable resource, e.g. an order, keeping its other tags.
+func (c *Client) RemoveTags(ctx context.Context, id string, tags []string) error {
+	variables := map[string]interface{}{"id": id, "tags": tags}
+	query := `
+mutation RemoveTags($id: ID!, $tags: [String!]!) {
+  tagsRemove(id: $id, tags: $tags) {
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp RemoveTagsResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.TagsRemove.UserErrors.Error()
+}
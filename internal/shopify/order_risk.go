@@ -0,0 +1,64 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// GetOrderRisk returns the risk of the order, or nil if it doesn't exist.
+func (c *Client) GetOrderRisk(ctx context.Context, orderID, riskID uint64) (*goshopify.OrderRisk, error) {
+	risk, err := c.shopifyClient.OrderRisk.Get(ctx, orderID, riskID, nil)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("orders/%d/risks/%d.json", orderID, riskID))
+	if err != nil {
+		var responseErr goshopify.ResponseError
+		if errors.As(err, &responseErr) && responseErr.Status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return risk, nil
+}
+
+func (c *Client) CreateOrderRisk(ctx context.Context, orderID uint64, risk goshopify.OrderRisk) (*goshopify.OrderRisk, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	createdRisk, err := c.shopifyClient.OrderRisk.Create(ctx, orderID, risk)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("orders/%d/risks.json", orderID))
+	var id uint64
+	if createdRisk != nil {
+		id = createdRisk.Id
+	}
+	c.recordREST("orderRisk", "Create", "OrderRisk", id, err)
+	return createdRisk, wrapAmbiguousCreateError("order risk create", wrapRESTAccessDeniedError("orderRisk", "Create", err))
+}
+
+func (c *Client) UpdateOrderRisk(ctx context.Context, orderID uint64, risk goshopify.OrderRisk) (*goshopify.OrderRisk, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	updatedRisk, err := c.shopifyClient.OrderRisk.Update(ctx, orderID, risk.Id, risk)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("orders/%d/risks/%d.json", orderID, risk.Id))
+	c.recordREST("orderRisk", "Update", "OrderRisk", risk.Id, err)
+	return updatedRisk, wrapRESTAccessDeniedError("orderRisk", "Update", err)
+}
+
+func (c *Client) DeleteOrderRisk(ctx context.Context, orderID, riskID uint64) error {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	err = c.shopifyClient.OrderRisk.Delete(ctx, orderID, riskID)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("orders/%d/risks/%d.json", orderID, riskID))
+	c.recordREST("orderRisk", "Delete", "OrderRisk", riskID, err)
+	return wrapRESTAccessDeniedError("orderRisk", "Delete", err)
+}
@@ -1,7 +1,110 @@
 package shopify
 
-import goshopify "github.com/bold-commerce/go-shopify/v4"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
 
-func (c *Client) Page() goshopify.PageService {
-	return c.shopifyClient.Page
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// GetPage returns the page, or nil if it doesn't exist.
+func (c *Client) GetPage(ctx context.Context, id uint64) (*goshopify.Page, error) {
+	page, err := c.shopifyClient.Page.Get(ctx, id, nil)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("pages/%d.json", id))
+	if err != nil {
+		var responseErr goshopify.ResponseError
+		if errors.As(err, &responseErr) && responseErr.Status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return page, nil
+}
+
+// CreatePage creates the page. The errors of the rejected pages are ValidationError,
+// the ones that don't tell whether the page has been created are AmbiguousMutationError.
+func (c *Client) CreatePage(ctx context.Context, page goshopify.Page) (*goshopify.Page, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	createdPage, err := c.shopifyClient.Page.Create(ctx, page)
+	c.observeRawRESTCallLimit(ctx, "pages.json")
+	var id uint64
+	if createdPage != nil {
+		id = createdPage.Id
+	}
+	c.recordREST("page", "Create", "Page", id, err)
+	err = wrapRESTAccessDeniedError("page", "Create", err)
+	if err != nil {
+		return nil, wrapValidationError(wrapAmbiguousCreateError("page create", err))
+	}
+	return createdPage, nil
+}
+
+// UpdatePage updates the page. The errors of the rejected pages are ValidationError.
+func (c *Client) UpdatePage(ctx context.Context, page goshopify.Page) (*goshopify.Page, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	updatedPage, err := c.shopifyClient.Page.Update(ctx, page)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("pages/%d.json", page.Id))
+	c.recordREST("page", "Update", "Page", page.Id, err)
+	err = wrapRESTAccessDeniedError("page", "Update", err)
+	if err != nil {
+		return nil, wrapValidationError(err)
+	}
+	return updatedPage, nil
+}
+
+func (c *Client) DeletePage(ctx context.Context, id uint64) error {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	err = c.shopifyClient.Page.Delete(ctx, id)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("pages/%d.json", id))
+	c.recordREST("page", "Delete", "Page", id, err)
+	return wrapRESTAccessDeniedError("page", "Delete", err)
+}
+
+// PageListFilter filters the pages listed by ListAllPages. The empty fields don't filter.
+type PageListFilter struct {
+	Handle string `url:"handle,omitempty"`
+	Title  string `url:"title,omitempty"`
+	// PublishedStatus is one of published, unpublished and any, which is the default.
+	PublishedStatus string `url:"published_status,omitempty"`
+}
+
+// pageListLimit is the maximum number of pages per request allowed by Shopify.
+const pageListLimit = 250
+
+// ListAllPages returns every page matching the filter, following the pagination of the Link header.
+func (c *Client) ListAllPages(ctx context.Context, filter PageListFilter) ([]goshopify.Page, error) {
+	// The filters are only allowed on the first request, the next ones carry the page_info cursor and the limit only
+	var options interface{} = struct {
+		PageListFilter
+		Limit int `url:"limit"`
+	}{PageListFilter: filter, Limit: pageListLimit}
+
+	var pages []goshopify.Page
+	for {
+		var resource goshopify.PagesResource
+		pagination, err := c.shopifyClient.ListWithPagination(ctx, "pages.json", &resource, options)
+		c.observeRawRESTCallLimit(ctx, "pages.json")
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, resource.Pages...)
+		if pagination.NextPageOptions == nil {
+			return pages, nil
+		}
+		options = pagination.NextPageOptions
+	}
 }
@@ -0,0 +1,95 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+func TestCreatePage_validationError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":{"handle":["has already been taken"],"title":["can't be blank"]}}`))
+	})
+
+	_, err := client.CreatePage(context.Background(), goshopify.Page{Handle: "about"})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	want := map[string][]string{
+		"handle": {"has already been taken"},
+		"title":  {"can't be blank"},
+	}
+	if !reflect.DeepEqual(validationErr.FieldErrors, want) {
+		t.Errorf("unexpected field errors: %v", validationErr.FieldErrors)
+	}
+	if err.Error() != "handle: has already been taken\ntitle: can't be blank" {
+		t.Errorf("unexpected message: %s", err)
+	}
+}
+
+func TestCreatePage_otherError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":"Not Found"}`))
+	})
+
+	_, err := client.CreatePage(context.Background(), goshopify.Page{Handle: "about"})
+	var validationErr *ValidationError
+	if err == nil || errors.As(err, &validationErr) {
+		t.Fatalf("expected a plain error, got %v", err)
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	var queries []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("page_info") == "" {
+			w.Header().Set("Link", `<https://test.myshopify.com/admin/api/2024-07/pages.json?limit=250&page_info=next-cursor>; rel="next"`)
+			_, _ = w.Write([]byte(`{"pages":[{"id":1,"handle":"about"},{"id":2,"handle":"contact"}]}`))
+			return
+		}
+		w.Header().Set("Link", `<https://test.myshopify.com/admin/api/2024-07/pages.json?limit=250&page_info=prev-cursor>; rel="previous"`)
+		_, _ = w.Write([]byte(`{"pages":[{"id":3,"handle":"faq"}]}`))
+	})
+
+	pages, err := client.ListAllPages(context.Background(), PageListFilter{PublishedStatus: "published"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []uint64
+	for _, page := range pages {
+		ids = append(ids, page.Id)
+	}
+	if !reflect.DeepEqual(ids, []uint64{1, 2, 3}) {
+		t.Errorf("unexpected pages: %v", ids)
+	}
+	// The filters are only sent on the first request, the cursor carries them afterwards
+	want := []string{"limit=250&published_status=published", "limit=250&page_info=next-cursor"}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("unexpected queries: %v", queries)
+	}
+}
+
+func TestGetPage_notFound(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":"Not Found"}`))
+	})
+
+	page, err := client.GetPage(context.Background(), 1)
+	if err != nil || page != nil {
+		t.Errorf("expected no page, got %+v, %v", page, err)
+	}
+	if requests != 1 {
+		t.Errorf("unexpected number of requests: %d", requests)
+	}
+}
@@ -0,0 +1,30 @@
+package shopify
+
+import (
+	"context"
+	"time"
+)
+
+// pollUntil calls fn every interval until it reports done or returns an error.
+// It returns ctx.Err() as soon as the context is cancelled, so that interrupting
+// terraform doesn't have to wait for a long-running operation to finish.
+func pollUntil(ctx context.Context, interval time.Duration, fn func(ctx context.Context) (bool, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
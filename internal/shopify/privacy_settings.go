@@ -0,0 +1,101 @@
+package shopify
+
+import (
+	"context"
+)
+
+// The privacy features of the shop, which can be disabled through the API but only enabled in the Shopify admin.
+const (
+	PrivacyFeatureCookieBanner       = "COOKIE_BANNER"
+	PrivacyFeatureDataSaleOptOutPage = "DATA_SALE_OPT_OUT_PAGE"
+	PrivacyFeaturePrivacyPolicy      = "PRIVACY_POLICY"
+)
+
+type PrivacySettings struct {
+	// ShopID is the ID of the shop the settings belong to.
+	ShopID             string                     `json:"-"`
+	Banner             *CookieBanner              `json:"banner"`
+	DataSaleOptOutPage *AutoManagedPrivacyFeature `json:"dataSaleOptOutPage"`
+	PrivacyPolicy      *AutoManagedPrivacyFeature `json:"privacyPolicy"`
+}
+
+// CookieBanner is the banner asking the visitors of the online store for their consent to the cookies.
+type CookieBanner struct {
+	Enabled     bool `json:"enabled"`
+	AutoManaged bool `json:"autoManaged"`
+}
+
+// AutoManagedPrivacyFeature is a privacy feature Shopify manages unless it's disabled.
+type AutoManagedPrivacyFeature struct {
+	AutoManaged bool `json:"autoManaged"`
+}
+
+type GetPrivacySettingsResponse struct {
+	Shop struct {
+		ID string `json:"id"`
+	} `json:"shop"`
+	PrivacySettings *PrivacySettings `json:"privacySettings"`
+}
+
+func (c *Client) GetPrivacySettings(ctx context.Context) (*PrivacySettings, error) {
+	query := `
+query privacySettings {
+  shop {
+    id
+  }
+  privacySettings {
+    banner {
+      enabled
+      autoManaged
+    }
+    dataSaleOptOutPage {
+      autoManaged
+    }
+    privacyPolicy {
+      autoManaged
+    }
+  }
+}
+`
+
+	var gqlResp GetPrivacySettingsResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	settings := gqlResp.PrivacySettings
+	if settings == nil {
+		settings = &PrivacySettings{}
+	}
+	settings.ShopID = gqlResp.Shop.ID
+	return settings, nil
+}
+
+type DisablePrivacyFeaturesResponse struct {
+	PrivacyFeaturesDisable struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"privacyFeaturesDisable"`
+}
+
+// DisablePrivacyFeatures disables the privacy features, e.g. PrivacyFeatureCookieBanner.
+func (c *Client) DisablePrivacyFeatures(ctx context.Context, features []string) error {
+	variables := map[string]interface{}{"featuresToDisable": features}
+	query := `
+mutation privacyFeaturesDisable($featuresToDisable: [PrivacyFeaturesEnum!]!) {
+  privacyFeaturesDisable(featuresToDisable: $featuresToDisable) {
+    featuresDisabled
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp DisablePrivacyFeaturesResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.PrivacyFeaturesDisable.UserErrors.Error()
+}
@@ -0,0 +1,199 @@
+package shopify
+
+import (
+	"context"
+)
+
+// ProductOption is an option of a product, e.g. Size or Color, whose values make the variants of the product.
+type ProductOption struct {
+	ID           string                `json:"id"`
+	Name         string                `json:"name"`
+	Position     int64                 `json:"position"`
+	OptionValues []*ProductOptionValue `json:"optionValues"`
+}
+
+type ProductOptionValue struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// HasVariants is whether any variant of the product uses the value.
+	HasVariants bool `json:"hasVariants"`
+}
+
+type OptionCreateInput struct {
+	Name     string                    `json:"name"`
+	Position *int64                    `json:"position,omitempty"`
+	Values   []*OptionValueCreateInput `json:"values"`
+}
+
+type OptionValueCreateInput struct {
+	Name string `json:"name"`
+}
+
+type OptionUpdateInput struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Position *int64 `json:"position,omitempty"`
+}
+
+const productOptionsFields = `
+      options {
+        id
+        name
+        position
+        optionValues {
+          id
+          name
+          hasVariants
+        }
+      }`
+
+type ProductOptionsResponse struct {
+	Product *struct {
+		Options []*ProductOption `json:"options"`
+	} `json:"product"`
+}
+
+// GetProductOption returns the option of the product, or nil if the product or the option doesn't exist.
+func (c *Client) GetProductOption(ctx context.Context, productID, optionID string) (*ProductOption, error) {
+	variables := map[string]interface{}{"id": productID}
+	query := `
+query productOptions($id: ID!) {
+  product(id: $id) {` + productOptionsFields + `
+  }
+}
+`
+
+	var gqlResp ProductOptionsResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Product == nil {
+		return nil, nil
+	}
+	return findProductOption(gqlResp.Product.Options, func(option *ProductOption) bool { return option.ID == optionID }), nil
+}
+
+type CreateProductOptionsResponse struct {
+	ProductOptionsCreate struct {
+		Product *struct {
+			Options []*ProductOption `json:"options"`
+		} `json:"product"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"productOptionsCreate"`
+}
+
+// CreateProductOption creates the option of the product and returns it.
+func (c *Client) CreateProductOption(ctx context.Context, productID string, input *OptionCreateInput) (*ProductOption, error) {
+	variables := map[string]interface{}{"productId": productID, "options": []*OptionCreateInput{input}}
+	query := `
+mutation productOptionsCreate($productId: ID!, $options: [OptionCreateInput!]!) {
+  productOptionsCreate(productId: $productId, options: $options) {
+    product {` + productOptionsFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp CreateProductOptionsResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ProductOptionsCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	if gqlResp.ProductOptionsCreate.Product == nil {
+		return nil, nil
+	}
+	// The option names are unique in a product
+	return findProductOption(gqlResp.ProductOptionsCreate.Product.Options, func(option *ProductOption) bool { return option.Name == input.Name }), nil
+}
+
+type UpdateProductOptionResponse struct {
+	ProductOptionUpdate struct {
+		Product *struct {
+			Options []*ProductOption `json:"options"`
+		} `json:"product"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"productOptionUpdate"`
+}
+
+// UpdateProductOption updates the option of the product, adding and deleting the option values, and returns it.
+func (c *Client) UpdateProductOption(ctx context.Context, productID string, input *OptionUpdateInput, valuesToAdd []*OptionValueCreateInput, valuesToDelete []string) (*ProductOption, error) {
+	variables := map[string]interface{}{
+		"productId":            productID,
+		"option":               input,
+		"optionValuesToAdd":    valuesToAdd,
+		"optionValuesToDelete": valuesToDelete,
+	}
+	query := `
+mutation productOptionUpdate($productId: ID!, $option: OptionUpdateInput!, $optionValuesToAdd: [OptionValueCreateInput!], $optionValuesToDelete: [ID!]) {
+  productOptionUpdate(productId: $productId, option: $option, optionValuesToAdd: $optionValuesToAdd, optionValuesToDelete: $optionValuesToDelete) {
+    product {` + productOptionsFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp UpdateProductOptionResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ProductOptionUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	if gqlResp.ProductOptionUpdate.Product == nil {
+		return nil, nil
+	}
+	return findProductOption(gqlResp.ProductOptionUpdate.Product.Options, func(option *ProductOption) bool { return option.ID == input.ID }), nil
+}
+
+type DeleteProductOptionsResponse struct {
+	ProductOptionsDelete struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"productOptionsDelete"`
+}
+
+// DeleteProductOption deletes the option of the product.
+func (c *Client) DeleteProductOption(ctx context.Context, productID, optionID string) error {
+	variables := map[string]interface{}{"productId": productID, "options": []string{optionID}}
+	query := `
+mutation productOptionsDelete($productId: ID!, $options: [ID!]!) {
+  productOptionsDelete(productId: $productId, options: $options) {
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp DeleteProductOptionsResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.ProductOptionsDelete.UserErrors.Error()
+}
+
+func findProductOption(options []*ProductOption, f func(option *ProductOption) bool) *ProductOption {
+	for _, option := range options {
+		if f(option) {
+			return option
+		}
+	}
+	return nil
+}
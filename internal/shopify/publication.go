@@ -0,0 +1,122 @@
+package shopify
+
+import (
+	"context"
+	"time"
+)
+
+// ResourcePublication is the publication of a publishable resource, e.g. a product or a collection, to a sales channel.
+type ResourcePublication struct {
+	Publication struct {
+		ID string `json:"id"`
+	} `json:"publication"`
+	PublishDate *time.Time `json:"publishDate"`
+	IsPublished bool       `json:"isPublished"`
+}
+
+type PublicationInput struct {
+	PublicationID string     `json:"publicationId"`
+	PublishDate   *time.Time `json:"publishDate,omitempty"`
+}
+
+type GetResourcePublicationsResponse struct {
+	Node *struct {
+		ID                     string `json:"id"`
+		ResourcePublicationsV2 struct {
+			Nodes []*ResourcePublication `json:"nodes"`
+		} `json:"resourcePublicationsV2"`
+	} `json:"node"`
+}
+
+// GetResourcePublication returns the publication of the publishable resource to the publication,
+// or nil if the resource doesn't exist or isn't published nor scheduled to be published to it.
+func (c *Client) GetResourcePublication(ctx context.Context, publishableID, publicationID string) (*ResourcePublication, error) {
+	variables := map[string]interface{}{"id": publishableID}
+	query := `
+query resourcePublications($id: ID!) {
+  node(id: $id) {
+    id
+    ... on Publishable {
+      resourcePublicationsV2(first: 250, onlyPublished: false) {
+        nodes {
+          publication {
+            id
+          }
+          publishDate
+          isPublished
+        }
+      }
+    }
+  }
+}
+`
+
+	var gqlResp GetResourcePublicationsResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if gqlResp.Node == nil {
+		return nil, nil
+	}
+	for _, resourcePublication := range gqlResp.Node.ResourcePublicationsV2.Nodes {
+		if resourcePublication.Publication.ID == publicationID {
+			return resourcePublication, nil
+		}
+	}
+	return nil, nil
+}
+
+type PublishablePublishResponse struct {
+	PublishablePublish struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"publishablePublish"`
+}
+
+// PublishablePublish publishes the publishable resource, e.g. a product or a collection, to the publications.
+func (c *Client) PublishablePublish(ctx context.Context, id string, input []*PublicationInput) error {
+	variables := map[string]interface{}{"id": id, "input": input}
+	query := `
+mutation PublishablePublish($id: ID!, $input: [PublicationInput!]!) {
+  publishablePublish(id: $id, input: $input) {
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp PublishablePublishResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.PublishablePublish.UserErrors.Error()
+}
+
+type PublishableUnpublishResponse struct {
+	PublishableUnpublish struct {
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"publishableUnpublish"`
+}
+
+// PublishableUnpublish unpublishes the publishable resource, e.g. a product or a collection, from the publications.
+func (c *Client) PublishableUnpublish(ctx context.Context, id string, input []*PublicationInput) error {
+	variables := map[string]interface{}{"id": id, "input": input}
+	query := `
+mutation PublishableUnpublish($id: ID!, $input: [PublicationInput!]!) {
+  publishableUnpublish(id: $id, input: $input) {
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+	var gqlResp PublishableUnpublishResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.PublishableUnpublish.UserErrors.Error()
+}
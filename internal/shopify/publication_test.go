@@ -0,0 +1,32 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetResourcePublication(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"node":{"id":"gid://shopify/Collection/1","resourcePublicationsV2":{"nodes":[` +
+			`{"publication":{"id":"gid://shopify/Publication/1"},"publishDate":"2024-01-01T00:00:00Z","isPublished":true},` +
+			`{"publication":{"id":"gid://shopify/Publication/2"},"publishDate":"2099-01-01T00:00:00Z","isPublished":false}]}}}}`))
+	})
+	ctx := context.Background()
+
+	resourcePublication, err := client.GetResourcePublication(ctx, "gid://shopify/Collection/1", "gid://shopify/Publication/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resourcePublication == nil || resourcePublication.IsPublished || resourcePublication.PublishDate.Year() != 2099 {
+		t.Errorf("unexpected resource publication: %+v", resourcePublication)
+	}
+
+	resourcePublication, err = client.GetResourcePublication(ctx, "gid://shopify/Collection/1", "gid://shopify/Publication/3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resourcePublication != nil {
+		t.Errorf("expected no resource publication, got %+v", resourcePublication)
+	}
+}
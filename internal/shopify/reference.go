@@ -0,0 +1,260 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/zero-clor/terraform-provider-shopify/internal/utils"
+)
+
+// The references to metaobject definitions and metaobjects are stored and returned by Shopify as GIDs,
+// but they can also be written by their stable names: a metaobject definition by its type, e.g. `author`,
+// and a metaobject by its type and its handle separated by a slash, e.g. `author/jane-doe`.
+// The names are resolved to GIDs before being sent, and the values read are rewritten to the names they were written with,
+// so that the plans stay stable whichever form the configuration uses.
+
+// gidPrefix prefixes every GID, e.g. gid://shopify/Metaobject/1.
+const gidPrefix = "gid://"
+
+// referenceValidations are the validations whose values refer to metaobject definitions,
+// with whether the value is a JSON list of references.
+var referenceValidations = map[string]bool{
+	"metaobject_definition_id":  false,
+	"metaobject_definition_ids": true,
+}
+
+// metaobjectReferenceTypes are the metafield types whose values refer to metaobjects,
+// with whether the value is a JSON list of references.
+var metaobjectReferenceTypes = map[string]bool{
+	"metaobject_reference":      false,
+	"mixed_reference":           false,
+	"list.metaobject_reference": true,
+	"list.mixed_reference":      true,
+}
+
+// IsGID returns whether the reference is a GID rather than a name.
+func IsGID(reference string) bool {
+	return strings.HasPrefix(reference, gidPrefix)
+}
+
+// ResolveMetaobjectGID returns the GID of the metaobject of the type with the handle, or an empty string if it doesn't exist.
+func (c *Client) ResolveMetaobjectGID(ctx context.Context, metaobjectType, handle string) (string, error) {
+	query := `
+query metaobjectByHandle($handle: MetaobjectHandleInput!) {
+  metaobjectByHandle(handle: $handle) {
+    id
+  }
+}
+`
+
+	var gqlResp struct {
+		MetaobjectByHandle *struct {
+			ID string `json:"id"`
+		} `json:"metaobjectByHandle"`
+	}
+	err := c.query(ctx, query, map[string]interface{}{"handle": &MetaobjectHandleInput{Type: metaobjectType, Handle: handle}}, &gqlResp)
+	if err != nil {
+		return "", err
+	}
+	if gqlResp.MetaobjectByHandle == nil {
+		return "", nil
+	}
+	return gqlResp.MetaobjectByHandle.ID, nil
+}
+
+// resolveMetaobjectDefinitionReference returns the GID of the metaobject definition referred to by its GID or its type.
+func (c *Client) resolveMetaobjectDefinitionReference(ctx context.Context, reference string) (string, error) {
+	if IsGID(reference) {
+		return reference, nil
+	}
+	gid, err := c.resolveDefinitionGID(ctx, reference)
+	if err != nil {
+		return "", err
+	}
+	if gid == "" {
+		return "", fmt.Errorf("no metaobject definition has the type %q", reference)
+	}
+	return gid, nil
+}
+
+// resolveMetaobjectReference returns the GID of the metaobject referred to by its GID or by `<type>/<handle>`.
+func (c *Client) resolveMetaobjectReference(ctx context.Context, reference string) (string, error) {
+	if IsGID(reference) {
+		return reference, nil
+	}
+	metaobjectType, handle, ok := strings.Cut(reference, "/")
+	if !ok || metaobjectType == "" || handle == "" {
+		return "", fmt.Errorf("invalid metaobject reference %q, expected a GID or <type>/<handle>", reference)
+	}
+	gid, err := c.ResolveMetaobjectGID(ctx, metaobjectType, handle)
+	if err != nil {
+		return "", err
+	}
+	if gid == "" {
+		return "", fmt.Errorf("no metaobject of the type %q has the handle %q", metaobjectType, handle)
+	}
+	return gid, nil
+}
+
+// resolveReferences resolves the reference, or each reference of the JSON list if list is true, to its GID.
+// The value is returned as it is if every reference is already a GID, not to change how it's written.
+func resolveReferences(ctx context.Context, value string, list bool, resolve func(context.Context, string) (string, error)) (string, error) {
+	if !list {
+		return resolve(ctx, value)
+	}
+	var references []string
+	if err := json.Unmarshal([]byte(value), &references); err != nil {
+		// Let Shopify report the invalid value
+		return value, nil
+	}
+	resolved := make([]string, len(references))
+	changed := false
+	for i, reference := range references {
+		gid, err := resolve(ctx, reference)
+		if err != nil {
+			return "", err
+		}
+		resolved[i] = gid
+		changed = changed || gid != reference
+	}
+	if !changed {
+		return value, nil
+	}
+	b, err := json.Marshal(resolved)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ResolveValidationReferences returns the validations with the metaobject definitions they refer to by type resolved to GIDs.
+func (c *Client) ResolveValidationReferences(ctx context.Context, validations []*MetafieldDefinitionValidation) ([]*MetafieldDefinitionValidation, error) {
+	// Keep nil validations as they are, as they're sent as null rather than as an empty list
+	if validations == nil {
+		return nil, nil
+	}
+	resolved := make([]*MetafieldDefinitionValidation, len(validations))
+	for i, validation := range validations {
+		resolved[i] = validation
+		list, ok := referenceValidations[validation.Name]
+		if !ok {
+			continue
+		}
+		value, err := resolveReferences(ctx, validation.Value, list, c.resolveMetaobjectDefinitionReference)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve the %s validation: %w", validation.Name, err)
+		}
+		resolved[i] = &MetafieldDefinitionValidation{Name: validation.Name, Value: value}
+	}
+	return resolved, nil
+}
+
+// resolveFieldDefinitionReferences returns copies of the metaobject field definitions to create
+// with their validation references resolved.
+func (c *Client) resolveFieldDefinitionReferences(ctx context.Context, fieldDefinitions []*MetaobjectFieldDefinitionCreateInput) ([]*MetaobjectFieldDefinitionCreateInput, error) {
+	resolved := make([]*MetaobjectFieldDefinitionCreateInput, len(fieldDefinitions))
+	for i, fieldDefinition := range fieldDefinitions {
+		validations, err := c.ResolveValidationReferences(ctx, fieldDefinition.Validations)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", fieldDefinition.Key, err)
+		}
+		clone := *fieldDefinition
+		clone.Validations = validations
+		resolved[i] = &clone
+	}
+	return resolved, nil
+}
+
+// resolveFieldDefinitionOperationReferences returns copies of the operations on metaobject field definitions
+// with the validation references of the created and updated fields resolved.
+func (c *Client) resolveFieldDefinitionOperationReferences(ctx context.Context, operations []*MetaobjectFieldDefinitionOperationInput) ([]*MetaobjectFieldDefinitionOperationInput, error) {
+	if operations == nil {
+		return nil, nil
+	}
+	resolved := make([]*MetaobjectFieldDefinitionOperationInput, len(operations))
+	for i, operation := range operations {
+		clone := *operation
+		if operation.Create != nil {
+			created, err := c.resolveFieldDefinitionReferences(ctx, []*MetaobjectFieldDefinitionCreateInput{operation.Create})
+			if err != nil {
+				return nil, err
+			}
+			clone.Create = created[0]
+		}
+		if operation.Update != nil {
+			validations, err := c.ResolveValidationReferences(ctx, operation.Update.Validations)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", operation.Update.Key, err)
+			}
+			update := *operation.Update
+			update.Validations = validations
+			clone.Update = &update
+		}
+		resolved[i] = &clone
+	}
+	return resolved, nil
+}
+
+// ResolveMetafieldReferences returns the value of the metafield type with the metaobjects it refers to by handle resolved to GIDs.
+func (c *Client) ResolveMetafieldReferences(ctx context.Context, metafieldType, value string) (string, error) {
+	list, ok := metaobjectReferenceTypes[CanonicalMetafieldType(metafieldType)]
+	if !ok {
+		return value, nil
+	}
+	resolved, err := resolveReferences(ctx, value, list, c.resolveMetaobjectReference)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve the metafield value: %w", err)
+	}
+	return resolved, nil
+}
+
+// KeepWrittenValidationReferences returns the validations read with the values of the reference validations replaced
+// by the written ones, when the written ones refer to the same metaobject definitions by type.
+func (c *Client) KeepWrittenValidationReferences(ctx context.Context, validations, written []*MetafieldDefinitionValidation) []*MetafieldDefinitionValidation {
+	writtenValues := make(map[string]string, len(written))
+	for _, validation := range written {
+		writtenValues[validation.Name] = validation.Value
+	}
+	kept := make([]*MetafieldDefinitionValidation, len(validations))
+	for i, validation := range validations {
+		kept[i] = validation
+		list, ok := referenceValidations[validation.Name]
+		writtenValue, found := writtenValues[validation.Name]
+		if !ok || !found || writtenValue == validation.Value {
+			continue
+		}
+		if sameReferences(ctx, validation.Value, writtenValue, list, c.resolveMetaobjectDefinitionReference) {
+			kept[i] = &MetafieldDefinitionValidation{Name: validation.Name, Value: writtenValue}
+		}
+	}
+	return kept
+}
+
+// KeepWrittenMetafieldReferences returns the written value of the metafield type in place of the value read,
+// when the written one refers to the same metaobjects by handle, otherwise the value read.
+func (c *Client) KeepWrittenMetafieldReferences(ctx context.Context, metafieldType, value, written string) string {
+	list, ok := metaobjectReferenceTypes[CanonicalMetafieldType(metafieldType)]
+	if !ok || written == value {
+		return value
+	}
+	if sameReferences(ctx, value, written, list, c.resolveMetaobjectReference) {
+		return written
+	}
+	return value
+}
+
+// sameReferences returns whether the written value resolves to the GIDs of the value read.
+// A written value which can't be resolved, e.g. because the object has been deleted, differs.
+func sameReferences(ctx context.Context, value, written string, list bool, resolve func(context.Context, string) (string, error)) bool {
+	resolved, err := resolveReferences(ctx, written, list, resolve)
+	if err != nil {
+		return false
+	}
+	if !list {
+		return resolved == value
+	}
+	equal, err := utils.JSONEqual(resolved, value)
+	return err == nil && equal
+}
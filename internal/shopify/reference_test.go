@@ -0,0 +1,156 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// newReferenceTestClient returns a client resolving the author definition to gid://shopify/MetaobjectDefinition/1,
+// and the authors jane and john to gid://shopify/Metaobject/1 and 2, where the other definitions and handles don't exist.
+func newReferenceTestClient(t *testing.T) *Client {
+	t.Helper()
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				Type   string                 `json:"type"`
+				Handle *MetaobjectHandleInput `json:"handle"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case body.Variables.Handle != nil:
+			handles := map[string]string{"author/jane": "gid://shopify/Metaobject/1", "author/john": "gid://shopify/Metaobject/2"}
+			gid, ok := handles[body.Variables.Handle.Type+"/"+body.Variables.Handle.Handle]
+			if !ok {
+				_, _ = w.Write([]byte(`{"data":{"metaobjectByHandle":null}}`))
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"data":{"metaobjectByHandle":{"id":%q}}}`, gid)
+		case body.Variables.Type == "author":
+			_, _ = w.Write([]byte(`{"data":{"metaobjectDefinitionByType":{"id":"gid://shopify/MetaobjectDefinition/1"}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{"metaobjectDefinitionByType":null}}`))
+		}
+	})
+}
+
+func TestResolveMetafieldReferences(t *testing.T) {
+	client := newReferenceTestClient(t)
+
+	for _, tt := range []struct {
+		metafieldType string
+		value         string
+		want          string
+		wantErr       bool
+	}{
+		{metafieldType: "metaobject_reference", value: "author/jane", want: "gid://shopify/Metaobject/1"},
+		{metafieldType: "metaobject_reference", value: "gid://shopify/Metaobject/1", want: "gid://shopify/Metaobject/1"},
+		{metafieldType: "list.metaobject_reference", value: `["author/jane","gid://shopify/Metaobject/2"]`, want: `["gid://shopify/Metaobject/1","gid://shopify/Metaobject/2"]`},
+		{metafieldType: "list.metaobject_reference", value: `["gid://shopify/Metaobject/1", "gid://shopify/Metaobject/2"]`, want: `["gid://shopify/Metaobject/1", "gid://shopify/Metaobject/2"]`},
+		{metafieldType: "single_line_text_field", value: "author/jane", want: "author/jane"},
+		{metafieldType: "metaobject_reference", value: "author/unknown", wantErr: true},
+		{metafieldType: "metaobject_reference", value: "jane", wantErr: true},
+	} {
+		got, err := client.ResolveMetafieldReferences(context.Background(), tt.metafieldType, tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s %s: expected an error, got %s", tt.metafieldType, tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s %s: expected %s, got %s", tt.metafieldType, tt.value, tt.want, got)
+		}
+	}
+}
+
+func TestResolveValidationReferences(t *testing.T) {
+	client := newReferenceTestClient(t)
+
+	validations := []*MetafieldDefinitionValidation{
+		{Name: "metaobject_definition_id", Value: "author"},
+		{Name: "max", Value: "author"},
+	}
+	resolved, err := client.ResolveValidationReferences(context.Background(), validations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved[0].Value != "gid://shopify/MetaobjectDefinition/1" {
+		t.Errorf("expected the type to be resolved, got %s", resolved[0].Value)
+	}
+	if resolved[1].Value != "author" {
+		t.Errorf("expected the other validations to be kept, got %s", resolved[1].Value)
+	}
+	if validations[0].Value != "author" {
+		t.Errorf("expected the validations not to be modified, got %s", validations[0].Value)
+	}
+
+	resolved, err = client.ResolveValidationReferences(context.Background(), []*MetafieldDefinitionValidation{
+		{Name: "metaobject_definition_ids", Value: `["gid://shopify/MetaobjectDefinition/1","author"]`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["gid://shopify/MetaobjectDefinition/1","gid://shopify/MetaobjectDefinition/1"]`; resolved[0].Value != want {
+		t.Errorf("expected %s, got %s", want, resolved[0].Value)
+	}
+
+	if _, err := client.ResolveValidationReferences(context.Background(), []*MetafieldDefinitionValidation{
+		{Name: "metaobject_definition_id", Value: "unknown"},
+	}); err == nil {
+		t.Error("expected an error for an unknown type")
+	}
+}
+
+func TestKeepWrittenMetafieldReferences(t *testing.T) {
+	client := newReferenceTestClient(t)
+
+	for _, tt := range []struct {
+		metafieldType string
+		value         string
+		written       string
+		want          string
+	}{
+		// The same metaobject written by handle or by GID is kept as written
+		{metafieldType: "metaobject_reference", value: "gid://shopify/Metaobject/1", written: "author/jane", want: "author/jane"},
+		{metafieldType: "metaobject_reference", value: "gid://shopify/Metaobject/1", written: "gid://shopify/Metaobject/1", want: "gid://shopify/Metaobject/1"},
+		{metafieldType: "list.metaobject_reference", value: `["gid://shopify/Metaobject/1","gid://shopify/Metaobject/2"]`, written: `["author/jane", "gid://shopify/Metaobject/2"]`, want: `["author/jane", "gid://shopify/Metaobject/2"]`},
+		// A metaobject changed outside of Terraform shows up as a diff
+		{metafieldType: "metaobject_reference", value: "gid://shopify/Metaobject/2", written: "author/jane", want: "gid://shopify/Metaobject/2"},
+		{metafieldType: "list.metaobject_reference", value: `["gid://shopify/Metaobject/2"]`, written: `["author/jane"]`, want: `["gid://shopify/Metaobject/2"]`},
+		// A metaobject deleted since it was written
+		{metafieldType: "metaobject_reference", value: "gid://shopify/Metaobject/1", written: "author/unknown", want: "gid://shopify/Metaobject/1"},
+	} {
+		got := client.KeepWrittenMetafieldReferences(context.Background(), tt.metafieldType, tt.value, tt.written)
+		if got != tt.want {
+			t.Errorf("%s %s written as %s: expected %s, got %s", tt.metafieldType, tt.value, tt.written, tt.want, got)
+		}
+	}
+}
+
+func TestKeepWrittenValidationReferences(t *testing.T) {
+	client := newReferenceTestClient(t)
+
+	read := []*MetafieldDefinitionValidation{{Name: "metaobject_definition_id", Value: "gid://shopify/MetaobjectDefinition/1"}}
+	for _, tt := range []struct {
+		written string
+		want    string
+	}{
+		{written: "author", want: "author"},
+		{written: "gid://shopify/MetaobjectDefinition/1", want: "gid://shopify/MetaobjectDefinition/1"},
+		{written: "book", want: "gid://shopify/MetaobjectDefinition/1"},
+	} {
+		kept := client.KeepWrittenValidationReferences(context.Background(), read, []*MetafieldDefinitionValidation{{Name: "metaobject_definition_id", Value: tt.written}})
+		if kept[0].Value != tt.want {
+			t.Errorf("written as %s: expected %s, got %s", tt.written, tt.want, kept[0].Value)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package shopify
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RESTCallLimit is the usage of the REST API call bucket of the shop, in calls.
+type RESTCallLimit struct {
+	Used       int
+	BucketSize int
+}
+
+// LastRESTCallLimit returns the usage of the REST call bucket returned by the last REST response,
+// shared by the clients of every API version, or nil if no REST request has been sent yet.
+func (c *Client) LastRESTCallLimit() *RESTCallLimit {
+	if c.restCallLimit == nil {
+		return nil
+	}
+	return c.restCallLimit.Load()
+}
+
+// observeRawRESTCallLimit records and logs the usage of the REST call bucket after a request of the raw client,
+// which parses the header itself and doesn't return it.
+func (c *Client) observeRawRESTCallLimit(ctx context.Context, relPath string) {
+	limits := c.shopifyClient.RateLimits
+	if limits.BucketSize <= 0 {
+		return
+	}
+	c.observeRESTCallLimit(ctx, relPath, &RESTCallLimit{Used: limits.RequestCount, BucketSize: limits.BucketSize})
+}
+
+func (c *Client) observeRESTCallLimit(ctx context.Context, relPath string, limit *RESTCallLimit) {
+	if limit == nil {
+		return
+	}
+	if c.restCallLimit != nil {
+		c.restCallLimit.Store(limit)
+	}
+	tflog.Debug(ctx, "Shopify REST API call limit", map[string]interface{}{
+		"path":        relPath,
+		"used":        limit.Used,
+		"bucket_size": limit.BucketSize,
+	})
+}
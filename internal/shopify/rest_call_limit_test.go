@@ -0,0 +1,25 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetPage_restCallLimit(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Shopify-Shop-Api-Call-Limit", "32/40")
+		_, _ = w.Write([]byte(`{"page":{"id":1,"handle":"about"}}`))
+	})
+	if limit := client.LastRESTCallLimit(); limit != nil {
+		t.Fatalf("expected no call limit before any request, got %+v", limit)
+	}
+
+	if _, err := client.GetPage(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if limit := client.LastRESTCallLimit(); !reflect.DeepEqual(limit, &RESTCallLimit{Used: 32, BucketSize: 40}) {
+		t.Errorf("unexpected call limit: %+v", limit)
+	}
+}
@@ -0,0 +1,49 @@
+package shopify
+
+import (
+	"context"
+)
+
+type Shop struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	MyshopifyDomain string `json:"myshopifyDomain"`
+}
+
+type GetShopResponse struct {
+	Shop *Shop `json:"shop"`
+}
+
+func (c *Client) GetShop(ctx context.Context) (*Shop, error) {
+	query := `
+query shop {
+  shop {
+    id
+    name
+    myshopifyDomain
+  }
+}
+`
+
+	var gqlResp GetShopResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Shop, nil
+}
+
+// Ping runs the cheapest query to check the connection to the shop and the credentials.
+// Use IsUnauthorized to tell whether the credentials have been rejected.
+func (c *Client) Ping(ctx context.Context) error {
+	query := `
+query ping {
+  shop {
+    id
+  }
+}
+`
+
+	var gqlResp GetShopResponse
+	return c.query(ctx, query, nil, &gqlResp)
+}
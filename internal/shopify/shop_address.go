@@ -0,0 +1,118 @@
+package shopify
+
+import (
+	"context"
+)
+
+// ShopAddress is the billing address of the shop, which is its address as a business.
+type ShopAddress struct {
+	// ShopID is the ID of the shop the address belongs to.
+	ShopID   string `json:"-"`
+	Address1 string `json:"address1"`
+	Address2 string `json:"address2"`
+	City     string `json:"city"`
+	Zip      string `json:"zip"`
+	Phone    string `json:"phone"`
+	// Province and Country are the names of ProvinceCode and CountryCodeV2, which are the only ones that can be changed.
+	Province      string `json:"province"`
+	ProvinceCode  string `json:"provinceCode"`
+	Country       string `json:"country"`
+	CountryCodeV2 string `json:"countryCodeV2"`
+}
+
+// ShopAddressInput changes the billing address of the shop. The fields missing in the input are left unchanged.
+// The province and the country are set by their codes, their names follow.
+type ShopAddressInput struct {
+	Address1     *string `json:"address1,omitempty"`
+	Address2     *string `json:"address2,omitempty"`
+	City         *string `json:"city,omitempty"`
+	Zip          *string `json:"zip,omitempty"`
+	Phone        *string `json:"phone,omitempty"`
+	ProvinceCode *string `json:"provinceCode,omitempty"`
+	CountryCode  *string `json:"countryCode,omitempty"`
+}
+
+const shopAddressFields = `
+    id
+    billingAddress {
+      address1
+      address2
+      city
+      zip
+      phone
+      province
+      provinceCode
+      country
+      countryCodeV2
+    }`
+
+type shopAddressNode struct {
+	ID             string       `json:"id"`
+	BillingAddress *ShopAddress `json:"billingAddress"`
+}
+
+func (n *shopAddressNode) address() *ShopAddress {
+	address := n.BillingAddress
+	if address == nil {
+		address = &ShopAddress{}
+	}
+	address.ShopID = n.ID
+	return address
+}
+
+type GetShopAddressResponse struct {
+	Shop shopAddressNode `json:"shop"`
+}
+
+func (c *Client) GetShopAddress(ctx context.Context) (*ShopAddress, error) {
+	query := `
+query shopAddress {
+  shop {` + shopAddressFields + `
+  }
+}
+`
+
+	var gqlResp GetShopAddressResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Shop.address(), nil
+}
+
+type UpdateShopAddressResponse struct {
+	ShopUpdate struct {
+		Shop       *shopAddressNode `json:"shop"`
+		UserErrors UserErrors       `json:"userErrors"`
+	} `json:"shopUpdate"`
+}
+
+// UpdateShopAddress updates the billing address of the shop.
+func (c *Client) UpdateShopAddress(ctx context.Context, input *ShopAddressInput) (*ShopAddress, error) {
+	variables := map[string]interface{}{"input": map[string]interface{}{"billingAddress": input}}
+	query := `
+mutation UpdateShopAddress($input: ShopInput!) {
+  shopUpdate(input: $input) {
+    shop {` + shopAddressFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateShopAddressResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ShopUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	if gqlResp.ShopUpdate.Shop == nil {
+		return nil, nil
+	}
+	return gqlResp.ShopUpdate.Shop.address(), nil
+}
@@ -0,0 +1,93 @@
+package shopify
+
+import (
+	"context"
+)
+
+// ShopWeightUnits are the units of weight a shop can use.
+var ShopWeightUnits = []string{"GRAMS", "KILOGRAMS", "OUNCES", "POUNDS"}
+
+type ShopSettings struct {
+	// ShopID is the ID of the shop the settings belong to.
+	ShopID          string `json:"id"`
+	CurrencyFormats struct {
+		MoneyFormat             string `json:"moneyFormat"`
+		MoneyWithCurrencyFormat string `json:"moneyWithCurrencyFormat"`
+	} `json:"currencyFormats"`
+	WeightUnit string `json:"weightUnit"`
+	// IanaTimezone can only be changed in the Shopify admin.
+	IanaTimezone string `json:"ianaTimezone"`
+	// ContactEmail is the email address customers are sent emails from and can reply to.
+	ContactEmail string `json:"contactEmail"`
+}
+
+type ShopSettingsInput struct {
+	MoneyFormat             *string `json:"moneyFormat,omitempty"`
+	MoneyWithCurrencyFormat *string `json:"moneyWithCurrencyFormat,omitempty"`
+	WeightUnit              *string `json:"weightUnit,omitempty"`
+	ContactEmail            *string `json:"contactEmail,omitempty"`
+}
+
+const shopSettingsFields = `
+    id
+    currencyFormats {
+      moneyFormat
+      moneyWithCurrencyFormat
+    }
+    weightUnit
+    ianaTimezone
+    contactEmail`
+
+type GetShopSettingsResponse struct {
+	Shop *ShopSettings `json:"shop"`
+}
+
+func (c *Client) GetShopSettings(ctx context.Context) (*ShopSettings, error) {
+	query := `
+query shopSettings {
+  shop {` + shopSettingsFields + `
+  }
+}
+`
+
+	var gqlResp GetShopSettingsResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Shop, nil
+}
+
+type UpdateShopSettingsResponse struct {
+	ShopUpdate struct {
+		Shop       *ShopSettings `json:"shop"`
+		UserErrors UserErrors    `json:"userErrors"`
+	} `json:"shopUpdate"`
+}
+
+// UpdateShopSettings updates the settings of the shop. The settings missing in the input are left unchanged.
+func (c *Client) UpdateShopSettings(ctx context.Context, input *ShopSettingsInput) (*ShopSettings, error) {
+	variables := map[string]interface{}{"input": input}
+	query := `
+mutation UpdateShopSettings($input: ShopInput!) {
+  shopUpdate(input: $input) {
+    shop {` + shopSettingsFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateShopSettingsResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ShopUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.ShopUpdate.Shop, nil
+}
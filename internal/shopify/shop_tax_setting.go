@@ -0,0 +1,81 @@
+package shopify
+
+import (
+	"context"
+)
+
+type ShopTaxSetting struct {
+	// ShopID is the ID of the shop the settings belong to.
+	ShopID        string `json:"id"`
+	TaxesIncluded bool   `json:"taxesIncluded"`
+	TaxShipping   bool   `json:"taxShipping"`
+	CountyTaxes   bool   `json:"countyTaxes"`
+}
+
+type ShopTaxSettingInput struct {
+	TaxesIncluded *bool `json:"taxesIncluded,omitempty"`
+	TaxShipping   *bool `json:"taxShipping,omitempty"`
+	CountyTaxes   *bool `json:"countyTaxes,omitempty"`
+}
+
+type GetShopTaxSettingResponse struct {
+	Shop *ShopTaxSetting `json:"shop"`
+}
+
+func (c *Client) GetShopTaxSetting(ctx context.Context) (*ShopTaxSetting, error) {
+	query := `
+query shopTaxSetting {
+  shop {
+    id
+    taxesIncluded
+    taxShipping
+    countyTaxes
+  }
+}
+`
+
+	var gqlResp GetShopTaxSettingResponse
+	err := c.query(ctx, query, nil, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Shop, nil
+}
+
+type UpdateShopTaxSettingResponse struct {
+	ShopUpdate struct {
+		Shop       *ShopTaxSetting `json:"shop"`
+		UserErrors UserErrors      `json:"userErrors"`
+	} `json:"shopUpdate"`
+}
+
+// UpdateShopTaxSetting updates the tax settings of the shop. The settings missing in the input are left unchanged.
+func (c *Client) UpdateShopTaxSetting(ctx context.Context, input *ShopTaxSettingInput) (*ShopTaxSetting, error) {
+	variables := map[string]interface{}{"input": input}
+	query := `
+mutation UpdateShopTaxSetting($input: ShopInput!) {
+  shopUpdate(input: $input) {
+    shop {
+      id
+      taxesIncluded
+      taxShipping
+      countyTaxes
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateShopTaxSettingResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ShopUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.ShopUpdate.Shop, nil
+}
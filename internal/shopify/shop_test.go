@@ -0,0 +1,47 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetShop(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/api/2024-07/graphql.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"data":{"shop":{"id":"gid://shopify/Shop/1","name":"Test","myshopifyDomain":"test.myshopify.com"}}}`))
+	})
+
+	shop, err := client.GetShop(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shop.ID != "gid://shopify/Shop/1" {
+		t.Errorf("unexpected shop id: %s", shop.ID)
+	}
+	if shop.MyshopifyDomain != "test.myshopify.com" {
+		t.Errorf("unexpected shop domain: %s", shop.MyshopifyDomain)
+	}
+}
+
+func TestPing(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"shop":{"id":"gid://shopify/Shop/1"}}}`))
+	})
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPingUnauthorized(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`))
+	})
+	err := client.Ping(context.Background())
+	if !IsUnauthorized(err) {
+		t.Errorf("expected an unauthorized error, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	goshopify "github.com/bold-commerce/go-shopify/v4"
+)
+
+// GetSmartCollection returns the smart collection, or nil if it doesn't exist.
+func (c *Client) GetSmartCollection(ctx context.Context, id uint64) (*goshopify.SmartCollection, error) {
+	collection, err := c.shopifyClient.SmartCollection.Get(ctx, id, nil)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("smart_collections/%d.json", id))
+	if err != nil {
+		var responseErr goshopify.ResponseError
+		if errors.As(err, &responseErr) && responseErr.Status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return collection, nil
+}
+
+// CreateSmartCollection creates the smart collection. The errors of the rejected collections are ValidationError,
+// the ones that don't tell whether the collection has been created are AmbiguousMutationError.
+func (c *Client) CreateSmartCollection(ctx context.Context, collection goshopify.SmartCollection) (*goshopify.SmartCollection, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	createdCollection, err := c.shopifyClient.SmartCollection.Create(ctx, collection)
+	c.observeRawRESTCallLimit(ctx, "smart_collections.json")
+	var id uint64
+	if createdCollection != nil {
+		id = createdCollection.Id
+	}
+	c.recordREST("smartCollection", "Create", "Collection", id, err)
+	err = wrapRESTAccessDeniedError("smartCollection", "Create", err)
+	if err != nil {
+		return nil, wrapValidationError(wrapAmbiguousCreateError("smart collection create", err))
+	}
+	return createdCollection, nil
+}
+
+// UpdateSmartCollection updates the smart collection. Every field of the collection is sent,
+// so it should be the collection read with the fields to update changed, not to reset the other ones.
+// The errors of the rejected collections are ValidationError.
+func (c *Client) UpdateSmartCollection(ctx context.Context, collection goshopify.SmartCollection) (*goshopify.SmartCollection, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	updatedCollection, err := c.shopifyClient.SmartCollection.Update(ctx, collection)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("smart_collections/%d.json", collection.Id))
+	c.recordREST("smartCollection", "Update", "Collection", collection.Id, err)
+	err = wrapRESTAccessDeniedError("smartCollection", "Update", err)
+	if err != nil {
+		return nil, wrapValidationError(err)
+	}
+	return updatedCollection, nil
+}
+
+func (c *Client) DeleteSmartCollection(ctx context.Context, id uint64) error {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	err = c.shopifyClient.SmartCollection.Delete(ctx, id)
+	c.observeRawRESTCallLimit(ctx, fmt.Sprintf("smart_collections/%d.json", id))
+	c.recordREST("smartCollection", "Delete", "Collection", id, err)
+	return wrapRESTAccessDeniedError("smartCollection", "Delete", err)
+}
@@ -0,0 +1,113 @@
+package shopify
+
+import (
+	"context"
+)
+
+const (
+	SubscriptionBillingAttemptStatusPending   = "PENDING"
+	SubscriptionBillingAttemptStatusSucceeded = "SUCCEEDED"
+	SubscriptionBillingAttemptStatusFailed    = "FAILED"
+)
+
+type SubscriptionBillingAttempt struct {
+	ID                   string                `json:"id"`
+	IdempotencyKey       string                `json:"idempotencyKey"`
+	Ready                bool                  `json:"ready"`
+	ErrorCode            *string               `json:"errorCode"`
+	ErrorMessage         *string               `json:"errorMessage"`
+	Order                *Order                `json:"order"`
+	SubscriptionContract *SubscriptionContract `json:"subscriptionContract"`
+}
+
+// Status returns the status of the billing attempt derived from its result,
+// as the API has no field for it.
+func (a *SubscriptionBillingAttempt) Status() string {
+	switch {
+	case !a.Ready:
+		return SubscriptionBillingAttemptStatusPending
+	case a.ErrorCode != nil:
+		return SubscriptionBillingAttemptStatusFailed
+	default:
+		return SubscriptionBillingAttemptStatusSucceeded
+	}
+}
+
+type SubscriptionContract struct {
+	ID string `json:"id"`
+}
+
+type SubscriptionBillingAttemptInput struct {
+	IdempotencyKey string `json:"idempotencyKey"`
+}
+
+const subscriptionBillingAttemptFields = `
+id
+idempotencyKey
+ready
+errorCode
+errorMessage
+order {
+  id
+}
+subscriptionContract {
+  id
+}
+`
+
+type GetSubscriptionBillingAttemptResponse struct {
+	SubscriptionBillingAttempt *SubscriptionBillingAttempt `json:"subscriptionBillingAttempt"`
+}
+
+func (c *Client) GetSubscriptionBillingAttempt(ctx context.Context, id string) (*SubscriptionBillingAttempt, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query subscriptionBillingAttempt($id: ID!) {
+  subscriptionBillingAttempt(id: $id) {` + subscriptionBillingAttemptFields + `
+  }
+}
+`
+
+	var gqlResp GetSubscriptionBillingAttemptResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.SubscriptionBillingAttempt, nil
+}
+
+type CreateSubscriptionBillingAttemptResponse struct {
+	SubscriptionBillingAttemptCreate struct {
+		SubscriptionBillingAttempt *SubscriptionBillingAttempt `json:"subscriptionBillingAttempt"`
+		UserErrors                 UserErrors                  `json:"userErrors"`
+	} `json:"subscriptionBillingAttemptCreate"`
+}
+
+func (c *Client) CreateSubscriptionBillingAttempt(ctx context.Context, subscriptionContractID string, input *SubscriptionBillingAttemptInput) (*SubscriptionBillingAttempt, error) {
+	variables := map[string]interface{}{
+		"subscriptionContractId":          subscriptionContractID,
+		"subscriptionBillingAttemptInput": input,
+	}
+	query := `
+mutation subscriptionBillingAttemptCreate($subscriptionContractId: ID!, $subscriptionBillingAttemptInput: SubscriptionBillingAttemptInput!) {
+  subscriptionBillingAttemptCreate(subscriptionContractId: $subscriptionContractId, subscriptionBillingAttemptInput: $subscriptionBillingAttemptInput) {
+    subscriptionBillingAttempt {` + subscriptionBillingAttemptFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp CreateSubscriptionBillingAttemptResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.SubscriptionBillingAttemptCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.SubscriptionBillingAttemptCreate.SubscriptionBillingAttempt, nil
+}
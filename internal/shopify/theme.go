@@ -0,0 +1,77 @@
+package shopify
+
+import (
+	"context"
+)
+
+// ThemeRoleMain is the role of the published theme of the shop.
+const ThemeRoleMain = "MAIN"
+
+type Theme struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Role is the role of the theme, e.g. MAIN for the published one or UNPUBLISHED.
+	Role string `json:"role"`
+}
+
+const themeFields = `
+      id
+      name
+      role`
+
+type GetThemeResponse struct {
+	Theme *Theme `json:"theme"`
+}
+
+// GetTheme returns the theme, or nil if it doesn't exist.
+func (c *Client) GetTheme(ctx context.Context, id string) (*Theme, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query theme($id: ID!) {
+  theme(id: $id) {` + themeFields + `
+  }
+}
+`
+
+	var gqlResp GetThemeResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.Theme, nil
+}
+
+type PublishThemeResponse struct {
+	ThemePublish struct {
+		Theme      *Theme     `json:"theme"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"themePublish"`
+}
+
+// PublishTheme makes the theme the main theme of the shop, which unpublishes the previous main theme.
+func (c *Client) PublishTheme(ctx context.Context, id string) (*Theme, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation themePublish($id: ID!) {
+  themePublish(id: $id) {
+    theme {` + themeFields + `
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}
+`
+
+	var gqlResp PublishThemeResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.ThemePublish.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.ThemePublish.Theme, nil
+}
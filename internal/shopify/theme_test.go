@@ -0,0 +1,32 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetTheme_notFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"theme":null}}`))
+	})
+
+	theme, err := client.GetTheme(context.Background(), "gid://shopify/OnlineStoreTheme/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if theme != nil {
+		t.Errorf("expected no theme, got %+v", theme)
+	}
+}
+
+func TestPublishTheme_userErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"themePublish":{"theme":null,"userErrors":[{"field":["id"],"message":"Theme does not exist","code":"NOT_FOUND"}]}}}`))
+	})
+
+	_, err := client.PublishTheme(context.Background(), "gid://shopify/OnlineStoreTheme/1")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
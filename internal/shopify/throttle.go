@@ -0,0 +1,31 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+)
+
+// ThrottleStatus is the status of the GraphQL cost bucket of the shop, in cost points.
+type ThrottleStatus struct {
+	MaximumAvailable   float64
+	CurrentlyAvailable float64
+	// RestoreRate is the number of points restored per second.
+	RestoreRate float64
+}
+
+// GetThrottleStatus returns the current throttle status of the GraphQL API.
+// It pings the shop first, as the status is only returned along with the response of a query.
+func (c *Client) GetThrottleStatus(ctx context.Context) (*ThrottleStatus, error) {
+	if err := c.Ping(ctx); err != nil {
+		return nil, err
+	}
+	cost := c.shopifyClient.RateLimits.GraphQLCost
+	if cost == nil {
+		return nil, errors.New("the response has no throttle status")
+	}
+	return &ThrottleStatus{
+		MaximumAvailable:   cost.ThrottleStatus.MaximumAvailable,
+		CurrentlyAvailable: cost.ThrottleStatus.CurrentlyAvailable,
+		RestoreRate:        cost.ThrottleStatus.RestoreRate,
+	}, nil
+}
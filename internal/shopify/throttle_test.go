@@ -0,0 +1,22 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetThrottleStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"shop":{"id":"gid://shopify/Shop/1"}},"extensions":{"cost":{"requestedQueryCost":1,"actualQueryCost":1,"throttleStatus":{"maximumAvailable":2000.0,"currentlyAvailable":1999.0,"restoreRate":100.0}}}}`))
+	})
+
+	status, err := client.GetThrottleStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ThrottleStatus{MaximumAvailable: 2000, CurrentlyAvailable: 1999, RestoreRate: 100}
+	if *status != want {
+		t.Errorf("expected %+v, got %+v", want, *status)
+	}
+}
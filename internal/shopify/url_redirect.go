@@ -0,0 +1,143 @@
+package shopify
+
+import (
+	"context"
+)
+
+type URLRedirect struct {
+	ID     string `json:"id"`
+	Path   string `json:"path"`
+	Target string `json:"target"`
+}
+
+type URLRedirectInput struct {
+	Path   string `json:"path"`
+	Target string `json:"target"`
+}
+
+type CreateURLRedirectResponse struct {
+	URLRedirectCreate struct {
+		URLRedirect *URLRedirect `json:"urlRedirect"`
+		UserErrors  UserErrors   `json:"userErrors"`
+	} `json:"urlRedirectCreate"`
+}
+
+func (c *Client) CreateURLRedirect(ctx context.Context, input *URLRedirectInput) (*URLRedirect, error) {
+	variables := map[string]interface{}{"urlRedirect": input}
+	query := `
+mutation urlRedirectCreate($urlRedirect: UrlRedirectInput!) {
+  urlRedirectCreate(urlRedirect: $urlRedirect) {
+    urlRedirect {
+      id
+      path
+      target
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp CreateURLRedirectResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.URLRedirectCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.URLRedirectCreate.URLRedirect, nil
+}
+
+type GetURLRedirectResponse struct {
+	URLRedirect *URLRedirect `json:"urlRedirect"`
+}
+
+// GetURLRedirect returns the URL redirect, or nil if it doesn't exist.
+func (c *Client) GetURLRedirect(ctx context.Context, id string) (*URLRedirect, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query urlRedirect($id: ID!) {
+  urlRedirect(id: $id) {
+    id
+    path
+    target
+  }
+}
+`
+
+	var gqlResp GetURLRedirectResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.URLRedirect, nil
+}
+
+type UpdateURLRedirectResponse struct {
+	URLRedirectUpdate struct {
+		URLRedirect *URLRedirect `json:"urlRedirect"`
+		UserErrors  UserErrors   `json:"userErrors"`
+	} `json:"urlRedirectUpdate"`
+}
+
+// UpdateURLRedirect updates the URL redirect, including its path.
+func (c *Client) UpdateURLRedirect(ctx context.Context, id string, input *URLRedirectInput) (*URLRedirect, error) {
+	variables := map[string]interface{}{"id": id, "urlRedirect": input}
+	query := `
+mutation urlRedirectUpdate($id: ID!, $urlRedirect: UrlRedirectInput!) {
+  urlRedirectUpdate(id: $id, urlRedirect: $urlRedirect) {
+    urlRedirect {
+      id
+      path
+      target
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateURLRedirectResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.URLRedirectUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.URLRedirectUpdate.URLRedirect, nil
+}
+
+type DeleteURLRedirectResponse struct {
+	URLRedirectDelete struct {
+		DeletedURLRedirectID string     `json:"deletedUrlRedirectId"`
+		UserErrors           UserErrors `json:"userErrors"`
+	} `json:"urlRedirectDelete"`
+}
+
+func (c *Client) DeleteURLRedirect(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation urlRedirectDelete($id: ID!) {
+  urlRedirectDelete(id: $id) {
+    deletedUrlRedirectId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp DeleteURLRedirectResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.URLRedirectDelete.UserErrors.Error()
+}
@@ -0,0 +1,151 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type WebPixel struct {
+	ID       string           `json:"id"`
+	Settings WebPixelSettings `json:"settings"`
+}
+
+// WebPixelSettings is the JSON encoded settings of a web pixel.
+// Shopify returns the settings either as a JSON object or as a string containing the JSON object.
+type WebPixelSettings string
+
+func (s *WebPixelSettings) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*s = WebPixelSettings(str)
+		return nil
+	}
+	*s = WebPixelSettings(data)
+	return nil
+}
+
+type WebPixelInput struct {
+	Settings string `json:"settings"`
+}
+
+type CreateWebPixelResponse struct {
+	WebPixelCreate struct {
+		WebPixel   *WebPixel  `json:"webPixel"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"webPixelCreate"`
+}
+
+func (c *Client) CreateWebPixel(ctx context.Context, input *WebPixelInput) (*WebPixel, error) {
+	variables := map[string]interface{}{"webPixel": input}
+	query := `
+mutation CreateWebPixel($webPixel: WebPixelInput!) {
+  webPixelCreate(webPixel: $webPixel) {
+    webPixel {
+      id
+      settings
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp CreateWebPixelResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.WebPixelCreate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.WebPixelCreate.WebPixel, nil
+}
+
+type GetWebPixelResponse struct {
+	WebPixel *WebPixel `json:"webPixel"`
+}
+
+func (c *Client) GetWebPixel(ctx context.Context, id string) (*WebPixel, error) {
+	variables := map[string]interface{}{"id": id}
+	query := `
+query webPixel($id: ID) {
+  webPixel(id: $id) {
+    id
+    settings
+  }
+}
+`
+
+	var gqlResp GetWebPixelResponse
+	err := c.query(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	return gqlResp.WebPixel, nil
+}
+
+type UpdateWebPixelResponse struct {
+	WebPixelUpdate struct {
+		WebPixel   *WebPixel  `json:"webPixel"`
+		UserErrors UserErrors `json:"userErrors"`
+	} `json:"webPixelUpdate"`
+}
+
+func (c *Client) UpdateWebPixel(ctx context.Context, id string, input *WebPixelInput) (*WebPixel, error) {
+	variables := map[string]interface{}{"id": id, "webPixel": input}
+	query := `
+mutation UpdateWebPixel($id: ID!, $webPixel: WebPixelInput!) {
+  webPixelUpdate(id: $id, webPixel: $webPixel) {
+    webPixel {
+      id
+      settings
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp UpdateWebPixelResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return nil, err
+	}
+	if err := gqlResp.WebPixelUpdate.UserErrors.Error(); err != nil {
+		return nil, err
+	}
+	return gqlResp.WebPixelUpdate.WebPixel, nil
+}
+
+type DeleteWebPixelResponse struct {
+	WebPixelDelete struct {
+		DeletedWebPixelID string     `json:"deletedWebPixelId"`
+		UserErrors        UserErrors `json:"userErrors"`
+	} `json:"webPixelDelete"`
+}
+
+func (c *Client) DeleteWebPixel(ctx context.Context, id string) error {
+	variables := map[string]interface{}{"id": id}
+	query := `
+mutation DeleteWebPixel($id: ID!) {
+  webPixelDelete(id: $id) {
+    deletedWebPixelId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+	var gqlResp DeleteWebPixelResponse
+	err := c.mutate(ctx, query, variables, &gqlResp)
+	if err != nil {
+		return err
+	}
+	return gqlResp.WebPixelDelete.UserErrors.Error()
+}
@@ -2,10 +2,13 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httputil"
+	"os"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -29,10 +32,64 @@ type debugTransport struct {
 	transport http.RoundTripper
 }
 
+type withoutResponseBodyLogKey struct{}
+
+// WithoutResponseBodyLog returns the context of the requests whose response body must not be logged,
+// e.g. a large download streamed to keep it out of memory.
+func WithoutResponseBodyLog(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutResponseBodyLogKey{}, true)
+}
+
 func NewDebugTransport(t http.RoundTripper) *debugTransport {
 	return &debugTransport{name: "Shopify", transport: t}
 }
 
+// NewTransport returns the transport of the Shopify API requests, retrying the failed ones.
+// The requests and responses are logged only if the provider logs at the DEBUG level or below,
+// since dumping them slows large applies down.
+func NewTransport(t http.RoundTripper) http.RoundTripper {
+	t = NewRetryTransport(t)
+	if debugLogEnabled(os.Getenv) {
+		return NewDebugTransport(t)
+	}
+	return t
+}
+
+// hostTransport sends the requests to one host to another one instead.
+type hostTransport struct {
+	from, to  string
+	transport http.RoundTripper
+}
+
+// NewHostTransport returns the transport sending the requests to the host from to the host to instead,
+// e.g. to a proxy of the Admin API. The requests to the other hosts, e.g. signed download URLs, are sent as they are.
+func NewHostTransport(t http.RoundTripper, from, to string) http.RoundTripper {
+	return &hostTransport{from: from, to: to, transport: t}
+}
+
+func (t *hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !strings.EqualFold(req.URL.Host, t.from) {
+		return t.transport.RoundTrip(req)
+	}
+	// A RoundTripper must not modify the request
+	req = req.Clone(req.Context())
+	req.URL.Host = t.to
+	req.Host = t.to
+	return t.transport.RoundTrip(req)
+}
+
+// debugLogEnabled returns whether the provider logs at the DEBUG level or below. Like terraform-plugin-go,
+// TF_LOG_PROVIDER_SHOPIFY takes precedence over TF_LOG_PROVIDER, which takes precedence over TF_LOG.
+func debugLogEnabled(getenv func(string) string) bool {
+	for _, envVar := range []string{"TF_LOG_PROVIDER_SHOPIFY", "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := getenv(envVar); level != "" {
+			// JSON logs every level
+			return slices.Contains([]string{"TRACE", "DEBUG", "JSON"}, strings.ToUpper(strings.TrimSpace(level)))
+		}
+	}
+	return false
+}
+
 func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	ctx := req.Context()
 	reqData, err := httputil.DumpRequestOut(req, true)
@@ -47,7 +104,8 @@ func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		return resp, err
 	}
 
-	respData, err := httputil.DumpResponse(resp, true)
+	withoutBody, _ := ctx.Value(withoutResponseBodyLogKey{}).(bool)
+	respData, err := httputil.DumpResponse(resp, !withoutBody)
 	if err == nil {
 		tflog.Debug(ctx, fmt.Sprintf(logRespMsg, t.name, prettyPrintJsonLines(respData)))
 	} else {
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNewTransport(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_SHOPIFY", "")
+	t.Setenv("TF_LOG_PROVIDER", "")
+
+	t.Setenv("TF_LOG", "INFO")
+	if _, ok := NewTransport(http.DefaultTransport).(*retryTransport); !ok {
+		t.Error("expected the plain retrying transport at INFO level")
+	}
+
+	t.Setenv("TF_LOG", "DEBUG")
+	if _, ok := NewTransport(http.DefaultTransport).(*debugTransport); !ok {
+		t.Error("expected the debug transport at DEBUG level")
+	}
+}
+
+func TestDebugLogEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{name: "unset", env: map[string]string{}, want: false},
+		{name: "info", env: map[string]string{"TF_LOG": "INFO"}, want: false},
+		{name: "debug", env: map[string]string{"TF_LOG": "debug"}, want: true},
+		{name: "trace", env: map[string]string{"TF_LOG": "TRACE"}, want: true},
+		{name: "json", env: map[string]string{"TF_LOG": "JSON"}, want: true},
+		{name: "provider overrides root", env: map[string]string{"TF_LOG": "TRACE", "TF_LOG_PROVIDER": "WARN"}, want: false},
+		{name: "shopify overrides provider", env: map[string]string{"TF_LOG_PROVIDER": "ERROR", "TF_LOG_PROVIDER_SHOPIFY": "DEBUG"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+			if got := debugLogEnabled(getenv); got != tt.want {
+				t.Errorf("debugLogEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewHostTransport(t *testing.T) {
+	var hosts []string
+	transport := NewHostTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		hosts = append(hosts, req.URL.Host+" "+req.Host)
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}), "theshop.myshopify.com", "shopify-proxy.example.com:8443")
+
+	for _, u := range []string{"https://theshop.myshopify.com/admin/api/graphql.json", "https://storage.googleapis.com/results.jsonl"} {
+		req, err := http.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+		if req.URL.Host == "shopify-proxy.example.com:8443" {
+			t.Error("expected the request not to be modified")
+		}
+	}
+	// Only the requests to the shop go to the other host
+	want := []string{"shopify-proxy.example.com:8443 shopify-proxy.example.com:8443", "storage.googleapis.com storage.googleapis.com"}
+	if !reflect.DeepEqual(hosts, want) {
+		t.Errorf("unexpected hosts: %v", hosts)
+	}
+}
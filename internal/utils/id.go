@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseNumericID parses the numeric ID of a REST API resource.
+// The GraphQL global ID of the resource, e.g. gid://shopify/Customer/123, is accepted as well.
+func ParseNumericID(id, gidType string) (uint64, error) {
+	prefix := GIDPrefix(gidType)
+	parsed, err := strconv.ParseUint(strings.TrimPrefix(id, prefix), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected a numeric ID or %s<id>, got %q", prefix, id)
+	}
+	return parsed, nil
+}
+
+// GIDPrefix returns the prefix of the GraphQL global IDs of the resource type.
+func GIDPrefix(gidType string) string {
+	return "gid://shopify/" + gidType + "/"
+}
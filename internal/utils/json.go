@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// JSONEqual returns whether the two JSON documents are semantically equal,
+// i.e. equal regardless of whitespace and the order of object keys.
+func JSONEqual(a, b string) (bool, error) {
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(av, bv), nil
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultRetryAfter is the wait before retrying a throttled request without a Retry-After header.
+	defaultRetryAfter = time.Second
+	// maxRetries is the number of times a throttled request is retried before its response is returned.
+	maxRetries = 5
+)
+
+// retryTransport retries the requests throttled with 429 Too Many Requests once the wait of
+// their Retry-After header has elapsed. It applies to both the REST and the GraphQL API.
+type retryTransport struct {
+	transport http.RoundTripper
+}
+
+func NewRetryTransport(t http.RoundTripper) *retryTransport {
+	return &retryTransport{transport: t}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= maxRetries {
+			return resp, err
+		}
+		// The body has been consumed, so the request can't be sent again without a way to rewind it
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		wait := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		tflog.Debug(ctx, fmt.Sprintf("Shopify API request throttled, retrying in %s", wait))
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// parseRetryAfter returns the wait of the Retry-After header value, which is either a number of seconds
+// or an HTTP date. The default wait is returned when the value is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return defaultRetryAfter
+	}
+	// Shopify sends fractional seconds, e.g. 2.0
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds * float64(time.Second))
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait
+		}
+		return 0
+	}
+	return defaultRetryAfter
+}
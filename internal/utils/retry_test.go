@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"page":{"title":"About"}}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport)}
+	start := time.Now()
+	resp, err := client.Post(server.URL+"/admin/api/2024-07/pages.json", "application/json", strings.NewReader(`{"page":{"title":"About"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("expected status 201, got %d", resp.StatusCode)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+	if elapsed := time.Since(start); elapsed < 2*time.Second {
+		t.Errorf("expected the client to wait 2s before retrying, waited %s", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "2", want: 2 * time.Second},
+		{value: "2.0", want: 2 * time.Second},
+		{value: "0.5", want: 500 * time.Millisecond},
+		{value: "Mon, 01 Jul 2024 12:00:03 GMT", want: 3 * time.Second},
+		{value: "Mon, 01 Jul 2024 11:59:00 GMT", want: 0},
+		{value: "", want: defaultRetryAfter},
+		{value: "soon", want: defaultRetryAfter},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
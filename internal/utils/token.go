@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// accessTokenHeader is the header of the Admin API access token, for both the REST and the GraphQL API.
+const accessTokenHeader = "X-Shopify-Access-Token"
+
+// tokenRefreshTransport refreshes the access token once a request is rejected with 401 Unauthorized,
+// and sends the request again with the new token. The new token is sent with every later request.
+type tokenRefreshTransport struct {
+	transport http.RoundTripper
+	refresh   func(ctx context.Context) (string, error)
+
+	mu    sync.Mutex
+	token string
+}
+
+// NewTokenRefreshTransport returns the transport refreshing the access token token with refresh when it's rejected.
+func NewTokenRefreshTransport(t http.RoundTripper, token string, refresh func(ctx context.Context) (string, error)) http.RoundTripper {
+	return &tokenRefreshTransport{transport: t, refresh: refresh, token: token}
+}
+
+func (t *tokenRefreshTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Only the requests authenticated with the access token can be refreshed, not e.g. signed download URLs
+	if req.Header.Get(accessTokenHeader) == "" {
+		return t.transport.RoundTrip(req)
+	}
+	token := t.currentToken()
+	resp, err := t.transport.RoundTrip(withAccessToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// The body has been consumed, so the request can't be sent again without a way to rewind it
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	newToken, err := t.refreshToken(req.Context(), token)
+	if err != nil {
+		tflog.Warn(req.Context(), "Unable to refresh the Shopify access token", map[string]interface{}{"error": err.Error()})
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	retry := req
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry = req.Clone(req.Context())
+		retry.Body = body
+	}
+	return t.transport.RoundTrip(withAccessToken(retry, newToken))
+}
+
+func (t *tokenRefreshTransport) currentToken() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.token
+}
+
+// refreshToken refreshes the rejected token, unless a concurrent request has already replaced it.
+func (t *tokenRefreshTransport) refreshToken(ctx context.Context, rejected string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != rejected {
+		return t.token, nil
+	}
+	token, err := t.refresh(ctx)
+	if err != nil {
+		return "", err
+	}
+	tflog.Info(ctx, "Refreshed the Shopify access token")
+	t.token = token
+	return token, nil
+}
+
+// withAccessToken returns the request authenticated with the token. A RoundTripper must not modify the request.
+func withAccessToken(req *http.Request, token string) *http.Request {
+	if req.Header.Get(accessTokenHeader) == token {
+		return req
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(accessTokenHeader, token)
+	return req
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTokenRefreshTransport(t *testing.T) {
+	var tokens, bodies []string
+	refreshes := 0
+	transport := NewTokenRefreshTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		token := req.Header.Get(accessTokenHeader)
+		body, _ := io.ReadAll(req.Body)
+		tokens = append(tokens, token)
+		bodies = append(bodies, string(body))
+		if token != "new" {
+			return &http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}), "expired", func(ctx context.Context) (string, error) {
+		refreshes++
+		return "new", nil
+	})
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodPost, "https://theshop.myshopify.com/admin/api/2024-07/graphql.json", strings.NewReader(`{"query":"{ shop { id } }"}`))
+		req.Header.Set(accessTokenHeader, "expired")
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected the request to succeed with the new token, got %d", resp.StatusCode)
+		}
+	}
+	if refreshes != 1 {
+		t.Errorf("expected the token to be refreshed once, got %d", refreshes)
+	}
+	// The rejected request is sent again with its body, and the later one with the new token right away
+	if want := []string{"expired", "new", "new"}; strings.Join(tokens, ",") != strings.Join(want, ",") {
+		t.Errorf("expected the tokens %v, got %v", want, tokens)
+	}
+	if bodies[1] != bodies[0] {
+		t.Errorf("expected the retried request to have the same body, got %q", bodies[1])
+	}
+}
+
+func TestTokenRefreshTransport_refreshFailed(t *testing.T) {
+	requests := 0
+	transport := NewTokenRefreshTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody}, nil
+	}), "revoked", func(ctx context.Context) (string, error) {
+		return "", errors.New("invalid refresh token")
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://theshop.myshopify.com/admin/api/2024-07/shop.json", nil)
+	req.Header.Set(accessTokenHeader, "revoked")
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized || requests != 1 {
+		t.Errorf("expected the rejection to be returned without retrying, got %d after %d requests", resp.StatusCode, requests)
+	}
+}